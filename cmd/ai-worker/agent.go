@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/claudehook"
+	"github.com/zime/slickwebhook/internal/remoteagent"
+)
+
+// defaultAgentPort는 코디네이터 에이전트 API 기본 포트입니다.
+const defaultAgentPort = 8082
+
+// runAgent는 agent 서브커맨드를 실행합니다.
+// 코디네이터(AGENT_COORDINATOR_URL)에 등록하고 배정받은 작업을 이 머신에서 실행합니다.
+func runAgent(logger *log.Logger) int {
+	coordinatorURL := os.Getenv("AGENT_COORDINATOR_URL")
+	token := os.Getenv("AGENT_TOKEN")
+	if coordinatorURL == "" || token == "" {
+		logger.Println("[Agent] AGENT_COORDINATOR_URL, AGENT_TOKEN 설정 필요")
+		return 1
+	}
+
+	repoPaths, err := parseAgentRepos(os.Getenv("AGENT_REPOS"))
+	if err != nil || len(repoPaths) == 0 {
+		logger.Printf("[Agent] AGENT_REPOS 설정 오류 (예: repo-a=/home/build/repo-a): %v", err)
+		return 1
+	}
+
+	hostname, _ := os.Hostname()
+	reg := remoteagent.Registration{
+		AgentID:  os.Getenv("AGENT_ID"),
+		Hostname: hostname,
+	}
+	if reg.AgentID == "" {
+		reg.AgentID = hostname
+	}
+	for name := range repoPaths {
+		reg.Repos = append(reg.Repos, name)
+	}
+	for _, model := range splitList(os.Getenv("AGENT_MODELS")) {
+		reg.Models = append(reg.Models, parseAIModelType(model))
+	}
+	if slots, err := strconv.Atoi(os.Getenv("AGENT_SLOTS")); err == nil {
+		reg.Slots = slots
+	}
+
+	hookPort := aiworker.DefaultConfig().HookServerPort
+	if p, err := strconv.Atoi(os.Getenv("HOOK_SERVER_PORT")); err == nil {
+		hookPort = p
+	}
+
+	// Claude Code Stop/SessionEnd Hook을 로컬 Hook 포트로 설정
+	hookManager := claudehook.NewManager(hookPort)
+	settingsPath := claudehook.GetDefaultSettingsPath()
+	if err := hookManager.MergeSettings(settingsPath); err != nil {
+		logger.Printf("[Agent] Claude Hook 설정 실패 (무시): %v", err)
+	}
+
+	var runner remoteagent.Runner
+	runnerType := os.Getenv("AGENT_RUNNER")
+	if runnerType == "" {
+		runnerType = "tmux"
+		if runtime.GOOS == "darwin" {
+			runnerType = "terminal"
+		}
+	}
+	switch runnerType {
+	case "terminal":
+		runner = remoteagent.NewTerminalRunner(hookPort, parseTerminalType(os.Getenv("TERMINAL_TYPE")))
+	case "tmux":
+		runner = remoteagent.NewTmuxRunner(hookPort)
+	default:
+		logger.Printf("[Agent] 알 수 없는 AGENT_RUNNER: %s (tmux, terminal)", runnerType)
+		return 1
+	}
+
+	logger.Printf("[Agent] 시작: %s → %s (저장소: %v, 모델: %v, 슬롯: %d, 실행: %s, Hook 포트: %d)",
+		reg.AgentID, coordinatorURL, repoPaths, reg.Models, reg.Slots, runnerType, hookPort)
+
+	agent := remoteagent.NewAgent(remoteagent.AgentConfig{
+		CoordinatorURL: coordinatorURL,
+		Token:          token,
+		Registration:   reg,
+		RepoPaths:      repoPaths,
+		HookPort:       hookPort,
+	}, runner)
+	agent.SetLogger(logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigChan
+		logger.Printf("[Agent] %v 시그널 수신, 종료 중...", sig)
+		cancel()
+	}()
+
+	if err := agent.Run(ctx); err != nil {
+		logger.Printf("[Agent] 에러: %v", err)
+		return 1
+	}
+	logger.Println("[Agent] 종료됨")
+	return 0
+}
+
+// parseAgentRepos는 "이름=경로,이름=경로" 형식의 저장소 목록을 파싱합니다.
+func parseAgentRepos(s string) (map[string]string, error) {
+	repos := make(map[string]string)
+	for _, item := range splitList(s) {
+		name, path, ok := strings.Cut(item, "=")
+		name, path = strings.TrimSpace(name), strings.TrimSpace(path)
+		if !ok || name == "" || path == "" {
+			return nil, fmt.Errorf("잘못된 저장소 항목: %q", item)
+		}
+		repos[name] = path
+	}
+	return repos, nil
+}
+
+// newCoordinator는 AGENT_TOKEN이 설정되어 있으면 원격 에이전트 코디네이터를 생성합니다.
+// 코디네이터 포트는 AGENT_PORT(기본: 8082)입니다.
+func newCoordinator(logger *log.Logger) (*remoteagent.Coordinator, int) {
+	token := os.Getenv("AGENT_TOKEN")
+	if token == "" {
+		return nil, 0
+	}
+	port := defaultAgentPort
+	if p, err := strconv.Atoi(os.Getenv("AGENT_PORT")); err == nil {
+		port = p
+	}
+	coordinator := remoteagent.NewCoordinator(token)
+	coordinator.SetLogger(logger)
+	return coordinator, port
+}
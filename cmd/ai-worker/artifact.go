@@ -0,0 +1,39 @@
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// loadArtifacts는 완료 시 패치 저장/첨부 설정을 로드합니다.
+// ARTIFACTS=off이거나 원격 Worker(경로가 에이전트 머신에 있음)면 nil을 반환합니다.
+// ARTIFACTS_DIR 기본값은 실행 파일 디렉토리의 artifacts입니다.
+func loadArtifacts(worker aiworker.WorkerConfig, exeDir string, logger *log.Logger) *aiworker.ArtifactConfig {
+	if strings.EqualFold(os.Getenv("ARTIFACTS"), "off") || worker.RemoteRepo != "" {
+		return nil
+	}
+
+	dir := os.Getenv("ARTIFACTS_DIR")
+	if dir == "" {
+		dir = "artifacts"
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(exeDir, dir)
+	}
+
+	config := &aiworker.ArtifactConfig{Dir: dir, MaxUploadBytes: aiworker.DefaultArtifactMaxUpload}
+	if value := os.Getenv("ARTIFACT_MAX_UPLOAD_MB"); value != "" {
+		mb, err := strconv.Atoi(value)
+		if err != nil || mb <= 0 {
+			logger.Printf("[AI Worker] ARTIFACT_MAX_UPLOAD_MB 파싱 실패 (기본값 사용): %q", value)
+		} else {
+			config.MaxUploadBytes = mb * 1024 * 1024
+		}
+	}
+	return config
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/clickup"
+	"github.com/zime/slickwebhook/internal/digest"
+	"github.com/zime/slickwebhook/internal/store"
+)
+
+// digestUsage는 digest 서브커맨드 사용법입니다.
+const digestUsage = `사용법: ai-worker digest [--period daily|weekly] [--post]
+  --period  집계 기간 (기본: DIGEST_SCHEDULE 또는 daily)
+  --post    터미널 출력 대신 DIGEST_CHANNEL로 게시`
+
+// loadDigestSchedule은 환경변수에서 리포트 일정을 로드합니다. DIGEST_SCHEDULE이 비어있으면 ok=false입니다.
+func loadDigestSchedule() (schedule digest.Schedule, ok bool, err error) {
+	period := os.Getenv("DIGEST_SCHEDULE")
+	if period == "" {
+		return digest.Schedule{}, false, nil
+	}
+	schedule, err = digest.ParseSchedule(period, os.Getenv("DIGEST_TIME"), os.Getenv("DIGEST_WEEKDAY"), os.Getenv("DIGEST_TIMEZONE"))
+	if err != nil {
+		return digest.Schedule{}, false, err
+	}
+	return schedule, true, nil
+}
+
+// digestChannel은 리포트 게시 채널을 반환합니다. (DIGEST_CHANNEL, 기본: SLACK_NOTIFY_CHANNEL)
+func digestChannel(config aiworker.Config) string {
+	if channel := os.Getenv("DIGEST_CHANNEL"); channel != "" {
+		return channel
+	}
+	return config.SlackChannel
+}
+
+// buildAIDigest는 [since, until) 구간의 AI Worker 요약 리포트를 생성합니다.
+// 대기 태스크 조회에 실패한 리스트는 리포트에서 생략합니다.
+func buildAIDigest(ctx context.Context, manager *aiworker.Manager, runStore store.TaskRunStore, since, until time.Time, logger *log.Logger) (digest.Report, error) {
+	runs, err := runStore.ListRuns(store.TaskRunFilter{Since: since})
+	if err != nil {
+		return digest.Report{}, err
+	}
+	inWindow := runs[:0]
+	for _, run := range runs {
+		if run.StartedAt.Before(until) {
+			inWindow = append(inWindow, run)
+		}
+	}
+
+	// 풀 리스트는 Worker마다 세지 않도록 리스트별로 한 번만 조회하고 풀 Worker ID를 묶어 표시
+	waiting := make(map[string][]digest.WaitingTask)
+	for _, worker := range manager.GetWorkers() {
+		config := worker.GetConfig()
+		pool := manager.GetPool(config.ListID)
+		if pool[0] != worker {
+			continue
+		}
+		tasks, err := manager.WaitingTasks(ctx, config.ListID)
+		if err != nil {
+			logger.Printf("[%s] 리포트용 태스크 조회 실패: %v", config.ID, err)
+			continue
+		}
+
+		ids := make([]string, 0, len(pool))
+		for _, w := range pool {
+			ids = append(ids, w.GetConfig().ID)
+		}
+		label := strings.Join(ids, "/")
+		for _, task := range tasks {
+			waiting[label] = append(waiting[label], digest.WaitingTask{
+				WorkerID:  label,
+				TaskID:    task.ID,
+				Name:      task.Name,
+				URL:       task.URL,
+				CreatedAt: clickup.ParseMillis(task.DateCreated),
+			})
+		}
+	}
+
+	return digest.Report{
+		Title:    "📊 AI Worker 리포트",
+		Since:    since,
+		Until:    until,
+		Sections: digest.AIWorkerSections(inWindow, waiting, until),
+	}, nil
+}
+
+// startDigest는 리포트 일정에 따라 AI Worker 리포트를 게시하는 고루틴을 시작합니다.
+func startDigest(ctx context.Context, schedule digest.Schedule, poster digest.Poster, channel string, manager *aiworker.Manager, runStore store.TaskRunStore, logger *log.Logger) {
+	go digest.Run(ctx, schedule, func(ctx context.Context, since, until time.Time) {
+		report, err := buildAIDigest(ctx, manager, runStore, since, until, logger)
+		if err != nil {
+			logger.Printf("[AI Worker] 리포트 생성 실패: %v", err)
+			return
+		}
+		if err := report.Post(ctx, poster, channel); err != nil {
+			logger.Printf("[AI Worker] %v", err)
+			return
+		}
+		logger.Println("[AI Worker] 요약 리포트 게시 완료")
+	})
+	logger.Printf("[AI Worker] 요약 리포트 예약: %s (다음: %s, 채널: %s)",
+		schedule.Period, schedule.Next(time.Now()).Format("2006-01-02 15:04"), channel)
+}
+
+// runDigest는 digest 서브커맨드를 실행합니다. 직전 기간의 리포트를 출력하거나 게시합니다.
+func runDigest(args []string, manager *aiworker.Manager, dbPath string, poster digest.Poster, channel string, logger *log.Logger, out io.Writer) int {
+	fs := flag.NewFlagSet("digest", flag.ContinueOnError)
+	fs.SetOutput(out)
+	period := fs.String("period", "", "집계 기간 (daily, weekly)")
+	post := fs.Bool("post", false, "Slack 채널에 게시")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	schedule, ok, err := loadDigestSchedule()
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 2
+	}
+	if !ok {
+		schedule.Period = digest.PeriodDaily
+	}
+	if *period != "" {
+		schedule.Period = digest.Period(*period)
+	}
+	if schedule.Period != digest.PeriodDaily && schedule.Period != digest.PeriodWeekly {
+		fmt.Fprintln(out, digestUsage)
+		return 2
+	}
+	if *post && channel == "" {
+		fmt.Fprintln(out, "❌ DIGEST_CHANNEL 또는 SLACK_NOTIFY_CHANNEL이 설정되지 않았습니다")
+		return 2
+	}
+
+	runStore, err := store.NewSQLiteTaskRunStore(dbPath)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+	defer runStore.Close()
+
+	ctx := context.Background()
+	until := time.Now()
+	report, err := buildAIDigest(ctx, manager, runStore, schedule.Window(until), until, logger)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+
+	if *post {
+		if err := report.Post(ctx, poster, channel); err != nil {
+			fmt.Fprintf(out, "❌ %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(out, "✅ 리포트 게시 완료 (%s)\n", channel)
+		return 0
+	}
+
+	fmt.Fprint(out, report.Text())
+	return 0
+}
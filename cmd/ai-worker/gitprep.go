@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// loadGitPrep은 Worker의 git 준비/정리 설정을 로드합니다. (Worker별 AI_XX_GIT_* 우선)
+// GIT_PREP이 비어있거나 원격 Worker(경로가 에이전트 머신에 있음)면 nil을 반환합니다.
+func loadGitPrep(worker aiworker.WorkerConfig, logger *log.Logger) *aiworker.GitPrepConfig {
+	prefix := worker.ID + "_"
+	if worker.RemoteRepo != "" {
+		return nil
+	}
+	steps, err := aiworker.ParseGitPrepSteps(envOr(prefix+"GIT_PREP", "GIT_PREP"))
+	if err != nil {
+		logger.Printf("[AI Worker] %s git 준비 설정 오류 (준비 안함): %v", worker.ID, err)
+		return nil
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+
+	cleanup, err := aiworker.ParseCleanupPolicy(envOr(prefix+"GIT_CLEANUP", "GIT_CLEANUP"))
+	if err != nil {
+		logger.Printf("[AI Worker] %s 정리 정책 오류 (keep 사용): %v", worker.ID, err)
+		cleanup = aiworker.CleanupKeep
+	}
+
+	config := &aiworker.GitPrepConfig{
+		Steps:        steps,
+		Remote:       envOr(prefix+"GIT_REMOTE", "GIT_REMOTE"),
+		BaseBranch:   envOr(prefix+"GIT_BASE_BRANCH", "GIT_BASE_BRANCH"),
+		BranchPrefix: envOr(prefix+"GIT_BRANCH_PREFIX", "GIT_BRANCH_PREFIX"),
+		Cleanup:      cleanup,
+	}
+	logger.Printf("[AI Worker] git 준비: %s (단계: %v, 기준: %s/%s, 정리: %s)",
+		worker.ID, steps, orDefault(config.Remote, aiworker.DefaultGitRemote),
+		orDefault(config.BaseBranch, aiworker.DefaultGitBaseBranch), cleanup)
+	return config
+}
+
+// orDefault는 value가 비어있으면 fallback을 반환합니다.
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
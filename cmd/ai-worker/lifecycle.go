@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/hookserver"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+)
+
+// registerLifecycleHooks는 태스크 상태 전이에 Slack 알림과 에이전트 종료를 연결합니다.
+// 종료 상태 훅은 처리 상태가 클리어되기 전에 실행되므로 Worker의 현재 태스크 정보로 알립니다.
+func registerLifecycleHooks(manager *aiworker.Manager, notify *taskNotifier, logger *log.Logger) {
+	manager.OnEnter(aiworker.StateAwaitingApproval, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		notify.notifyPlanReady(ctx, w, &hookserver.PlanReadyPayload{Cwd: w.GetConfig().SrcPath, PlanTitle: t.Reason})
+	})
+
+	manager.OnEnter(aiworker.StateRateLimited, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		notify.notify(ctx, w, newTaskNotice(w), notifyformatter.EventRateLimited, t.Reason)
+	})
+
+	manager.OnEnter(aiworker.StateFailed, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		notify.notify(ctx, nil, newTaskNotice(w), notifyformatter.EventFailed, t.Reason)
+	})
+
+	manager.OnEnter(aiworker.StateCancelled, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		notify.notify(ctx, nil, newTaskNotice(w), notifyformatter.EventCancelled, t.Reason)
+	})
+
+	manager.OnEnter(aiworker.StateCompleted, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		notify.notify(ctx, nil, newTaskNotice(w), notifyformatter.EventCompleted, t.Reason)
+
+		// 완료 보고 요청이 응답을 받을 수 있도록 0.5초 후 에이전트 종료
+		workerID := w.GetConfig().ID
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			logger.Printf("[AI Worker] Claude 프로세스 종료 중 (Worker: %s)", workerID)
+			if err := w.TerminateClaude(); err != nil {
+				logger.Printf("[AI Worker] Claude 종료 실패: %v", err)
+			}
+		}()
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
-	"regexp"
 	"strconv"
 	"strings"
 	"syscall"
@@ -21,7 +20,11 @@ import (
 	"github.com/zime/slickwebhook/internal/config"
 	"github.com/zime/slickwebhook/internal/hookserver"
 	"github.com/zime/slickwebhook/internal/issueformatter"
+	"github.com/zime/slickwebhook/internal/notifier"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+	"github.com/zime/slickwebhook/internal/remoteagent"
 	"github.com/zime/slickwebhook/internal/slack"
+	"github.com/zime/slickwebhook/internal/store"
 	"github.com/zime/slickwebhook/internal/webhook"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
@@ -39,11 +42,38 @@ func main() {
   지원 AI 모델:
     - claude   : Claude Code (기본값)
     - opencode : OpenCode (oh-my-opencode)
-    - ampcode  : Ampcode (Sourcegraph)`,
+    - ampcode  : Ampcode (Sourcegraph)
+
+  웹훅 재생:
+    replay [--lists L1,L2] [--rules R1,R2] [--only-diff] [-v] <file.jsonl>
+                             WEBHOOK_RECORD_FILE로 기록한 요청을 라우팅만 다시 수행
+
+  웹훅 관리:
+    webhooks list            등록된 ClickUp 웹훅과 상태(fail_count) 출력
+    webhooks sync [--endpoint URL] [--dry-run] [--no-write]
+                             AI 리스트마다 웹훅 등록/수정/재활성화, WEBHOOK_SECRET 저장
+
+  원격 에이전트:
+    agent                    AGENT_COORDINATOR_URL의 ai-worker에 등록하고 배정받은 작업을 이 머신에서 실행
+
+  MCP:
+    mcp                      에이전트 stdio MCP 연결을 MCP 서버로 전달 (MCP=stdio 설정 파일에서 자동 실행)
+
+  Dry-run 옵션:
+    --dry-run                ClickUp 변경/AI 도구 실행 없이 로그로만 기록
+    --fixture <file>         ClickUp 대신 로컬 JSON 픽스처에서 태스크 조회
+    --dry-run-dir <dir>      프롬프트/실행 스크립트 저장 위치 (기본: dryrun/)
+    --fake-agent             가짜 에이전트가 Hook 엔드포인트를 호출하여 수명주기 재현
+    --fake-agent-delay <d>   가짜 에이전트 단계 사이 대기 시간 (기본: 2s)`,
 	}) {
 		return
 	}
 
+	// mcp 서브커맨드: 에이전트의 stdio MCP 연결을 MCP 서버로 전달 (stdout은 MCP 메시지 전용이라 로거 설정 전에 처리)
+	if len(os.Args) > 1 && os.Args[1] == "mcp" {
+		os.Exit(runMCPBridge())
+	}
+
 	// 로거 설정 (LOG_TO_FILE 환경변수로 파일 로깅 활성화)
 	var logWriter io.Writer = os.Stdout
 
@@ -79,25 +109,109 @@ func main() {
 		logger.Printf("[AI Worker] 설정 파일 로드 실패 (무시): %v", err)
 	}
 
+	// replay 서브커맨드: 기록된 웹훅 요청 재생
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(runReplay(os.Args[2:], loadWorkerConfig(logger), os.Stdout))
+	}
+
+	// webhooks 서브커맨드: ClickUp 웹훅 등록/상태 관리
+	if len(os.Args) > 1 && os.Args[1] == "webhooks" {
+		os.Exit(runWebhooks(os.Args[2:], loadWorkerConfig(logger), configPath, os.Stdout))
+	}
+
+	// runs 서브커맨드: 태스크 실행 기록 통계
+	if len(os.Args) > 1 && os.Args[1] == "runs" {
+		os.Exit(runRuns(os.Args[2:], taskRunDBPath(exeDir), os.Stdout))
+	}
+
+	// digest 서브커맨드: 요약 리포트 출력/게시
+	if len(os.Args) > 1 && os.Args[1] == "digest" {
+		digestConfig := loadWorkerConfig(logger)
+		digestManager := aiworker.NewManager(digestConfig)
+		digestManager.SetClickUpClient(clickup.NewClickUpClient(clickup.Config{
+			APIToken: os.Getenv("CLICKUP_API_TOKEN"),
+			TeamID:   os.Getenv("CLICKUP_TEAM_ID"),
+		}))
+		os.Exit(runDigest(os.Args[2:], digestManager, taskRunDBPath(exeDir),
+			slack.NewSlackClient(os.Getenv("SLACK_BOT_TOKEN")), digestChannel(digestConfig), logger, os.Stdout))
+	}
+
+	// agent 서브커맨드: 코디네이터에서 작업을 받아 이 머신에서 실행
+	if len(os.Args) > 1 && os.Args[1] == "agent" {
+		os.Exit(runAgent(logger))
+	}
+
+	// 실행 옵션 파싱 (dry-run 등)
+	opts, err := parseRunOptions(os.Args[1:], filepath.Join(exeDir, "dryrun"))
+	if err != nil {
+		logger.Fatalf("[AI Worker] 실행 옵션 파싱 실패: %v", err)
+	}
+
 	// AI Worker 설정 구성
 	workerConfig := loadWorkerConfig(logger)
 
-	// ClickUp 클라이언트 생성
-	clickupClient := clickup.NewClickUpClient(clickup.Config{
+	// ClickUp 클라이언트 생성 (dry-run이면 변경 작업을 로그로만 기록)
+	var clickupClient aiworker.ClickUpClientInterface = clickup.NewClickUpClient(clickup.Config{
 		APIToken: os.Getenv("CLICKUP_API_TOKEN"),
 		TeamID:   os.Getenv("CLICKUP_TEAM_ID"),
 	})
+	if opts.DryRun {
+		clickupClient = newDryRunClickUpClient(opts, clickupClient, logger)
+	}
 
-	// Slack 클라이언트 생성
+	// Slack 클라이언트 생성 (태스크별 스레드 알림, Block Kit 포맷)
 	slackClient := slack.NewSlackClient(os.Getenv("SLACK_BOT_TOKEN"))
+	notifyFormatter, err := notifyformatter.NewFormatter(loadNotifyFormatterConfig())
+	if err != nil {
+		logger.Printf("[AI Worker] 알림 템플릿 오류, 기본 템플릿 사용: %v", err)
+		notifyFormatter, _ = notifyformatter.NewFormatter(notifyformatter.DefaultConfig())
+	}
+	notifyRegistry, err := notifier.NewRegistryFromConfig(notifier.LoadConfigFromEnv(os.Environ()), nil, nil)
+	if err != nil {
+		logger.Printf("[AI Worker] 알림 라우팅 설정 오류, Slack만 사용: %v", err)
+		notifyRegistry = notifier.NewRegistry()
+	}
+	slackChannel := workerConfig.SlackChannel
+	if opts.DryRun {
+		// dry-run에서는 알림을 전송하지 않음
+		slackChannel = ""
+		notifyRegistry = notifier.NewRegistry()
+	}
+	taskNotify := newTaskNotifier(slackClient, notifyFormatter, notifyRegistry, slackChannel, logger)
 
 	// issueformatter 생성
 	formatter := issueformatter.NewIssueFormatter(issueformatter.DefaultConfig())
 
+	// dry-run에서는 작업 트리를 건드리지 않도록 사전 점검, git 준비, TDD 점검(테스트 실행) 생략
+	if opts.DryRun {
+		for i := range workerConfig.Workers {
+			workerConfig.Workers[i].Preflight = nil
+			workerConfig.Workers[i].GitPrep = nil
+			workerConfig.Workers[i].TDD = nil
+		}
+	}
+
+	// 완료 시 패치 저장 및 ClickUp 첨부 (dry-run에서는 첨부하지 않음)
+	if !opts.DryRun {
+		for i := range workerConfig.Workers {
+			workerConfig.Workers[i].Artifacts = loadArtifacts(workerConfig.Workers[i], exeDir, logger)
+		}
+	}
+
 	// Manager 생성 및 의존성 주입
 	manager := aiworker.NewManager(workerConfig)
 	manager.SetLogger(logger)
 	manager.SetClickUpClient(clickupClient)
+	manager.SetScheduler(aiworker.NewScheduler(loadSchedulerConfig(logger)))
+
+	// 원격 에이전트 코디네이터 (AGENT_TOKEN 설정 시)
+	coordinator, agentPort := newCoordinator(logger)
+
+	// 에이전트용 MCP 서버 (MCP 설정 시, dry-run에서는 사용 안함)
+	var mcp *mcpSetup
+	if !opts.DryRun {
+		mcp = newMCPSetup(exeDir, clickupClient, logger)
+	}
 
 	// 각 Worker에 개별 Invoker 및 formatter 설정
 	for _, worker := range manager.GetWorkers() {
@@ -108,18 +222,33 @@ func main() {
 			wConfig.TerminalType,
 			wConfig.AIModelType,
 		)
-		worker.SetInvoker(workerInvoker)
+		switch {
+		case opts.DryRun:
+			worker.SetInvoker(newDryRunInvoker(opts, workerInvoker, workerConfig.HookServerPort, logger))
+		case wConfig.RemoteRepo != "":
+			if coordinator == nil {
+				logger.Fatalf("[AI Worker] 원격 Worker %s에는 AGENT_TOKEN 설정 필요", wConfig.ID)
+			}
+			worker.SetInvoker(remoteagent.NewRemoteInvoker(coordinator, wConfig.RemoteRepo, wConfig.AIModelType))
+		default:
+			if mcp != nil {
+				mcp.configure(wConfig, workerInvoker)
+			}
+			worker.SetInvoker(workerInvoker)
+		}
 		worker.SetFormatter(formatter)
 		worker.SetTerminalType(wConfig.TerminalType)
 	}
 
-	// Claude Code Hook 설정
-	hookManager := claudehook.NewManager(workerConfig.HookServerPort)
-	settingsPath := claudehook.GetDefaultSettingsPath()
-	if err := hookManager.MergeSettings(settingsPath); err != nil {
-		logger.Printf("[AI Worker] Claude Hook 설정 실패 (무시): %v", err)
-	} else {
-		logger.Printf("[AI Worker] Claude Hook 설정 완료: %s", settingsPath)
+	// Claude Code Hook 설정 (dry-run에서는 사용자 설정 파일을 수정하지 않음)
+	if !opts.DryRun {
+		hookManager := claudehook.NewManager(workerConfig.HookServerPort)
+		settingsPath := claudehook.GetDefaultSettingsPath()
+		if err := hookManager.MergeSettings(settingsPath); err != nil {
+			logger.Printf("[AI Worker] Claude Hook 설정 실패 (무시): %v", err)
+		} else {
+			logger.Printf("[AI Worker] Claude Hook 설정 완료: %s", settingsPath)
+		}
 	}
 
 	// 컨텍스트 설정
@@ -130,8 +259,47 @@ func main() {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
+	// 상태 전이 부수 효과 (Slack 알림, 에이전트 종료)
+	registerLifecycleHooks(manager, taskNotify, logger)
+
+	// SLA 위반 알림 (위반당 한 번, 해소 시 같은 메시지 갱신)
+	manager.SetSLAHandler(newSLAAlerter(taskNotify).handle)
+
+	// 사전 점검 실패 알림 (같은 실패는 한 번만)
+	manager.SetPreflightHandler(preflightAlert(taskNotify))
+
+	// 태스크 실행 기록 (dry-run에서는 기록하지 않음)
+	var runs *runRecorder
+	var runStore store.TaskRunStore
+	if !opts.DryRun {
+		sqliteRunStore, err := store.NewSQLiteTaskRunStore(taskRunDBPath(exeDir))
+		if err != nil {
+			logger.Printf("[AI Worker] 실행 기록 DB 열기 실패 (기록 생략): %v", err)
+		} else {
+			defer sqliteRunStore.Close()
+			runStore = sqliteRunStore
+			runs = newRunRecorder(runStore, logger)
+			runs.register(manager)
+		}
+	}
+
+	// completeTask는 완료 신호를 받으면 실행 단계를 거쳐 완료 처리를 백그라운드에서 시작합니다.
+	// 완료/실패 알림과 에이전트 종료는 상태 전이 훅에서 처리합니다.
+	completeTask := func(worker *aiworker.Worker, signal string) {
+		err := worker.SignalCompletion(ctx, signal, func(err error) {
+			if err != nil {
+				logger.Printf("[AI Worker] 완료 처리 실패: %v", err)
+			} else {
+				logger.Printf("[AI Worker] 완료 처리 성공 (%s)", signal)
+			}
+		})
+		if err != nil {
+			logger.Printf("[AI Worker] 완료 처리 불가: %v", err)
+		}
+	}
+
 	// Hook 서버 시작 (Claude Code Stop Hook 수신)
-	// Stop 이벤트에 따라 다른 Slack 알림 전송
+	// Stop 원인에 따라 태스크 상태를 전이합니다.
 	hookCallback := func(payload *hookserver.StopHookPayload) {
 		logger.Printf("[AI Worker] Claude Code Stop Hook 수신: cwd=%s, permission_mode=%s", payload.Cwd, payload.PermissionMode)
 
@@ -141,17 +309,21 @@ func main() {
 			return
 		}
 
-		workerID := worker.GetConfig().ID
-
-		// Plan 모드면 transcript 분석 없이 바로 알림 전송
+		// Plan 모드면 transcript 분석 없이 바로 승인 대기로 전환
 		// (Claude Code 2.1.19+ 버그: plan 모드 Stop Hook에서 transcript_path가 비어있음)
 		if payload.PermissionMode == "plan" {
-			logger.Printf("[AI Worker] Plan 모드 Stop 감지 - Slack 알림 전송")
-			planPayload := &hookserver.PlanReadyPayload{
-				Cwd:       payload.Cwd,
-				PlanTitle: "계획 수립 완료",
+			// 리뷰 전용 태스크는 승인할 계획이 없으므로 리뷰 보고로 완료 처리
+			if worker.RunOptions().Mode == aiworker.ModeReview {
+				logger.Printf("[AI Worker] 리뷰 모드 Stop 감지 - 완료 처리")
+				runs.recordStop(worker, StopReasonCompleted, payload.TranscriptPath)
+				completeTask(worker, "리뷰 모드 Stop")
+				return
+			}
+			logger.Printf("[AI Worker] Plan 모드 Stop 감지 - 승인 대기")
+			runs.recordStop(worker, StopReasonPlanReady, payload.TranscriptPath)
+			if err := worker.PlanReady(ctx, "계획 수립 완료"); err != nil {
+				logger.Printf("[AI Worker] %v", err)
 			}
-			sendPlanReadySlackNotification(ctx, slackClient, workerConfig.SlackChannel, worker, planPayload)
 			return
 		}
 
@@ -160,64 +332,45 @@ func main() {
 		// acceptEdits 모드에서는 Stop 발생 시 작업 완료로 간주
 		if payload.PermissionMode == "acceptEdits" {
 			logger.Printf("[AI Worker] acceptEdits 모드 Stop 감지 - 자동 완료 처리")
-
-			taskID := worker.GetCurrentTaskID()
-			taskName := worker.GetCurrentTaskName()
-			jiraID := worker.GetCurrentJiraID()
-
-			if err := manager.OnHookReceived(ctx, payload.Cwd); err != nil {
-				logger.Printf("[AI Worker] 완료 처리 실패: %v", err)
-			} else {
-				logger.Printf("[AI Worker] 완료 처리 성공 (acceptEdits 자동 완료)")
-				// Slack 알림 전송
-				sendSlackNotificationWithInfo(ctx, slackClient, workerConfig.SlackChannel, workerID, taskID, taskName, jiraID)
-
-				// 0.5초 후 Claude 프로세스 종료
-				go func() {
-					time.Sleep(500 * time.Millisecond)
-					logger.Printf("[AI Worker] Claude 프로세스 종료 중 (Worker: %s)", workerID)
-					if err := worker.TerminateClaude(); err != nil {
-						logger.Printf("[AI Worker] Claude 종료 실패: %v", err)
-					}
-				}()
-			}
+			runs.recordStop(worker, StopReasonCompleted, payload.TranscriptPath)
+			completeTask(worker, "acceptEdits 모드 Stop")
 			return
 		}
 
 		// 그 외 모드: transcript 파일에서 Stop 원인 분석
 		stopReason := analyzeStopReason(payload.TranscriptPath, logger)
 		logger.Printf("[AI Worker] Stop 원인 분석: %s", stopReason)
+		runs.recordStop(worker, stopReason, payload.TranscriptPath)
 
+		var err error
 		switch stopReason {
 		case StopReasonPlanReady:
-			// Plan 완료 - 검토 요청 알림 (fallback)
-			logger.Printf("[AI Worker] Plan 완료 감지 (transcript) - Slack 알림 전송")
-			planPayload := &hookserver.PlanReadyPayload{
-				Cwd:       payload.Cwd,
-				PlanTitle: "계획 수립 완료",
-			}
-			sendPlanReadySlackNotification(ctx, slackClient, workerConfig.SlackChannel, worker, planPayload)
+			// Plan 완료 - 승인 대기 (fallback)
+			logger.Printf("[AI Worker] Plan 완료 감지 (transcript) - 승인 대기")
+			err = worker.PlanReady(ctx, "계획 수립 완료")
 
 		case StopReasonRateLimit:
-			// Rate Limit 알림
-			sendStopEventNotification(ctx, slackClient, workerConfig.SlackChannel, workerID, "⚠️ Rate Limit", "API 사용량 한도에 도달했습니다. 잠시 후 재시도됩니다.")
+			_, err = worker.Transition(ctx, aiworker.StateRateLimited, "API 사용량 한도에 도달했습니다. 잠시 후 재시도됩니다.")
 
 		case StopReasonContextExceeded:
-			// Context 초과 알림
-			sendStopEventNotification(ctx, slackClient, workerConfig.SlackChannel, workerID, "⚠️ Context 초과", "컨텍스트 윈도우 한도를 초과했습니다.")
+			// Context 초과 알림 (상태 전이 없음, 세션은 계속 진행될 수 있음)
+			taskNotify.notify(ctx, worker, newTaskNotice(worker), notifyformatter.EventFailed, "*Context 초과:* 컨텍스트 윈도우 한도를 초과했습니다.")
 
 		case StopReasonAPIError:
-			// API 에러 알림
-			sendStopEventNotification(ctx, slackClient, workerConfig.SlackChannel, workerID, "❌ API 에러", "Claude API 호출 중 에러가 발생했습니다.")
+			// API 에러 알림 (상태 전이 없음, 세션은 계속 진행될 수 있음)
+			taskNotify.notify(ctx, worker, newTaskNotice(worker), notifyformatter.EventFailed, "*API 에러:* Claude API 호출 중 에러가 발생했습니다.")
 
 		case StopReasonCompleted:
-			// 정상 완료 - 별도 알림 없음 (TaskComplete에서 처리)
+			// 정상 완료 - 별도 처리 없음 (TaskComplete에서 처리)
 			logger.Printf("[AI Worker] 작업 정상 완료 감지")
 
 		case StopReasonUnknown:
 			// 알 수 없는 Stop - 로그만 남김
 			logger.Printf("[AI Worker] 알 수 없는 Stop 원인 (알림 생략)")
 		}
+		if err != nil {
+			logger.Printf("[AI Worker] %v", err)
+		}
 	}
 
 	// SessionEnd 콜백 (취소 시 롤백만 수행. 완료 처리는 TaskComplete에서)
@@ -233,13 +386,8 @@ func main() {
 		case hookserver.ReasonPromptInputExit:
 			// 사용자 취소 시 상태 롤백
 			logger.Printf("[AI Worker] 사용자 취소 감지, 상태 롤백 시작...")
-			taskID := worker.GetCurrentTaskID()
-			originalStatus := worker.GetOriginalStatus()
-
-			if err := worker.RollbackStatus(ctx); err != nil {
-				logger.Printf("[AI Worker] 상태 롤백 실패: %v", err)
-			} else {
-				logger.Printf("[AI Worker] 상태 롤백 완료: 태스크=%s, 원래상태=%s", taskID, originalStatus)
+			if err := worker.Cancel(ctx, "사용자 취소"); err != nil {
+				logger.Printf("[AI Worker] 취소 처리 실패: %v", err)
 			}
 
 		case hookserver.ReasonOther:
@@ -253,19 +401,20 @@ func main() {
 	hookServer.SetLogger(logger)
 	hookServer.SetSessionEndCallback(sessionEndCallback)
 
-	// Plan Ready 콜백 (Plan 완료 시 Slack 알림)
+	// Plan Ready 콜백 (Plan 완료 시 승인 대기)
 	planReadyCallback := func(payload *hookserver.PlanReadyPayload) {
 		logger.Printf("[AI Worker] Plan Ready 수신: cwd=%s, plan=%s", payload.Cwd, payload.PlanTitle)
 
 		// 해당 cwd에 매칭되는 Worker 찾기
 		worker := manager.GetWorkerBySrcPath(payload.Cwd)
-		if worker == nil {
-			logger.Printf("[AI Worker] Plan Ready: 매칭되는 Worker 없음 (cwd=%s)", payload.Cwd)
+		if worker == nil || !worker.IsProcessing() {
+			logger.Printf("[AI Worker] Plan Ready: 매칭되는 Worker 없거나 처리 중 아님 (cwd=%s)", payload.Cwd)
 			return
 		}
 
-		// Slack 알림 전송
-		sendPlanReadySlackNotification(ctx, slackClient, workerConfig.SlackChannel, worker, payload)
+		if err := worker.PlanReady(ctx, payload.PlanTitle); err != nil {
+			logger.Printf("[AI Worker] %v", err)
+		}
 	}
 	hookServer.SetPlanReadyCallback(planReadyCallback)
 
@@ -279,43 +428,164 @@ func main() {
 			return
 		}
 
-		// 완료 처리 전에 태스크 정보 저장
-		taskID := worker.GetCurrentTaskID()
-		taskName := worker.GetCurrentTaskName()
-		jiraID := worker.GetCurrentJiraID()
-		workerID := worker.GetConfig().ID
+		completeTask(worker, "Claude 명시적 완료")
+	}
+	hookServer.SetTaskCompleteCallback(taskCompleteCallback)
 
-		if err := manager.OnHookReceived(ctx, payload.Cwd); err != nil {
-			logger.Printf("[AI Worker] 완료 처리 실패: %v", err)
-		} else {
-			logger.Printf("[AI Worker] 완료 처리 성공 (Claude 명시적 완료)")
-			// Slack 알림 전송
-			sendSlackNotificationWithInfo(ctx, slackClient, workerConfig.SlackChannel, workerID, taskID, taskName, jiraID)
-
-			// 0.5초 후 Claude 프로세스 종료
-			go func() {
-				time.Sleep(500 * time.Millisecond)
-				logger.Printf("[AI Worker] Claude 프로세스 종료 중 (Worker: %s)", workerID)
-				if err := worker.TerminateClaude(); err != nil {
-					logger.Printf("[AI Worker] Claude 종료 실패: %v", err)
-				}
-			}()
+	// ReviewVerdict 콜백 (리뷰 에이전트 판정 → 완료 처리 또는 수정 요청 전달)
+	reviewVerdictCallback := func(payload *hookserver.ReviewVerdictPayload) {
+		logger.Printf("[AI Worker] 리뷰 판정 수신: cwd=%s, verdict=%s", payload.Cwd, payload.Verdict)
+
+		worker := manager.GetWorkerBySrcPath(payload.Cwd)
+		if worker == nil || !worker.IsProcessing() {
+			logger.Printf("[AI Worker] 리뷰 판정: 매칭되는 Worker 없거나 처리 중 아님")
+			return
+		}
+
+		verdict := aiworker.ReviewVerdict{
+			Approved: payload.Verdict == hookserver.VerdictApprove,
+			Summary:  payload.Summary,
+			Comments: payload.Comments,
+		}
+		if err := worker.ReviewVerdict(ctx, verdict); err != nil {
+			logger.Printf("[AI Worker] 리뷰 판정 처리 실패: %v", err)
 		}
 	}
-	hookServer.SetTaskCompleteCallback(taskCompleteCallback)
+	hookServer.SetReviewVerdictCallback(reviewVerdictCallback)
+
+	// Progress 콜백 (에이전트 진행 상황 heartbeat → Slack 메시지 갱신)
+	progressCallback := func(payload *hookserver.ProgressPayload) {
+		logger.Printf("[AI Worker] Progress 수신: cwd=%s, stage=%s", payload.Cwd, payload.Stage)
+
+		worker := manager.GetWorkerBySrcPath(payload.Cwd)
+		if worker == nil || !worker.RecordProgress(payload.Stage, payload.Message) {
+			logger.Printf("[AI Worker] Progress: 매칭되는 Worker 없거나 처리 중 아님")
+			return
+		}
+
+		// 계획 승인 보고는 실행 단계 전환 신호
+		if payload.Stage == hookserver.StagePlanApproved {
+			if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+				logger.Printf("[AI Worker] %v", err)
+			}
+		}
+
+		taskNotify.notifyProgress(ctx, worker)
+	}
+	hookServer.SetProgressCallback(progressCallback)
+
+	// 원격 에이전트 Hook은 Worker 경로로 cwd를 바꿔 로컬 Hook과 같은 콜백으로 처리
+	if coordinator != nil {
+		workersByID := make(map[string]*aiworker.Worker)
+		for _, worker := range manager.GetWorkers() {
+			workersByID[worker.GetConfig().ID] = worker
+		}
+		coordinator.SetHookDispatcher(hookServer.Dispatch)
+		coordinator.SetWorkDirResolver(func(workerID string) (string, bool) {
+			worker, ok := workersByID[workerID]
+			if !ok {
+				return "", false
+			}
+			return worker.GetConfig().SrcPath, true
+		})
+		coordinator.SetErrorHandler(func(workerID, message string) {
+			if worker, ok := workersByID[workerID]; ok {
+				if err := worker.Fail(ctx, "원격 에이전트 실행 실패: "+message); err != nil {
+					logger.Printf("[AI Worker] 실패 처리 오류: %v", err)
+				}
+			}
+		})
+	}
+
+	// MCP 도구는 Hook과 같은 콜백으로 처리
+	if mcp != nil {
+		mcp.registerTools(ctx, manager, hookServer.Dispatch, taskNotify)
+	}
 
 	webhookProcessor := &WebhookProcessor{manager: manager, logger: logger}
-	webhookServer := webhook.NewServer(
-		webhook.ServerConfig{
-			Port:   workerConfig.WebhookPort,
-			Secret: os.Getenv("WEBHOOK_SECRET"),
-		},
-		webhookProcessor,
-	)
+	// 웹훅 처리 풀 (WEBHOOK_WORKERS, WEBHOOK_QUEUE_SIZE, WEBHOOK_RETRY_AFTER)
+	webhookServerConfig := webhook.ServerConfig{
+		Port:   workerConfig.WebhookPort,
+		Secret: os.Getenv("WEBHOOK_SECRET"),
+	}
+	if n, err := strconv.Atoi(os.Getenv("WEBHOOK_WORKERS")); err == nil {
+		webhookServerConfig.Workers = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("WEBHOOK_QUEUE_SIZE")); err == nil {
+		webhookServerConfig.QueueSize = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_RETRY_AFTER")); err == nil {
+		webhookServerConfig.RetryAfter = d
+	}
+	webhookServer := webhook.NewServer(webhookServerConfig, webhookProcessor)
 	webhookServer.SetLogger(logger)
 
+	// 재전송 이벤트 중복 제거 (WEBHOOK_DEDUP_WINDOW, 기본 10분, 0이면 비활성화)
+	dedupWindow := 10 * time.Minute
+	if v := os.Getenv("WEBHOOK_DEDUP_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Fatalf("[AI Worker] WEBHOOK_DEDUP_WINDOW 파싱 실패: %v", err)
+		}
+		dedupWindow = d
+	}
+	if dedupWindow > 0 {
+		webhookServer.SetDeduplicator(webhook.NewDeduplicator(dedupWindow))
+	}
+
+	// 트리거 규칙 (WEBHOOK_TRIGGER_RULES, 미설정 시 생성/수정/상태 변경 모두 처리)
+	triggerRules, err := webhook.ParseTriggerRules(os.Getenv("WEBHOOK_TRIGGER_RULES"))
+	if err != nil {
+		logger.Fatalf("[AI Worker] WEBHOOK_TRIGGER_RULES 파싱 실패: %v", err)
+	}
+	if len(triggerRules) > 0 {
+		webhookServer.SetTriggerRules(triggerRules)
+		logger.Printf("[AI Worker] 웹훅 트리거 규칙: %s", os.Getenv("WEBHOOK_TRIGGER_RULES"))
+	}
+
+	// 웹훅 요청 기록 (WEBHOOK_RECORD_FILE 설정 시, ai-worker replay로 재생 가능)
+	if recordPath := os.Getenv("WEBHOOK_RECORD_FILE"); recordPath != "" {
+		if !filepath.IsAbs(recordPath) {
+			recordPath = filepath.Join(exeDir, recordPath)
+		}
+		recorderConfig := webhook.RecorderConfig{Path: recordPath}
+		if size, err := strconv.Atoi(os.Getenv("WEBHOOK_RECORD_MAX_SIZE_MB")); err == nil {
+			recorderConfig.MaxSizeMB = size
+		}
+		if backups, err := strconv.Atoi(os.Getenv("WEBHOOK_RECORD_MAX_BACKUPS")); err == nil {
+			recorderConfig.MaxBackups = backups
+		}
+		recorder := webhook.NewJSONLRecorder(recorderConfig)
+		defer recorder.Close()
+		webhookServer.SetRecorder(recorder)
+		logger.Printf("[AI Worker] 웹훅 요청 기록: %s", recordPath)
+	}
+
+	// 재시작 복구: Worker 상태 저장 및 작업중 태스크 정리 (dry-run에서는 사용하지 않음)
+	var requeue []requeueTask
+	if !opts.DryRun {
+		statePath := os.Getenv("WORKER_STATE_FILE")
+		if statePath == "" {
+			statePath = "aiworker_state.json"
+		}
+		if !filepath.IsAbs(statePath) {
+			statePath = filepath.Join(exeDir, statePath)
+		}
+		stateStore, err := aiworker.NewFileStateStore(statePath)
+		if err != nil {
+			logger.Fatalf("[AI Worker] Worker 상태 파일 로드 실패: %v", err)
+		}
+		recoveryPolicy, err := aiworker.ParseRecoveryPolicy(os.Getenv("RECOVERY_POLICY"))
+		if err != nil {
+			logger.Fatalf("[AI Worker] RECOVERY_POLICY 파싱 실패: %v", err)
+		}
+		manager.SetStateStore(stateStore)
+		logger.Printf("[AI Worker] Worker 상태 파일: %s (복구 정책: %s)", statePath, recoveryPolicy)
+		requeue = recoverTasks(ctx, manager, recoveryPolicy, taskNotify, logger)
+	}
+
 	// 서버 시작
-	errChan := make(chan error, 3)
+	errChan := make(chan error, 5)
 
 	go func() {
 		errChan <- hookServer.Start(ctx)
@@ -325,6 +595,18 @@ func main() {
 		errChan <- webhookServer.Start(ctx)
 	}()
 
+	if coordinator != nil {
+		go func() {
+			errChan <- coordinator.Start(ctx, agentPort)
+		}()
+	}
+
+	if mcp != nil {
+		go func() {
+			errChan <- mcp.server.Start(ctx, mcp.port)
+		}()
+	}
+
 	go func() {
 		manager.Start(ctx)
 		errChan <- nil
@@ -332,6 +614,24 @@ func main() {
 
 	logger.Println("[AI Worker] 모든 서비스 시작 완료")
 
+	// 정기 요약 리포트 (DIGEST_SCHEDULE 설정 시, 실행 기록 DB 필요)
+	if schedule, ok, err := loadDigestSchedule(); err != nil {
+		logger.Printf("[AI Worker] 리포트 일정 설정 오류 (리포트 생략): %v", err)
+	} else if ok && runStore != nil {
+		if channel := digestChannel(workerConfig); channel != "" {
+			startDigest(ctx, schedule, slackClient, channel, manager, runStore, logger)
+		}
+	}
+
+	// requeue 정책으로 복구된 태스크 재처리
+	for _, task := range requeue {
+		go func(task requeueTask) {
+			if err := manager.StartTask(ctx, task.worker, task.taskID); err != nil {
+				logger.Printf("[AI Worker] 복구 태스크 재처리 실패: %s: %v", task.taskID, err)
+			}
+		}(task)
+	}
+
 	// 종료 대기
 	select {
 	case sig := <-sigChan:
@@ -356,12 +656,18 @@ func loadWorkerConfig(logger *log.Logger) aiworker.Config {
 	config.TerminalType = globalTerminal
 	config.AIModelType = globalModel
 
-	// AI Worker 설정 로드 (AI_01 ~ AI_04)
-	for i := 1; i <= 4; i++ {
+	// AI Worker 설정 로드 (AI_01 ~ AI_09, 같은 LIST_ID를 쓰는 Worker는 풀로 동작)
+	for i := 1; i <= maxWorkers; i++ {
 		prefix := "AI_0" + strconv.Itoa(i)
 		listID := os.Getenv(prefix + "_LIST_ID")
 		srcPath := os.Getenv(prefix + "_SRC_PATH")
 
+		// 원격 Worker는 경로가 없으면 Hook 식별용 가상 경로 사용
+		remoteRepo := os.Getenv(prefix + "_REMOTE_REPO")
+		if remoteRepo != "" && srcPath == "" {
+			srcPath = "agent://" + remoteRepo + "/" + prefix
+		}
+
 		if listID != "" && srcPath != "" {
 			// Worker별 개별 설정 (없으면 전역 설정 사용)
 			workerTerminalStr := os.Getenv(prefix + "_TERMINAL_TYPE")
@@ -380,6 +686,10 @@ func loadWorkerConfig(logger *log.Logger) aiworker.Config {
 			config.AddWorkerWithConfig(prefix, listID, srcPath, workerTerminal, workerModel)
 			logger.Printf("[AI Worker] Worker 설정: %s (터미널: %s, AI: %s, 경로: %s)",
 				prefix, workerTerminal, workerModel, srcPath)
+			if remoteRepo != "" {
+				config.Workers[len(config.Workers)-1].RemoteRepo = remoteRepo
+				logger.Printf("[AI Worker] 원격 Worker: %s (저장소: %s)", prefix, remoteRepo)
+			}
 		}
 	}
 
@@ -401,6 +711,55 @@ func loadWorkerConfig(logger *log.Logger) aiworker.Config {
 		}
 	}
 
+	// 리스트별 SLA (전역 SLA_MAX_WAIT/SLA_MAX_RUN, Worker별 AI_XX_SLA_MAX_WAIT/AI_XX_SLA_MAX_RUN)
+	globalSLA := loadSLA("SLA", aiworker.SLA{}, logger)
+	for i := range config.Workers {
+		config.Workers[i].SLA = loadSLA(config.Workers[i].ID+"_SLA", globalSLA, logger)
+		if sla := config.Workers[i].SLA; sla.Enabled() {
+			logger.Printf("[AI Worker] SLA 설정: %s (대기: %v, 실행: %v)", config.Workers[i].ID, sla.MaxWait, sla.MaxRun)
+		}
+	}
+
+	// 태스크별 모델/모드 오버라이드 허용 정책 (전역 ALLOW_MODELS/ALLOW_MODES, Worker별 AI_XX_ALLOW_MODELS/AI_XX_ALLOW_MODES)
+	for i := range config.Workers {
+		config.Workers[i].Overrides = loadOverridePolicy(config.Workers[i].ID, logger)
+	}
+
+	// Worker별 업무 시간 (전역 WORKING_HOURS, Worker별 AI_XX_WORKING_HOURS)
+	for i := range config.Workers {
+		config.Workers[i].WorkingHours = loadWorkingHours(config.Workers[i].ID, logger)
+	}
+
+	// 풀 선점 담당자 (Worker별 AI_XX_ASSIGNEE_ID, 같은 풀에서 겹치면 선점 안함)
+	for i := range config.Workers {
+		config.Workers[i].AssigneeID = loadAssigneeID(config.Workers[i].ID, logger)
+	}
+	if os.Getenv("ASSIGNEE_ID") != "" {
+		logger.Printf("[AI Worker] ⚠️ 전역 ASSIGNEE_ID는 지원하지 않습니다 (Worker별 AI_XX_ASSIGNEE_ID 사용)")
+	}
+	disableSharedAssignees(&config, logger)
+	logPools(config, logger)
+
+	// 태스크 시작 전 사전 점검 (전역 PREFLIGHT_*, Worker별 AI_XX_PREFLIGHT_*)
+	for i := range config.Workers {
+		config.Workers[i].Preflight = loadPreflight(config.Workers[i], logger)
+	}
+
+	// 태스크 시작 전 git 준비 / 종료 후 정리 (전역 GIT_*, Worker별 AI_XX_GIT_*)
+	for i := range config.Workers {
+		config.Workers[i].GitPrep = loadGitPrep(config.Workers[i], logger)
+	}
+
+	// 완료 전 리뷰 에이전트 검토 (전역 REVIEW_*, Worker별 AI_XX_REVIEW_*)
+	for i := range config.Workers {
+		config.Workers[i].Review = loadReview(config.Workers[i], logger)
+	}
+
+	// 완료 시 TDD 규칙 점검 (전역 TDD_*, Worker별 AI_XX_TDD_*)
+	for i := range config.Workers {
+		config.Workers[i].TDD = loadTDD(config.Workers[i], logger)
+	}
+
 	// 포트 설정
 	if port := os.Getenv("WEBHOOK_PORT"); port != "" {
 		if p, err := strconv.Atoi(port); err == nil {
@@ -436,6 +795,42 @@ func loadWorkerConfig(logger *log.Logger) aiworker.Config {
 	return config
 }
 
+// loadNotifyFormatterConfig는 환경변수에서 알림 포맷 설정을 로드합니다.
+// 이벤트별 템플릿은 NOTIFY_TEMPLATE_<EVENT>_HEADER / NOTIFY_TEMPLATE_<EVENT>_BODY로 오버라이드합니다.
+// (예: NOTIFY_TEMPLATE_COMPLETED_HEADER, 본문의 "\n"은 줄바꿈으로 변환)
+func loadNotifyFormatterConfig() notifyformatter.Config {
+	config := notifyformatter.DefaultConfig()
+	config.JiraBaseURL = os.Getenv("JIRA_BASE_URL")
+	if baseURL := os.Getenv("CLICKUP_BASE_URL"); baseURL != "" {
+		config.ClickUpBaseURL = baseURL
+	}
+
+	for _, eventType := range notifyformatter.AllEventTypes {
+		prefix := "NOTIFY_TEMPLATE_" + strings.ToUpper(string(eventType))
+		tmpl := notifyformatter.Template{
+			Header: os.Getenv(prefix + "_HEADER"),
+			Body:   strings.ReplaceAll(os.Getenv(prefix+"_BODY"), `\n`, "\n"),
+		}
+		if tmpl.Header != "" || tmpl.Body != "" {
+			config.Templates[eventType] = tmpl
+		}
+	}
+
+	return config
+}
+
+// taskRunDBPath는 태스크 실행 기록 DB 경로를 반환합니다. (TASK_RUN_DB, 기본: 실행 파일 디렉토리의 task_runs.db)
+func taskRunDBPath(exeDir string) string {
+	path := os.Getenv("TASK_RUN_DB")
+	if path == "" {
+		path = "task_runs.db"
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(exeDir, path)
+	}
+	return path
+}
+
 // parseTerminalType은 문자열을 TerminalType으로 변환합니다.
 func parseTerminalType(s string) aiworker.TerminalType {
 	switch s {
@@ -466,17 +861,16 @@ type WebhookProcessor struct {
 	logger  *log.Logger
 }
 
-func (p *WebhookProcessor) EnqueueTask(taskID, listID string) {
+// EnqueueTask는 리스트 담당 Worker가 유휴 상태이면 태스크를 처리합니다.
+// 웹훅 서버의 처리 풀에서 호출되며, ctx는 서버 종료 시 취소됩니다.
+func (p *WebhookProcessor) EnqueueTask(ctx context.Context, taskID, listID string) {
 	worker := p.manager.GetWorkerByListID(listID)
 	if worker != nil && !worker.IsProcessing() {
 		p.logger.Printf("[WebhookProcessor] 태스크 처리 시작: %s", taskID)
-		// 백그라운드에서 처리
-		go func() {
-			ctx := context.Background()
-			if err := worker.ProcessTask(ctx, taskID); err != nil {
-				p.logger.Printf("[WebhookProcessor] 태스크 처리 실패: %v", err)
-			}
-		}()
+		if err := p.manager.StartTask(ctx, worker, taskID); err != nil {
+			// 슬롯 거부 시 태스크는 Worker 폴링에서 다시 시도됨
+			p.logger.Printf("[WebhookProcessor] 태스크 처리 실패: %v", err)
+		}
 	}
 }
 
@@ -484,102 +878,23 @@ func (p *WebhookProcessor) IsAIList(listID string) bool {
 	return p.manager.IsAIList(listID)
 }
 
-// sendSlackNotification는 Slack에 완료 알림을 전송합니다.
-func sendSlackNotification(ctx context.Context, client *slack.SlackClient, channelID, cwd string, manager *aiworker.Manager) {
-	if channelID == "" {
-		return
-	}
-
-	worker := manager.GetWorkerBySrcPath(cwd)
+// CancelTask는 태스크가 삭제/이동/취소되면 진행 중인 작업을 중단합니다.
+// 중단 사유는 취소 상태 전이 훅이 Slack 스레드에 알립니다.
+// 같은 Worker 리스트 안에서의 이동은 무시합니다. (webhook.TaskCanceller 구현)
+func (p *WebhookProcessor) CancelTask(ctx context.Context, taskID, destListID, reason string) bool {
+	worker := p.manager.GetWorkerByTaskID(taskID)
 	if worker == nil {
-		return
-	}
-
-	taskID := worker.GetCurrentTaskID()
-	taskName := worker.GetCurrentTaskName()
-	config := worker.GetConfig()
-
-	message := "✅ AI 작업이 완료되었습니다.\n"
-	message += "Worker: " + config.ID + "\n"
-
-	if taskName != "" {
-		message += "제목: " + taskName + "\n"
-	}
-
-	if taskID != "" {
-		message += "ClickUP: https://app.clickup.com/t/" + taskID + "\n"
-	}
-
-	// Jira 이슈 ID 추출 (ITSM-xxxx, BUGS-xxxx 등)
-	if taskName != "" {
-		re := regexp.MustCompile(`([A-Z]+-\d+)`)
-		if match := re.FindString(taskName); match != "" {
-			message += "Jira 이슈: https://kakaovx.atlassian.net/browse/" + match + "\n"
-		}
-	}
-
-	client.PostMessage(ctx, channelID, nil, message)
-}
-
-// sendSlackNotificationWithInfo는 저장된 태스크 정보로 Slack 알림을 전송합니다.
-func sendSlackNotificationWithInfo(ctx context.Context, client *slack.SlackClient, channelID, workerID, taskID, taskName, jiraID string) {
-	if channelID == "" {
-		return
+		return false
 	}
-
-	message := "✅ AI 작업이 완료되었습니다.\n"
-	message += "Worker: " + workerID + "\n"
-
-	if taskName != "" {
-		message += "제목: " + taskName + "\n"
-	}
-
-	if taskID != "" {
-		message += "ClickUP: https://app.clickup.com/t/" + taskID + "\n"
+	if destListID != "" && destListID == worker.GetConfig().ListID {
+		return false
 	}
 
-	// Jira 이슈 링크 (description에서 추출된 ID 사용)
-	if jiraID != "" {
-		message += "Jira 이슈: https://kakaovx.atlassian.net/browse/" + jiraID + "\n"
+	p.logger.Printf("[WebhookProcessor] 작업 중단: %s (Worker: %s, 사유: %s)", taskID, worker.GetConfig().ID, reason)
+	if err := worker.Abort(ctx, reason+". 진행 중인 AI 작업을 중단했습니다."); err != nil {
+		p.logger.Printf("[WebhookProcessor] %v", err)
 	}
-
-	client.PostMessage(ctx, channelID, nil, message)
-}
-
-// sendPlanReadySlackNotification는 Plan 완료 시 Slack에 검토 요청 알림을 전송합니다.
-func sendPlanReadySlackNotification(ctx context.Context, client *slack.SlackClient, channelID string, worker *aiworker.Worker, payload *hookserver.PlanReadyPayload) {
-	if channelID == "" {
-		return
-	}
-
-	config := worker.GetConfig()
-	taskID := worker.GetCurrentTaskID()
-	taskName := worker.GetCurrentTaskName()
-	jiraID := worker.GetCurrentJiraID()
-
-	message := "📋 *계획 수립 완료 - 검토 필요*\n"
-	message += "Worker: " + config.ID + "\n"
-
-	if taskName != "" {
-		message += "제목: " + taskName + "\n"
-	}
-
-	if payload.PlanTitle != "" {
-		message += "Plan: " + payload.PlanTitle + "\n"
-	}
-
-	if taskID != "" {
-		message += "ClickUP: https://app.clickup.com/t/" + taskID + "\n"
-	}
-
-	// Jira 이슈 링크
-	if jiraID != "" {
-		message += "Jira 이슈: https://kakaovx.atlassian.net/browse/" + jiraID + "\n"
-	}
-
-	message += "\n⏳ 터미널에서 계획을 검토하고 승인해주세요."
-
-	client.PostMessage(ctx, channelID, nil, message)
+	return true
 }
 
 // Stop 원인 상수
@@ -670,16 +985,3 @@ func analyzeStopReason(transcriptPath string, logger *log.Logger) StopReason {
 
 	return StopReasonUnknown
 }
-
-// sendStopEventNotification은 Stop 이벤트에 대한 Slack 알림을 전송합니다.
-func sendStopEventNotification(ctx context.Context, client *slack.SlackClient, channelID, workerID, eventType, description string) {
-	if channelID == "" {
-		return
-	}
-
-	message := eventType + "\n"
-	message += "Worker: " + workerID + "\n"
-	message += description
-
-	client.PostMessage(ctx, channelID, nil, message)
-}
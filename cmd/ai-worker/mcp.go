@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/cli"
+	"github.com/zime/slickwebhook/internal/jira"
+	"github.com/zime/slickwebhook/internal/mcpserver"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+)
+
+// defaultMCPPort는 MCP 서버 기본 포트입니다.
+const defaultMCPPort = 8083
+
+// 에이전트가 MCP 서버에 연결하는 방식
+const (
+	mcpModeHTTP  = "http"  // 에이전트가 HTTP로 직접 연결
+	mcpModeStdio = "stdio" // 에이전트가 `ai-worker mcp` 브리지를 실행해 stdio로 연결
+)
+
+// mcpSetup은 ai-worker MCP 서버와 에이전트 연결 설정입니다.
+type mcpSetup struct {
+	server    *mcpserver.Server
+	client    mcpserver.TaskClient
+	port      int
+	mode      string
+	configDir string // Worker별 에이전트 MCP 설정 파일 디렉토리
+	logger    *log.Logger
+}
+
+// newMCPSetup은 MCP 환경변수로 MCP 서버를 생성합니다. MCP가 비어있거나 off면 nil을 반환합니다.
+// MCP_SECRET이 없으면 실행마다 새 서명 키를 만들므로 재시작 전에 시작한 에이전트 세션의 토큰은 무효가 됩니다.
+func newMCPSetup(exeDir string, client aiworker.ClickUpClientInterface, logger *log.Logger) *mcpSetup {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv("MCP")))
+	switch mode {
+	case "", "off":
+		return nil
+	case mcpModeHTTP, mcpModeStdio:
+	default:
+		logger.Printf("[AI Worker] 알 수 없는 MCP 연결 방식: %q (http, stdio), MCP 사용 안함", mode)
+		return nil
+	}
+	taskClient, ok := client.(mcpserver.TaskClient)
+	if !ok {
+		logger.Printf("[AI Worker] ClickUp 클라이언트가 댓글 조회/작성을 지원하지 않아 MCP 사용 안함")
+		return nil
+	}
+
+	port := defaultMCPPort
+	if p, err := strconv.Atoi(os.Getenv("MCP_PORT")); err == nil {
+		port = p
+	}
+	secret := os.Getenv("MCP_SECRET")
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			logger.Printf("[AI Worker] MCP 서명 키 생성 실패, MCP 사용 안함: %v", err)
+			return nil
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	server := mcpserver.NewServer("ai-worker", cli.GetVersion(), secret)
+	server.SetLogger(logger)
+	logger.Printf("[AI Worker] MCP 서버: 포트 %d (연결: %s)", port, mode)
+	return &mcpSetup{
+		server:    server,
+		client:    taskClient,
+		port:      port,
+		mode:      mode,
+		configDir: filepath.Join(exeDir, "mcp"),
+		logger:    logger,
+	}
+}
+
+// url은 에이전트가 연결할 MCP 엔드포인트 주소입니다.
+func (m *mcpSetup) url() string {
+	return fmt.Sprintf("http://localhost:%d%s", m.port, mcpserver.Path)
+}
+
+// configure는 Worker 세션 토큰이 담긴 에이전트 MCP 설정 파일을 쓰고 Invoker에 연결합니다.
+// 원격 Worker는 에이전트 머신에서 실행되므로 설정하지 않습니다.
+func (m *mcpSetup) configure(worker aiworker.WorkerConfig, invoker *aiworker.DefaultInvoker) {
+	if worker.RemoteRepo != "" {
+		return
+	}
+	path, err := m.writeConfig(worker.ID)
+	if err != nil {
+		m.logger.Printf("[AI Worker] %s MCP 설정 파일 생성 실패 (curl 지시 사용): %v", worker.ID, err)
+		return
+	}
+	invoker.SetMCPConfig(path)
+	if _, ok := aimodel.GetAIModelHandler(worker.AIModelType, 0, "").(aimodel.MCPConfigurer); !ok {
+		m.logger.Printf("[AI Worker] %s 기본 모델 %s은 MCP 미지원 (curl 지시 사용, claude 실행 시에만 MCP 연결)", worker.ID, worker.AIModelType)
+	}
+}
+
+// writeConfig는 workerID 세션의 에이전트 MCP 설정 파일을 쓰고 경로를 반환합니다.
+// 세션 토큰이 들어있으므로 소유자만 읽을 수 있게 저장합니다.
+func (m *mcpSetup) writeConfig(workerID string) (string, error) {
+	token := m.server.SessionToken(workerID)
+	var entry map[string]interface{}
+	if m.mode == mcpModeStdio {
+		exe, err := os.Executable()
+		if err != nil {
+			return "", fmt.Errorf("실행 파일 경로 확인 실패: %w", err)
+		}
+		entry = map[string]interface{}{
+			"type":    "stdio",
+			"command": exe,
+			"args":    []string{"mcp"},
+			"env":     map[string]string{mcpserver.EnvURL: m.url(), mcpserver.EnvToken: token},
+		}
+	} else {
+		entry = map[string]interface{}{
+			"type":    "http",
+			"url":     m.url(),
+			"headers": map[string]string{"Authorization": "Bearer " + token},
+		}
+	}
+	data, err := json.MarshalIndent(map[string]interface{}{
+		"mcpServers": map[string]interface{}{aimodel.MCPServerName: entry},
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(m.configDir, 0700); err != nil {
+		return "", fmt.Errorf("디렉토리 생성 실패: %w", err)
+	}
+	path := filepath.Join(m.configDir, workerID+".json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("파일 저장 실패: %w", err)
+	}
+	return path, nil
+}
+
+// registerTools는 태스크 도구를 MCP 서버에 등록합니다.
+// 진행/계획/완료 도구는 Hook 이벤트(dispatch)로, 질문은 ClickUp 댓글과 태스크 스레드로 전달됩니다.
+func (m *mcpSetup) registerTools(ctx context.Context, manager *aiworker.Manager, dispatch func(event string, body []byte) error, notify *taskNotifier) {
+	workersByID := make(map[string]*aiworker.Worker)
+	for _, worker := range manager.GetWorkers() {
+		workersByID[worker.GetConfig().ID] = worker
+	}
+	tools := mcpserver.NewTaskTools(func(workerID string) (mcpserver.TaskInfo, bool) {
+		worker, ok := workersByID[workerID]
+		if !ok || !worker.IsProcessing() {
+			return mcpserver.TaskInfo{}, false
+		}
+		return mcpserver.TaskInfo{
+			WorkerID: workerID,
+			TaskID:   worker.GetCurrentTaskID(),
+			TaskName: worker.GetCurrentTaskName(),
+			JiraID:   worker.GetCurrentJiraID(),
+			SrcPath:  worker.GetConfig().SrcPath,
+		}, true
+	}, m.client, dispatch)
+	tools.SetLogger(m.logger)
+	tools.SetQuestionHandler(func(_ context.Context, task mcpserver.TaskInfo, question string) {
+		worker, ok := workersByID[task.WorkerID]
+		if !ok {
+			return
+		}
+		detail := "*질문:* " + question + "\n💬 ClickUp 태스크 댓글로 답변해주세요."
+		notify.notify(ctx, worker, newTaskNotice(worker), notifyformatter.EventQuestion, detail)
+	})
+	if jiraClient := newJiraClient(); jiraClient != nil {
+		tools.SetIssueClient(jiraClient)
+	} else {
+		m.logger.Printf("[AI Worker] JIRA_BASE_URL/JIRA_EMAIL/JIRA_API_TOKEN 미설정: MCP Jira 조회 비활성화")
+	}
+	tools.Register(m.server)
+}
+
+// newJiraClient는 Jira 환경변수로 Jira 클라이언트를 생성합니다. 하나라도 없으면 nil을 반환합니다.
+func newJiraClient() *jira.HTTPClient {
+	config := jira.ClientConfig{
+		BaseURL:  strings.TrimRight(os.Getenv("JIRA_BASE_URL"), "/"),
+		Email:    os.Getenv("JIRA_EMAIL"),
+		APIToken: os.Getenv("JIRA_API_TOKEN"),
+	}
+	if config.BaseURL == "" || config.Email == "" || config.APIToken == "" {
+		return nil
+	}
+	return jira.NewClient(config)
+}
+
+// runMCPBridge는 mcp 서브커맨드를 실행합니다.
+// 에이전트의 stdio MCP 메시지를 ai-worker MCP 서버(AI_WORKER_MCP_URL)로 전달합니다.
+// stdout은 MCP 메시지 전용이므로 로그는 stderr로 출력합니다.
+func runMCPBridge() int {
+	logger := log.New(os.Stderr, "", log.LstdFlags)
+	url, token := os.Getenv(mcpserver.EnvURL), os.Getenv(mcpserver.EnvToken)
+	if url == "" || token == "" {
+		logger.Printf("[MCP Bridge] %s, %s 설정 필요", mcpserver.EnvURL, mcpserver.EnvToken)
+		return 1
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err := mcpserver.NewBridge(url, token).Run(ctx, os.Stdin, os.Stdout); err != nil {
+		logger.Printf("[MCP Bridge] 에러: %v", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/hookserver"
+	"github.com/zime/slickwebhook/internal/notifier"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+	"github.com/zime/slickwebhook/internal/slack"
+)
+
+// taskNotice는 태스크 스레드 알림에 필요한 태스크 정보 스냅샷입니다.
+// 완료/롤백 처리로 Worker 상태가 클리어되기 전에 캡처하여 사용합니다.
+type taskNotice struct {
+	WorkerID string
+	TaskID   string
+	TaskName string
+	JiraID   string
+	ThreadTS string // 스레드 부모 메시지 타임스탬프 (없으면 첫 알림에서 생성)
+}
+
+// newTaskNotice는 Worker의 현재 태스크 정보로 taskNotice를 생성합니다.
+func newTaskNotice(worker *aiworker.Worker) taskNotice {
+	return taskNotice{
+		WorkerID: worker.GetConfig().ID,
+		TaskID:   worker.GetCurrentTaskID(),
+		TaskName: worker.GetCurrentTaskName(),
+		JiraID:   worker.GetCurrentJiraID(),
+		ThreadTS: worker.GetSlackThreadTS(),
+	}
+}
+
+// taskNotifier는 태스크별 Slack 스레드 알림을 전송합니다.
+// 태스크의 첫 알림이 스레드를 열고, 이후 이벤트는 스레드 답글로 게시되며
+// 부모 메시지는 현재 상태를 반영하도록 갱신됩니다.
+// Slack 이외의 싱크(Discord, Teams, Webhook, Email)는 레지스트리 라우팅에 따라 함께 전송됩니다.
+type taskNotifier struct {
+	client    *slack.SlackClient
+	formatter *notifyformatter.Formatter
+	registry  *notifier.Registry
+	channelID string
+	logger    *log.Logger
+
+	mu      sync.Mutex
+	threads map[string]*taskThread // 태스크 ID → 스레드 (부모/진행 메시지 생성 직렬화)
+}
+
+// taskThread는 태스크 스레드의 생성 상태입니다.
+// Working 알림, 진행 상황, 상태 전이 훅이 서로 다른 고루틴에서 동시에 스레드를 열지 않도록 mu로 직렬화합니다.
+type taskThread struct {
+	mu sync.Mutex
+	ts string // 생성한 스레드 부모 메시지 타임스탬프
+}
+
+// newTaskNotifier는 새 taskNotifier를 생성합니다.
+// registry의 Slack 라우트는 스레드 알림 여부만 결정하며, 실제 Slack 전송은 taskNotifier가 직접 처리합니다.
+func newTaskNotifier(client *slack.SlackClient, formatter *notifyformatter.Formatter, registry *notifier.Registry, channelID string, logger *log.Logger) *taskNotifier {
+	return &taskNotifier{
+		client:    client,
+		formatter: formatter,
+		registry:  registry,
+		channelID: channelID,
+		logger:    logger,
+		threads:   make(map[string]*taskThread),
+	}
+}
+
+// lockThread는 태스크 스레드를 잠그고 반환합니다. 호출자가 mu.Unlock해야 합니다.
+func (n *taskNotifier) lockThread(taskID string) *taskThread {
+	n.mu.Lock()
+	thread, ok := n.threads[taskID]
+	if !ok {
+		thread = &taskThread{}
+		n.threads[taskID] = thread
+	}
+	n.mu.Unlock()
+	thread.mu.Lock()
+	return thread
+}
+
+// forgetThread는 종료된 태스크의 스레드 상태를 정리합니다.
+func (n *taskNotifier) forgetThread(taskID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.threads, taskID)
+}
+
+// routesToSlack은 이벤트가 Slack 스레드로 라우팅되는지 확인합니다.
+func (n *taskNotifier) routesToSlack(eventType notifyformatter.EventType) bool {
+	if n.channelID == "" {
+		return false
+	}
+	return n.registry.Routes(notifyformatter.MessageEventType(eventType), notifier.SinkSlack)
+}
+
+// dispatch는 이벤트를 Slack 이외의 라우팅된 싱크로 전송합니다.
+func (n *taskNotifier) dispatch(ctx context.Context, event notifyformatter.Event) {
+	if err := n.registry.Notify(ctx, n.formatter.Message(event), notifier.SinkSlack); err != nil {
+		n.logger.Printf("[AI Worker] 알림 싱크 전송 실패: %v", err)
+	}
+}
+
+// newEvent는 taskNotice로 알림 이벤트를 생성합니다.
+func (notice taskNotice) newEvent(eventType notifyformatter.EventType, detail string) notifyformatter.Event {
+	return notifyformatter.Event{
+		Type:     eventType,
+		WorkerID: notice.WorkerID,
+		TaskID:   notice.TaskID,
+		TaskName: notice.TaskName,
+		JiraID:   notice.JiraID,
+		Detail:   detail,
+	}
+}
+
+// notify는 태스크 스레드에 이벤트를 게시합니다.
+// 부모 메시지는 eventType 상태로 생성/갱신되고, detail이 있으면 스레드 답글로 게시됩니다.
+// worker가 nil이 아니면 새로 연 스레드의 타임스탬프를 Worker에 저장합니다.
+func (n *taskNotifier) notify(ctx context.Context, worker *aiworker.Worker, notice taskNotice, eventType notifyformatter.EventType, detail string) {
+	n.dispatch(ctx, notice.newEvent(eventType, detail))
+
+	if !n.routesToSlack(eventType) {
+		return
+	}
+
+	thread := n.lockThread(notice.TaskID)
+	defer thread.mu.Unlock()
+	switch eventType {
+	case notifyformatter.EventCompleted, notifyformatter.EventFailed, notifyformatter.EventCancelled:
+		defer n.forgetThread(notice.TaskID)
+	}
+
+	threadTS := n.ensureThread(ctx, worker, thread, notice, eventType)
+	if threadTS == "" || detail == "" {
+		return
+	}
+
+	blocks, text := n.formatter.RenderReply(notice.newEvent(eventType, detail))
+	if _, err := n.client.PostThreadReply(ctx, n.channelID, threadTS, blocks, text); err != nil {
+		n.logger.Printf("[AI Worker] Slack 스레드 답글 전송 실패: %v", err)
+	}
+}
+
+// ensureThread는 스레드가 없으면 부모 메시지를 생성하고, 있으면 부모 메시지를 현재 상태로 갱신합니다.
+// thread를 잠근 상태에서 호출해야 하며, notice를 캡처한 뒤 다른 알림이 연 스레드도 이어서 사용합니다.
+// 스레드 타임스탬프를 반환하며, 실패 시 빈 문자열을 반환합니다.
+func (n *taskNotifier) ensureThread(ctx context.Context, worker *aiworker.Worker, thread *taskThread, notice taskNotice, eventType notifyformatter.EventType) string {
+	blocks, text := n.formatter.Render(notice.newEvent(eventType, ""))
+
+	threadTS := notice.ThreadTS
+	if threadTS == "" {
+		threadTS = thread.ts
+	}
+	if threadTS != "" {
+		if err := n.client.UpdateMessage(ctx, n.channelID, threadTS, blocks, text); err != nil {
+			n.logger.Printf("[AI Worker] Slack 스레드 부모 메시지 갱신 실패: %v", err)
+		}
+		thread.ts = threadTS
+		return threadTS
+	}
+
+	ts, err := n.client.PostMessageWithTS(ctx, n.channelID, blocks, text)
+	if err != nil {
+		n.logger.Printf("[AI Worker] Slack 스레드 생성 실패: %v", err)
+		return ""
+	}
+
+	thread.ts = ts
+	if worker != nil {
+		worker.SetSlackThreadTS(ts)
+	}
+	return ts
+}
+
+// notifyPlanReady는 Plan 완료 시 검토 요청을 스레드에 게시합니다.
+func (n *taskNotifier) notifyPlanReady(ctx context.Context, worker *aiworker.Worker, payload *hookserver.PlanReadyPayload) {
+	detail := ""
+	if payload.PlanTitle != "" {
+		detail += "*Plan:* " + payload.PlanTitle + "\n"
+	}
+	detail += "⏳ 터미널에서 계획을 검토하고 승인해주세요."
+
+	n.notify(ctx, worker, newTaskNotice(worker), notifyformatter.EventPlanReady, detail)
+}
+
+// notifyProgress는 진행 상황을 스레드 내 단일 메시지로 게시하고 이후에는 chat.update로 갱신합니다.
+func (n *taskNotifier) notifyProgress(ctx context.Context, worker *aiworker.Worker) {
+	notice := newTaskNotice(worker)
+	detail := buildProgressDetail(worker)
+	n.dispatch(ctx, notice.newEvent(notifyformatter.EventProgress, detail))
+
+	if !n.routesToSlack(notifyformatter.EventProgress) {
+		return
+	}
+
+	// 진행 메시지 타임스탬프 확인과 생성도 스레드 잠금 안에서 처리 (중복 게시 방지)
+	thread := n.lockThread(notice.TaskID)
+	defer thread.mu.Unlock()
+
+	threadTS := n.ensureThread(ctx, worker, thread, notice, notifyformatter.EventWorking)
+	if threadTS == "" {
+		return
+	}
+
+	blocks, text := n.formatter.RenderReply(notice.newEvent(notifyformatter.EventProgress, detail))
+
+	if ts := worker.GetProgressMessageTS(); ts != "" {
+		if err := n.client.UpdateMessage(ctx, n.channelID, ts, blocks, text); err != nil {
+			n.logger.Printf("[AI Worker] 진행 상황 메시지 갱신 실패: %v", err)
+		}
+		return
+	}
+
+	ts, err := n.client.PostThreadReply(ctx, n.channelID, threadTS, blocks, text)
+	if err != nil {
+		n.logger.Printf("[AI Worker] 진행 상황 메시지 전송 실패: %v", err)
+		return
+	}
+	worker.SetProgressMessageTS(ts)
+}
+
+// progressStageLabels는 진행 단계별 Slack 표시 문구입니다.
+var progressStageLabels = map[string]string{
+	hookserver.StageInvestigated: "🔍 원인 조사 완료",
+	hookserver.StagePlanApproved: "📋 계획 승인",
+	hookserver.StageTestsWritten: "🧪 테스트 작성",
+	hookserver.StageImplemented:  "🛠️ 구현 완료",
+	hookserver.StageTestsPassed:  "✅ 테스트 통과",
+}
+
+// buildProgressDetail은 Worker의 진행 단계 목록으로 진행 상황 본문을 생성합니다.
+func buildProgressDetail(worker *aiworker.Worker) string {
+	detail := ""
+
+	for _, entry := range worker.GetProgress() {
+		label, ok := progressStageLabels[entry.Stage]
+		if !ok {
+			label = "• " + entry.Stage
+		}
+		detail += label + " (" + entry.ReportedAt.Format("15:04:05") + ")"
+		if entry.Message != "" {
+			detail += " - " + entry.Message
+		}
+		detail += "\n"
+	}
+
+	detail += "\n마지막 heartbeat: " + worker.GetLastHeartbeat().Format("15:04:05")
+
+	return detail
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// runOptions는 실행 옵션입니다.
+type runOptions struct {
+	DryRun         bool          // ClickUp 변경/AI 도구 실행 없이 로그와 파일로만 기록
+	FixturePath    string        // dry-run 태스크 픽스처 JSON (없으면 ClickUp에서 조회)
+	DryRunDir      string        // 프롬프트/실행 스크립트 저장 디렉토리
+	FakeAgent      bool          // 가짜 에이전트로 Hook 엔드포인트 호출
+	FakeAgentDelay time.Duration // 가짜 에이전트 단계 사이 대기 시간
+}
+
+// parseRunOptions는 명령줄 인자에서 실행 옵션을 파싱합니다.
+// --help/--version 등 공통 옵션은 cli.ParseArgs에서 먼저 처리됩니다.
+func parseRunOptions(args []string, defaultDryRunDir string) (runOptions, error) {
+	var opts runOptions
+
+	fs := flag.NewFlagSet("ai-worker", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "ClickUp 변경/AI 도구 실행 없이 시뮬레이션")
+	fs.StringVar(&opts.FixturePath, "fixture", "", "dry-run 태스크 픽스처 JSON 경로")
+	fs.StringVar(&opts.DryRunDir, "dry-run-dir", defaultDryRunDir, "프롬프트/실행 스크립트 저장 디렉토리")
+	fs.BoolVar(&opts.FakeAgent, "fake-agent", false, "가짜 에이전트로 Hook 엔드포인트 호출")
+	fs.DurationVar(&opts.FakeAgentDelay, "fake-agent-delay", 2*time.Second, "가짜 에이전트 단계 사이 대기 시간")
+
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+
+	// --fixture/--fake-agent는 dry-run에서만 의미가 있으므로 dry-run을 함께 활성화
+	if opts.FixturePath != "" || opts.FakeAgent {
+		opts.DryRun = true
+	}
+
+	return opts, nil
+}
+
+// newDryRunClickUpClient는 dry-run용 ClickUp 클라이언트를 생성합니다.
+// 픽스처가 지정되면 ClickUp에 접속하지 않고 픽스처만 사용합니다.
+func newDryRunClickUpClient(opts runOptions, source aiworker.ClickUpClientInterface, logger *log.Logger) *aiworker.DryRunClickUpClient {
+	if opts.FixturePath == "" {
+		logger.Printf("[AI Worker] 🧪 DRY-RUN 모드: ClickUp에서 조회, 변경 작업은 로그로만 기록")
+		return aiworker.NewDryRunClickUpClient(source, nil, logger)
+	}
+
+	fixtures, err := aiworker.LoadTaskFixture(opts.FixturePath)
+	if err != nil {
+		logger.Fatalf("[AI Worker] %v", err)
+	}
+	logger.Printf("[AI Worker] 🧪 DRY-RUN 모드: 픽스처 %s (%d개 태스크)", opts.FixturePath, len(fixtures))
+	return aiworker.NewDryRunClickUpClient(nil, fixtures, logger)
+}
+
+// newDryRunInvoker는 dry-run용 Invoker를 생성합니다.
+// --fake-agent이면 실행 후 가짜 에이전트가 Hook 서버를 호출합니다.
+func newDryRunInvoker(opts runOptions, renderer *aiworker.DefaultInvoker, hookServerPort int, logger *log.Logger) *aiworker.DryRunInvoker {
+	invoker := aiworker.NewDryRunInvoker(renderer, opts.DryRunDir, logger)
+	if opts.FakeAgent {
+		baseURL := fmt.Sprintf("http://localhost:%d", hookServerPort)
+		invoker.SetFakeAgent(aiworker.NewFakeAgent(baseURL, opts.FakeAgentDelay, logger))
+	}
+	return invoker
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// loadOverridePolicy는 Worker의 태스크별 오버라이드 허용 정책을 로드합니다.
+// AI_XX_ALLOW_MODELS/AI_XX_ALLOW_MODES가 없으면 ALLOW_MODELS/ALLOW_MODES를 사용하며, 둘 다 없으면 오버라이드를 허용하지 않습니다.
+func loadOverridePolicy(workerID string, logger *log.Logger) aiworker.OverridePolicy {
+	var policy aiworker.OverridePolicy
+
+	for _, value := range splitList(envOr(workerID+"_ALLOW_MODELS", "ALLOW_MODELS")) {
+		value = strings.ToLower(value)
+		model := parseAIModelType(value)
+		if string(model) != value {
+			logger.Printf("[AI Worker] %s 허용 모델 무시: %q", workerID, value)
+			continue
+		}
+		policy.Models = append(policy.Models, model)
+	}
+
+	for _, value := range splitList(envOr(workerID+"_ALLOW_MODES", "ALLOW_MODES")) {
+		mode, err := aiworker.ParseAgentMode(value)
+		if err != nil {
+			logger.Printf("[AI Worker] %s 허용 모드 무시: %v", workerID, err)
+			continue
+		}
+		policy.Modes = append(policy.Modes, mode)
+	}
+
+	if len(policy.Models) > 0 || len(policy.Modes) > 0 {
+		logger.Printf("[AI Worker] 태스크 오버라이드 허용: %s (모델: %v, 모드: %v)", workerID, policy.Models, policy.Modes)
+	}
+	return policy
+}
+
+// splitList는 콤마 구분 문자열을 공백을 제거한 목록으로 나눕니다.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
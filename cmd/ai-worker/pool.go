@@ -0,0 +1,82 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// maxWorkers는 AI_01 ~ AI_09 형식으로 설정할 수 있는 최대 Worker 수입니다.
+const maxWorkers = 9
+
+// loadAssigneeID는 Worker의 풀 선점 담당자 ID(AI_XX_ASSIGNEE_ID)를 로드합니다.
+// 선점은 담당자로 Worker를 구분하므로 전역 기본값 없이 Worker마다 지정해야 하며, 없으면 ClickUp 선점을 하지 않습니다.
+func loadAssigneeID(workerID string, logger *log.Logger) int {
+	key := workerID + "_ASSIGNEE_ID"
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		logger.Printf("[AI Worker] %s 파싱 실패 (ClickUp 선점 안함): %q", key, value)
+		return 0
+	}
+	return id
+}
+
+// disableSharedAssignees는 같은 풀에서 담당자 ID가 겹치는 Worker의 ClickUp 선점을 끕니다.
+// 담당자가 같으면 ClickUp에서 누가 선점했는지 구분할 수 없어 선점이 원자적이지 않습니다.
+func disableSharedAssignees(config *aiworker.Config, logger *log.Logger) {
+	shared := make(map[string][]int) // "리스트 ID/담당자 ID" → Worker 인덱스
+	for i, wc := range config.Workers {
+		if wc.AssigneeID != 0 {
+			key := wc.ListID + "/" + strconv.Itoa(wc.AssigneeID)
+			shared[key] = append(shared[key], i)
+		}
+	}
+	for i, wc := range config.Workers {
+		indexes := shared[wc.ListID+"/"+strconv.Itoa(wc.AssigneeID)]
+		if wc.AssigneeID == 0 || len(indexes) < 2 {
+			continue
+		}
+		logger.Printf("[AI Worker] ⚠️ 풀 Worker %s의 담당자 ID %d가 같은 풀의 다른 Worker와 같아 ClickUp 선점을 끕니다 (Worker마다 다른 AI_XX_ASSIGNEE_ID 필요)", wc.ID, wc.AssigneeID)
+		config.Workers[i].AssigneeID = 0
+	}
+}
+
+// logPools는 리스트를 공유하는 Worker 풀 구성을 기록합니다.
+// Hook은 cwd로 Worker를 찾으므로 같은 풀에서 SRC_PATH가 겹치면 경고합니다.
+func logPools(config aiworker.Config, logger *log.Logger) {
+	pools := make(map[string][]aiworker.WorkerConfig)
+	var listIDs []string
+	for _, wc := range config.Workers {
+		if _, ok := pools[wc.ListID]; !ok {
+			listIDs = append(listIDs, wc.ListID)
+		}
+		pools[wc.ListID] = append(pools[wc.ListID], wc)
+	}
+
+	for _, listID := range listIDs {
+		pool := pools[listID]
+		if len(pool) < 2 {
+			continue
+		}
+
+		ids := make([]string, len(pool))
+		srcPaths := make(map[string]string)
+		for i, wc := range pool {
+			ids[i] = wc.ID
+			if other, ok := srcPaths[wc.SrcPath]; ok {
+				logger.Printf("[AI Worker] ⚠️ 풀 Worker %s, %s의 SRC_PATH가 같음 (Worker별 클론/worktree 필요): %s", other, wc.ID, wc.SrcPath)
+			}
+			srcPaths[wc.SrcPath] = wc.ID
+			if wc.AssigneeID == 0 {
+				logger.Printf("[AI Worker] ⚠️ 풀 Worker %s에 선점 담당자 ID가 없어 ClickUp 선점 없이 프로세스 안에서만 중복을 방지합니다", wc.ID)
+			}
+		}
+		logger.Printf("[AI Worker] Worker 풀: 리스트 %s → %v", listID, ids)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+)
+
+// loadPreflight는 Worker의 사전 점검 설정을 로드합니다. (Worker별 AI_XX_PREFLIGHT_* 우선)
+// PREFLIGHT=off이거나 원격 Worker(경로가 에이전트 머신에 있음)면 nil을 반환합니다.
+func loadPreflight(worker aiworker.WorkerConfig, logger *log.Logger) *aiworker.PreflightConfig {
+	prefix := worker.ID + "_"
+	if strings.EqualFold(envOr(prefix+"PREFLIGHT", "PREFLIGHT"), "off") || worker.RemoteRepo != "" {
+		return nil
+	}
+
+	config := &aiworker.PreflightConfig{
+		DirtyPolicy: aiworker.DirtyFail,
+		BaseBranch:  envOr(prefix+"PREFLIGHT_BASE_BRANCH", "PREFLIGHT_BASE_BRANCH"),
+		MinFreeMB:   aiworker.DefaultMinFreeMB,
+		Tools:       []string{"ffmpeg"},
+	}
+
+	switch policy := aiworker.DirtyPolicy(strings.ToLower(envOr(prefix+"PREFLIGHT_DIRTY", "PREFLIGHT_DIRTY"))); policy {
+	case "":
+	case aiworker.DirtyFail, aiworker.DirtyStash:
+		config.DirtyPolicy = policy
+	default:
+		logger.Printf("[AI Worker] %s 알 수 없는 PREFLIGHT_DIRTY (fail 사용): %s", worker.ID, policy)
+	}
+
+	if value := envOr(prefix+"PREFLIGHT_MIN_FREE_MB", "PREFLIGHT_MIN_FREE_MB"); value != "" {
+		mb, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			logger.Printf("[AI Worker] %s PREFLIGHT_MIN_FREE_MB 파싱 실패 (기본값 사용): %q", worker.ID, value)
+		} else {
+			config.MinFreeMB = mb
+		}
+	}
+
+	// 빈 값으로 설정하면 에이전트 외 도구는 확인하지 않음
+	for _, key := range []string{prefix + "PREFLIGHT_TOOLS", "PREFLIGHT_TOOLS"} {
+		if value, ok := os.LookupEnv(key); ok {
+			config.Tools = splitList(value)
+			break
+		}
+	}
+
+	logger.Printf("[AI Worker] 사전 점검: %s (변경: %s, 기준 브랜치: %q, 최소 여유: %dMB, 도구: %v)",
+		worker.ID, config.DirtyPolicy, config.BaseBranch, config.MinFreeMB, config.Tools)
+	return config
+}
+
+// preflightAlert는 사전 점검 실패를 태스크 스레드와 별개의 채널 메시지로 알립니다.
+// 태스크 상태는 바뀌지 않았으므로 태스크 스레드를 만들지 않습니다.
+func preflightAlert(notify *taskNotifier) func(ctx context.Context, failure *aiworker.PreflightError) {
+	return func(ctx context.Context, failure *aiworker.PreflightError) {
+		event := notifyformatter.Event{
+			Type:     notifyformatter.EventPreflightFailed,
+			WorkerID: failure.WorkerID,
+			TaskID:   failure.TaskID,
+			TaskName: failure.TaskName,
+			Detail:   preflightDetail(failure),
+			Time:     time.Now(),
+		}
+
+		n := notify
+		n.dispatch(ctx, event)
+		if !n.routesToSlack(event.Type) {
+			return
+		}
+		blocks, text := n.formatter.Render(event)
+		if _, err := n.client.PostMessageWithTS(ctx, n.channelID, blocks, text); err != nil {
+			n.logger.Printf("[AI Worker] 사전 점검 알림 전송 실패: %v", err)
+		}
+	}
+}
+
+// preflightDetail은 사전 점검 실패 알림 본문을 생성합니다.
+func preflightDetail(failure *aiworker.PreflightError) string {
+	var b strings.Builder
+	b.WriteString("태스크를 시작하지 않았습니다. 아래 항목을 해결하면 다음 폴링에서 다시 점검합니다.\n")
+	for _, reason := range failure.Failures {
+		fmt.Fprintf(&b, "• %s\n", reason)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
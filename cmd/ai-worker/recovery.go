@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+)
+
+// requeueTask는 requeue 정책으로 복구되어 재처리할 태스크입니다.
+type requeueTask struct {
+	worker *aiworker.Worker
+	taskID string
+}
+
+// recoverTasks는 재시작 시 작업중 태스크를 정리하고 결과를 태스크 스레드에 알립니다.
+// requeue 결과 태스크 목록을 반환하며, 호출자가 서버 시작 후 재처리합니다.
+func recoverTasks(ctx context.Context, manager *aiworker.Manager, policy aiworker.RecoveryPolicy, notify *taskNotifier, logger *log.Logger) []requeueTask {
+	results, err := manager.Reconcile(ctx, policy)
+	if err != nil {
+		logger.Printf("[AI Worker] 작업중 태스크 복구 실패: %v", err)
+	}
+
+	var requeue []requeueTask
+	for _, result := range results {
+		logger.Printf("[AI Worker] 태스크 복구: %s (Worker: %s, 결과: %s)", result.TaskID, result.WorkerID, result.Action)
+
+		notice := taskNotice{
+			WorkerID: result.WorkerID,
+			TaskID:   result.TaskID,
+			TaskName: result.TaskName,
+			JiraID:   result.JiraID,
+			ThreadTS: result.SlackThreadTS,
+		}
+		eventType := notifyformatter.EventRecovered
+		var detail string
+
+		switch result.Action {
+		case aiworker.RecoveryReattached:
+			eventType = notifyformatter.EventWorking
+			detail = "AI Worker 재시작 후 실행 중인 에이전트에 다시 연결했습니다."
+		case aiworker.RecoveryRolledBack:
+			detail = "AI Worker 재시작으로 중단된 작업의 상태를 롤백했습니다. (원래 상태: " + result.OriginalStatus + ")"
+		case aiworker.RecoveryRequeued:
+			detail = "AI Worker 재시작으로 중단된 작업을 다시 시작합니다."
+			if worker := findWorker(manager, result.WorkerID); worker != nil {
+				requeue = append(requeue, requeueTask{worker: worker, taskID: result.TaskID})
+			}
+		case aiworker.RecoveryHeld:
+			detail = "AI Worker 재시작으로 중단된 작업을 " + aiworker.StatusHold + " 상태로 변경했습니다. 확인 후 다시 진행해 주세요."
+		default:
+			eventType = notifyformatter.EventFailed
+			detail = "AI Worker 재시작 후 작업 복구 실패: " + result.Err.Error()
+		}
+
+		notify.notify(ctx, nil, notice, eventType, detail)
+	}
+	return requeue
+}
+
+// findWorker는 Worker ID로 Worker를 찾습니다.
+func findWorker(manager *aiworker.Manager, workerID string) *aiworker.Worker {
+	for _, worker := range manager.GetWorkers() {
+		if worker.GetConfig().ID == workerID {
+			return worker
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/webhook"
+)
+
+// replayProcessor는 재생용 프로세서입니다.
+// 태스크를 실제로 처리하지 않고 AI 리스트 판정과 작업 중 여부 추적만 수행합니다.
+type replayProcessor struct {
+	aiLists  map[string]bool
+	inFlight map[string]bool // 재생 중 큐에 추가되고 아직 중단되지 않은 태스크
+}
+
+// EnqueueTask는 태스크를 작업 중으로 기록만 합니다. (라우팅 결정은 Decision으로 확인)
+func (p *replayProcessor) EnqueueTask(ctx context.Context, taskID, listID string) {
+	p.inFlight[taskID] = true
+}
+
+// CancelTask는 재생 중 큐에 추가된 태스크면 작업 중 기록을 지우고 true를 반환합니다.
+// 기록 파일 이전에 시작된 작업은 알 수 없으므로 중단 대상으로 보지 않습니다.
+func (p *replayProcessor) CancelTask(ctx context.Context, taskID, destListID, reason string) bool {
+	if !p.inFlight[taskID] {
+		return false
+	}
+	delete(p.inFlight, taskID)
+	return true
+}
+
+// IsAIList는 리스트가 재생 설정의 AI 리스트인지 확인합니다.
+func (p *replayProcessor) IsAIList(listID string) bool {
+	return p.aiLists[listID]
+}
+
+// runReplay는 기록된 웹훅 요청을 processEvent로 다시 처리하고 결과를 출력합니다.
+// 사용법: ai-worker replay [--lists L1,L2] [--rules R1,R2] [--only-diff] [-v] <file.jsonl>
+// 종료 코드: 0 (성공), 1 (기록 파일 오류), 2 (인자 오류)
+func runReplay(args []string, config aiworker.Config, out io.Writer) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.SetOutput(out)
+	lists := fs.String("lists", "", "AI 리스트 ID (콤마 구분, 기본: 설정 파일의 Worker 리스트)")
+	onlyDiff := fs.Bool("only-diff", false, "기록 당시와 라우팅 결정이 다른 요청만 출력")
+	rulesSpec := fs.String("rules", os.Getenv("WEBHOOK_TRIGGER_RULES"), "트리거 규칙 (기본: WEBHOOK_TRIGGER_RULES)")
+	verbose := fs.Bool("v", false, "processEvent 결정 로그 출력")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(out, "사용법: ai-worker replay [--lists L1,L2] [--rules R1,R2] [--only-diff] [-v] <file.jsonl>")
+		return 2
+	}
+
+	processor := &replayProcessor{aiLists: make(map[string]bool), inFlight: make(map[string]bool)}
+	if *lists != "" {
+		for _, id := range strings.Split(*lists, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				processor.aiLists[id] = true
+			}
+		}
+	} else {
+		for _, w := range config.Workers {
+			processor.aiLists[w.ListID] = true
+		}
+	}
+
+	rules, err := webhook.ParseTriggerRules(*rulesSpec)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 2
+	}
+
+	records, err := webhook.ReadRecords(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+
+	// 재생은 기록 파일 전체를 한 번에 처리하므로 중복 제거는 적용하지 않음
+	handler := webhook.NewHandler(processor, "")
+	handler.SetTriggerRules(rules)
+	if *verbose {
+		handler.SetLogger(log.New(out, "", 0))
+	}
+
+	counts := make(map[string]int)
+	changed := 0
+
+	for i := range records {
+		rec := &records[i]
+		decision, _ := handler.Replay(rec)
+		counts[decision.Action]++
+
+		diff := decision.Action != rec.Decision.Action
+		if diff {
+			changed++
+		} else if *onlyDiff {
+			continue
+		}
+
+		reason := decision.Reason
+		if decision.Rule != "" {
+			reason += ": " + decision.Rule
+		}
+
+		marker := " "
+		if diff {
+			marker = "*"
+		}
+		fmt.Fprintf(out, "%s %s %-20s task=%-12s list=%-14s %s → %s (%s)\n",
+			marker,
+			rec.Time.Format("2006-01-02 15:04:05"),
+			decision.Event,
+			decision.TaskID,
+			decision.ListID,
+			rec.Decision.Action,
+			decision.Action,
+			reason,
+		)
+	}
+
+	fmt.Fprintf(out, "\n총 %d건: enqueued=%d, cancelled=%d, ignored=%d, rejected=%d, 결정 변경=%d\n",
+		len(records), counts[webhook.ActionEnqueued], counts[webhook.ActionCancelled], counts[webhook.ActionIgnored], counts[webhook.ActionRejected], changed)
+
+	return 0
+}
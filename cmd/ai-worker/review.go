@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// loadReview는 Worker의 완료 전 리뷰 설정을 로드합니다. (Worker별 AI_XX_REVIEW_* 우선)
+// REVIEW_MODEL이 비어있거나 원격 Worker(리뷰 에이전트를 실행할 수 없음)면 nil을 반환합니다.
+func loadReview(worker aiworker.WorkerConfig, logger *log.Logger) *aiworker.ReviewConfig {
+	prefix := worker.ID + "_"
+	value := strings.ToLower(envOr(prefix+"REVIEW_MODEL", "REVIEW_MODEL"))
+	if value == "" || value == "off" || worker.RemoteRepo != "" {
+		return nil
+	}
+	model := parseAIModelType(value)
+	if string(model) != value {
+		logger.Printf("[AI Worker] %s 리뷰 모델 오류 (리뷰 안함): %q", worker.ID, value)
+		return nil
+	}
+
+	config := &aiworker.ReviewConfig{Model: model, MaxRounds: aiworker.DefaultReviewMaxRounds}
+	if value := envOr(prefix+"REVIEW_MAX_ROUNDS", "REVIEW_MAX_ROUNDS"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			logger.Printf("[AI Worker] %s REVIEW_MAX_ROUNDS 파싱 실패 (기본값 사용): %q", worker.ID, value)
+		} else {
+			config.MaxRounds = n
+		}
+	}
+	if path := envOr(prefix+"REVIEW_PROMPT_FILE", "REVIEW_PROMPT_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			logger.Printf("[AI Worker] %s 리뷰 프롬프트 파일 읽기 실패 (기본 프롬프트 사용): %v", worker.ID, err)
+		} else {
+			config.PromptTemplate = string(data)
+		}
+	}
+	logger.Printf("[AI Worker] 코드 리뷰: %s (모델: %s, 수정 요청 최대 %d회)", worker.ID, model, config.MaxRounds)
+	return config
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/hookserver"
+	"github.com/zime/slickwebhook/internal/store"
+)
+
+// runRecorder는 태스크 상태 전이를 실행 기록 저장소에 기록합니다.
+// 준비 단계 진입 시 실행을 시작하고, 종료 상태 진입 시 결과(토큰 사용량, 검증 결과 포함)를 기록합니다.
+type runRecorder struct {
+	store  store.TaskRunStore
+	logger *log.Logger
+
+	mu     sync.Mutex
+	active map[string]*activeRun // Worker ID → 진행 중인 실행
+}
+
+// activeRun은 진행 중인 실행 정보입니다.
+type activeRun struct {
+	id             int64
+	transcriptPath string // 마지막 Stop Hook의 transcript 경로 (토큰 집계용)
+}
+
+// newRunRecorder는 새 runRecorder를 생성합니다.
+func newRunRecorder(runStore store.TaskRunStore, logger *log.Logger) *runRecorder {
+	return &runRecorder{
+		store:  runStore,
+		logger: logger,
+		active: make(map[string]*activeRun),
+	}
+}
+
+// register는 모든 Worker의 상태 전이에 실행 기록을 연결합니다.
+func (r *runRecorder) register(manager *aiworker.Manager) {
+	manager.OnEnter(aiworker.StatePreparing, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+		r.start(w, t)
+	})
+	for _, state := range []aiworker.TaskState{aiworker.StateCompleted, aiworker.StateFailed, aiworker.StateCancelled} {
+		manager.OnEnter(state, func(ctx context.Context, w *aiworker.Worker, t aiworker.Transition) {
+			r.finish(w, t)
+		})
+	}
+}
+
+// start는 실행 시작을 기록합니다.
+func (r *runRecorder) start(w *aiworker.Worker, t aiworker.Transition) {
+	config := w.GetConfig()
+	id, err := r.store.StartRun(store.TaskRun{
+		TaskID:    w.GetCurrentTaskID(),
+		JiraID:    w.GetCurrentJiraID(),
+		ListID:    config.ListID,
+		WorkerID:  config.ID,
+		Model:     string(w.RunOptions().Model),
+		Terminal:  string(config.TerminalType),
+		StartedAt: t.At,
+	})
+	if err != nil {
+		r.logger.Printf("[AI Worker] 실행 기록 시작 실패: %v", err)
+		return
+	}
+
+	r.mu.Lock()
+	r.active[config.ID] = &activeRun{id: id}
+	r.mu.Unlock()
+}
+
+// recordStop은 Stop Hook 원인과 transcript 경로를 기록합니다.
+func (r *runRecorder) recordStop(w *aiworker.Worker, reason StopReason, transcriptPath string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	run := r.active[w.GetConfig().ID]
+	if run != nil && transcriptPath != "" {
+		run.transcriptPath = transcriptPath
+	}
+	r.mu.Unlock()
+
+	if run == nil {
+		return
+	}
+	if err := r.store.AddStopReason(run.id, string(reason)); err != nil {
+		r.logger.Printf("[AI Worker] %v", err)
+	}
+}
+
+// finish는 실행 종료 결과를 기록합니다.
+func (r *runRecorder) finish(w *aiworker.Worker, t aiworker.Transition) {
+	workerID := w.GetConfig().ID
+
+	r.mu.Lock()
+	run := r.active[workerID]
+	delete(r.active, workerID)
+	r.mu.Unlock()
+
+	if run == nil {
+		return // 재시작 전에 시작된 실행 등
+	}
+
+	result := store.TaskRunResult{
+		FinalState: string(t.To),
+		Reason:     t.Reason,
+		EndedAt:    t.At,
+	}
+	result.InputTokens, result.OutputTokens = transcriptUsage(run.transcriptPath)
+	for _, entry := range w.GetProgress() {
+		if entry.Stage == hookserver.StageTestsPassed {
+			result.Verification = entry.Stage
+		}
+	}
+
+	if err := r.store.FinishRun(run.id, result); err != nil {
+		r.logger.Printf("[AI Worker] 실행 기록 종료 실패: %v", err)
+	}
+}
+
+// transcriptUsage는 transcript 파일에서 토큰 사용량을 집계합니다.
+// 같은 메시지가 여러 줄로 기록될 수 있어 메시지 ID 기준으로 한 번만 더합니다.
+func transcriptUsage(path string) (input, output int64) {
+	if path == "" {
+		return 0, 0
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0
+	}
+	defer file.Close()
+
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !strings.Contains(string(line), `"usage"`) {
+			continue
+		}
+		var entry struct {
+			Message struct {
+				ID    string `json:"id"`
+				Usage struct {
+					InputTokens  int64 `json:"input_tokens"`
+					OutputTokens int64 `json:"output_tokens"`
+				} `json:"usage"`
+			} `json:"message"`
+		}
+		if json.Unmarshal(line, &entry) != nil {
+			continue
+		}
+		if id := entry.Message.ID; id != "" {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+		}
+		input += entry.Message.Usage.InputTokens
+		output += entry.Message.Usage.OutputTokens
+	}
+	return input, output
+}
+
+// runsUsage는 runs 서브커맨드 사용법입니다.
+const runsUsage = `사용법: ai-worker runs [--since 7d] [--worker AI_02] [--list ID] [--model claude] [--format table|csv] [--detail]`
+
+// runRuns는 태스크 실행 기록의 리스트/모델별 통계(성공률, 평균 완료 시간, 실패 분류)를 출력합니다.
+// --detail이면 통계 대신 개별 실행 기록을 출력합니다.
+// 종료 코드: 0 (성공), 1 (DB 오류), 2 (인자 오류)
+func runRuns(args []string, dbPath string, out io.Writer) int {
+	fs := flag.NewFlagSet("runs", flag.ContinueOnError)
+	fs.SetOutput(out)
+	since := fs.String("since", "7d", "조회 기간 (예: 7d, 24h)")
+	workerID := fs.String("worker", "", "Worker ID")
+	listID := fs.String("list", "", "ClickUp 리스트 ID")
+	model := fs.String("model", "", "AI 모델")
+	format := fs.String("format", "table", "출력 형식 (table, csv)")
+	detail := fs.Bool("detail", false, "개별 실행 기록 출력")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	period, err := parseSince(*since)
+	if err != nil || (*format != "table" && *format != "csv") {
+		fmt.Fprintln(out, runsUsage)
+		return 2
+	}
+
+	runStore, err := store.NewSQLiteTaskRunStore(dbPath)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+	defer runStore.Close()
+
+	runs, err := runStore.ListRuns(store.TaskRunFilter{
+		Since:    time.Now().Add(-period),
+		WorkerID: *workerID,
+		ListID:   *listID,
+		Model:    *model,
+	})
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+
+	var header []string
+	var rows [][]string
+	if *detail {
+		header, rows = runRows(runs)
+	} else {
+		header = []string{"group", "key", "total", "completed", "failed", "cancelled", "running",
+			"success_rate", "mean_time_to_complete", "input_tokens", "output_tokens", "failures"}
+		rows = append(rows, summaryRows("list", store.SummarizeTaskRuns(runs, func(r store.TaskRun) string { return r.ListID }))...)
+		rows = append(rows, summaryRows("model", store.SummarizeTaskRuns(runs, func(r store.TaskRun) string { return r.Model }))...)
+	}
+
+	if *format == "csv" {
+		w := csv.NewWriter(out)
+		w.Write(header)
+		w.WriteAll(rows)
+		if err := w.Error(); err != nil {
+			fmt.Fprintf(out, "❌ %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+	fmt.Fprintf(out, "\n총 %d건 (최근 %s)\n", len(runs), *since)
+	return 0
+}
+
+// summaryRows는 통계를 출력 행으로 변환합니다.
+func summaryRows(group string, summaries []store.TaskRunSummary) [][]string {
+	var rows [][]string
+	for _, s := range summaries {
+		causes := make([]string, 0, len(s.Failures))
+		for cause, n := range s.Failures {
+			causes = append(causes, cause+"="+strconv.Itoa(n))
+		}
+		sort.Strings(causes)
+
+		rows = append(rows, []string{
+			group, s.Key,
+			strconv.Itoa(s.Total), strconv.Itoa(s.Completed), strconv.Itoa(s.Failed),
+			strconv.Itoa(s.Cancelled), strconv.Itoa(s.Running),
+			fmt.Sprintf("%.1f%%", s.SuccessRate*100), s.MeanTimeToComplete.Round(time.Second).String(),
+			strconv.FormatInt(s.InputTokens, 10), strconv.FormatInt(s.OutputTokens, 10),
+			strings.Join(causes, " "),
+		})
+	}
+	return rows
+}
+
+// runRows는 개별 실행 기록을 출력 행으로 변환합니다.
+func runRows(runs []store.TaskRun) ([]string, [][]string) {
+	header := []string{"id", "task_id", "jira_id", "list_id", "worker", "model", "terminal", "started_at",
+		"duration", "final_state", "stop_reasons", "input_tokens", "output_tokens", "verification", "reason"}
+
+	var rows [][]string
+	for _, r := range runs {
+		state := r.FinalState
+		if state == "" {
+			state = "running"
+		}
+		rows = append(rows, []string{
+			strconv.FormatInt(r.ID, 10), r.TaskID, r.JiraID, r.ListID, r.WorkerID, r.Model, r.Terminal,
+			r.StartedAt.Local().Format(time.RFC3339), r.Duration().Round(time.Second).String(), state,
+			strings.Join(r.StopReasons, ","), strconv.FormatInt(r.InputTokens, 10),
+			strconv.FormatInt(r.OutputTokens, 10), r.Verification, r.Reason,
+		})
+	}
+	return header, rows
+}
+
+// parseSince는 조회 기간을 파싱합니다. Go duration 외에 일 단위(예: 7d)를 지원합니다.
+func parseSince(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("잘못된 기간: %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("잘못된 기간: %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// loadSchedulerConfig는 환경변수에서 에이전트 실행 스케줄러 설정을 로드합니다.
+// AGENT_MAX_CONCURRENT, AGENT_MAX_CONCURRENT_<MODEL>(예: _CLAUDE), AGENT_DAILY_BUDGET, WORKING_TIMEZONE
+func loadSchedulerConfig(logger *log.Logger) aiworker.SchedulerConfig {
+	config := aiworker.SchedulerConfig{
+		MaxConcurrent: envInt("AGENT_MAX_CONCURRENT", logger),
+		ModelLimits:   make(map[aimodel.AIModelType]int),
+		DailyBudget:   envInt("AGENT_DAILY_BUDGET", logger),
+	}
+	for _, model := range []aimodel.AIModelType{aiworker.AIModelClaude, aiworker.AIModelOpenCode, aiworker.AIModelAmpcode} {
+		if limit := envInt("AGENT_MAX_CONCURRENT_"+strings.ToUpper(string(model)), logger); limit > 0 {
+			config.ModelLimits[model] = limit
+		}
+	}
+	if tz := os.Getenv("WORKING_TIMEZONE"); tz != "" {
+		if loc, err := time.LoadLocation(tz); err != nil {
+			logger.Printf("[AI Worker] WORKING_TIMEZONE 로드 실패 (로컬 사용): %v", err)
+		} else {
+			config.Location = loc
+		}
+	}
+
+	logger.Printf("[AI Worker] 스케줄러 설정 - 동시 실행: %s, 모델별: %v, 일일 한도: %s",
+		limitLabel(config.MaxConcurrent), config.ModelLimits, limitLabel(config.DailyBudget))
+	return config
+}
+
+// loadWorkingHours는 Worker의 업무 시간을 로드합니다. 설정이 없거나 잘못되면 nil(항상)입니다.
+// AI_XX_WORKING_HOURS/AI_XX_WORKING_TIMEZONE이 없으면 WORKING_HOURS/WORKING_TIMEZONE을 사용하며, 휴일은 HOLIDAYS입니다.
+func loadWorkingHours(workerID string, logger *log.Logger) *aiworker.WorkingHours {
+	spec := envOr(workerID+"_WORKING_HOURS", "WORKING_HOURS")
+	if spec == "" {
+		return nil
+	}
+	timezone := envOr(workerID+"_WORKING_TIMEZONE", "WORKING_TIMEZONE")
+
+	wh, err := aiworker.ParseWorkingHours(spec, timezone, os.Getenv("HOLIDAYS"))
+	if err != nil {
+		logger.Printf("[AI Worker] %s 업무 시간 설정 오류 (제한 없음): %v", workerID, err)
+		return nil
+	}
+	logger.Printf("[AI Worker] 업무 시간: %s (%s, %s, 휴일 %d일)", workerID, spec, wh.Location, len(wh.Holidays))
+	return wh
+}
+
+// envOr는 key 환경변수가 비어있으면 fallback 환경변수 값을 반환합니다.
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return os.Getenv(fallback)
+}
+
+// envInt는 정수 환경변수를 읽습니다. 비어있거나 잘못되면 0입니다.
+func envInt(key string, logger *log.Logger) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		logger.Printf("[AI Worker] %s 파싱 실패 (제한 없음): %q", key, value)
+		return 0
+	}
+	return n
+}
+
+// limitLabel은 한도 값을 표시용 문자열로 변환합니다.
+func limitLabel(n int) string {
+	if n == 0 {
+		return "제한 없음"
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
+)
+
+// slaAlerter는 SLA 위반을 태스크 스레드와 별개의 채널 메시지로 알립니다.
+// 위반당 메시지는 하나이며, 해소되면 같은 메시지를 해소 상태로 갱신합니다.
+type slaAlerter struct {
+	notify *taskNotifier
+
+	mu       sync.Mutex
+	messages map[string]string // 위반 키 → Slack 메시지 타임스탬프
+}
+
+// newSLAAlerter는 새 slaAlerter를 생성합니다.
+func newSLAAlerter(notify *taskNotifier) *slaAlerter {
+	return &slaAlerter{
+		notify:   notify,
+		messages: make(map[string]string),
+	}
+}
+
+// handle은 SLA 위반/해소 알림을 전송합니다.
+func (a *slaAlerter) handle(ctx context.Context, alert aiworker.SLAAlert) {
+	eventType := notifyformatter.EventSLABreached
+	if alert.Resolved {
+		eventType = notifyformatter.EventSLAResolved
+	}
+	event := notifyformatter.Event{
+		Type:     eventType,
+		WorkerID: alert.WorkerID,
+		TaskID:   alert.TaskID,
+		TaskName: alert.TaskName,
+		Detail:   slaDetail(alert),
+		Time:     alert.At,
+	}
+
+	n := a.notify
+	n.dispatch(ctx, event)
+	if !n.routesToSlack(eventType) {
+		return
+	}
+
+	blocks, text := n.formatter.Render(event)
+	key := alert.Key()
+
+	a.mu.Lock()
+	ts, ok := a.messages[key]
+	if alert.Resolved {
+		delete(a.messages, key)
+	}
+	a.mu.Unlock()
+
+	if ok {
+		if err := n.client.UpdateMessage(ctx, n.channelID, ts, blocks, text); err != nil {
+			n.logger.Printf("[AI Worker] SLA 알림 갱신 실패: %v", err)
+		}
+		return
+	}
+
+	ts, err := n.client.PostMessageWithTS(ctx, n.channelID, blocks, text)
+	if err != nil {
+		n.logger.Printf("[AI Worker] SLA 알림 전송 실패: %v", err)
+		return
+	}
+	if !alert.Resolved {
+		a.mu.Lock()
+		a.messages[key] = ts
+		a.mu.Unlock()
+	}
+}
+
+// slaDetail은 SLA 알림 본문을 생성합니다.
+func slaDetail(alert aiworker.SLAAlert) string {
+	label := "⏳ 대기 시간"
+	if alert.Kind == aiworker.SLAKindRun {
+		label = "⏱️ 실행 시간"
+	}
+	elapsed := alert.Elapsed.Round(time.Minute)
+
+	if alert.Resolved {
+		return fmt.Sprintf("%s SLA 초과가 해소되었습니다. (총 %v, SLA %v)", label, elapsed, alert.Limit)
+	}
+	return fmt.Sprintf("%s %v 경과 (SLA %v)\n*리스트:* %s", label, elapsed, alert.Limit, alert.ListID)
+}
+
+// loadSLA는 prefix(예: SLA, AI_01_SLA)의 _MAX_WAIT/_MAX_RUN 환경변수로 SLA를 로드합니다.
+// 값이 없거나 잘못되면 fallback 값을 사용합니다.
+func loadSLA(prefix string, fallback aiworker.SLA, logger *log.Logger) aiworker.SLA {
+	sla := fallback
+	for _, item := range []struct {
+		name   string
+		target *time.Duration
+	}{
+		{prefix + "_MAX_WAIT", &sla.MaxWait},
+		{prefix + "_MAX_RUN", &sla.MaxRun},
+	} {
+		value := os.Getenv(item.name)
+		if value == "" {
+			continue
+		}
+		d, err := parseSince(value)
+		if err != nil {
+			logger.Printf("[AI Worker] %s 파싱 실패 (무시): %v", item.name, err)
+			continue
+		}
+		*item.target = d
+	}
+	return sla
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+)
+
+// loadTDD는 Worker의 TDD 규칙 점검 설정을 로드합니다. (Worker별 AI_XX_TDD_* 우선)
+// TDD_TEST_PATTERNS가 비어있거나 원격 Worker(경로가 에이전트 머신에 있음)면 nil을 반환합니다.
+func loadTDD(worker aiworker.WorkerConfig, logger *log.Logger) *aiworker.TDDConfig {
+	prefix := worker.ID + "_"
+	patterns := splitList(envOr(prefix+"TDD_TEST_PATTERNS", "TDD_TEST_PATTERNS"))
+	if len(patterns) == 0 || worker.RemoteRepo != "" {
+		return nil
+	}
+
+	action, err := aiworker.ParseTDDAction(envOr(prefix+"TDD_ACTION", "TDD_ACTION"))
+	if err != nil {
+		logger.Printf("[AI Worker] %s TDD 위반 처리 방식 오류 (flag 사용): %v", worker.ID, err)
+		action = aiworker.TDDActionFlag
+	}
+
+	config := &aiworker.TDDConfig{
+		TestPatterns: patterns,
+		TestCommand:  envOr(prefix+"TDD_TEST_COMMAND", "TDD_TEST_COMMAND"),
+		VerifyBase:   strings.EqualFold(envOr(prefix+"TDD_VERIFY_BASE", "TDD_VERIFY_BASE"), "on"),
+		Action:       action,
+		MaxRetries:   aiworker.DefaultTDDMaxRetries,
+	}
+	if value := envOr(prefix+"TDD_MAX_RETRIES", "TDD_MAX_RETRIES"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			logger.Printf("[AI Worker] %s TDD_MAX_RETRIES 파싱 실패 (기본값 사용): %q", worker.ID, value)
+		} else {
+			config.MaxRetries = n
+		}
+	}
+	if config.VerifyBase && config.TestCommand == "" {
+		logger.Printf("[AI Worker] %s TDD_VERIFY_BASE는 TDD_TEST_COMMAND가 있어야 동작합니다", worker.ID)
+		config.VerifyBase = false
+	}
+	logger.Printf("[AI Worker] TDD 점검: %s (패턴: %v, 명령: %q, 시작 커밋 확인: %v, 위반 시: %s)",
+		worker.ID, patterns, config.TestCommand, config.VerifyBase, action)
+	return config
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/clickup"
+	"github.com/zime/slickwebhook/internal/config"
+	"github.com/zime/slickwebhook/internal/webhook"
+)
+
+// webhooksUsage는 webhooks 서브커맨드 사용법입니다.
+const webhooksUsage = `사용법:
+  ai-worker webhooks list
+  ai-worker webhooks sync [--endpoint URL] [--dry-run] [--no-write]`
+
+// runWebhooks는 ClickUp 웹훅 관리 서브커맨드를 실행합니다.
+// 종료 코드: 0 (성공), 1 (API 오류 또는 일부 리스트 실패), 2 (인자 오류)
+func runWebhooks(args []string, workerConfig aiworker.Config, configPath string, out io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(out, webhooksUsage)
+		return 2
+	}
+
+	client := clickup.NewClickUpClient(clickup.Config{
+		APIToken: os.Getenv("CLICKUP_API_TOKEN"),
+		TeamID:   os.Getenv("CLICKUP_TEAM_ID"),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "list":
+		return listWebhooks(ctx, client, workerConfig, out)
+	case "sync":
+		return syncWebhooks(ctx, client, args[1:], workerConfig, configPath, out)
+	default:
+		fmt.Fprintln(out, webhooksUsage)
+		return 2
+	}
+}
+
+// listWebhooks는 등록된 웹훅과 상태를 출력합니다.
+func listWebhooks(ctx context.Context, client *clickup.ClickUpClient, workerConfig aiworker.Config, out io.Writer) int {
+	hooks, err := client.GetWebhooks(ctx)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+
+	workerByList := make(map[string]string)
+	for _, w := range workerConfig.Workers {
+		workerByList[w.ListID] = w.ID
+	}
+
+	for _, wh := range hooks {
+		mark := "✅"
+		if !wh.IsHealthy() {
+			mark = "⚠️"
+		}
+		fmt.Fprintf(out, "%s %s list=%-14s worker=%-6s status=%s fail_count=%d endpoint=%s events=%s\n",
+			mark, wh.ID, wh.ListID, workerByList[string(wh.ListID)], wh.Health.Status, wh.Health.FailCount,
+			wh.Endpoint, strings.Join(wh.Events, ","))
+	}
+	fmt.Fprintf(out, "\n총 %d개\n", len(hooks))
+	return 0
+}
+
+// syncWebhooks는 AI 리스트마다 웹훅을 하나씩 등록/수정/재활성화하고 시크릿을 설정 파일에 저장합니다.
+func syncWebhooks(ctx context.Context, client *clickup.ClickUpClient, args []string, workerConfig aiworker.Config, configPath string, out io.Writer) int {
+	fs := flag.NewFlagSet("webhooks sync", flag.ContinueOnError)
+	fs.SetOutput(out)
+	endpoint := fs.String("endpoint", os.Getenv("WEBHOOK_PUBLIC_URL"), "웹훅 수신 URL (기본: WEBHOOK_PUBLIC_URL)")
+	dryRun := fs.Bool("dry-run", false, "변경 없이 계획만 출력")
+	noWrite := fs.Bool("no-write", false, "WEBHOOK_SECRET을 설정 파일에 저장하지 않음")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *endpoint == "" {
+		fmt.Fprintln(out, "❌ --endpoint 또는 WEBHOOK_PUBLIC_URL을 설정해야 합니다 (예: https://example.com/webhook/clickup)")
+		return 2
+	}
+
+	syncConfig := webhook.SyncConfig{Endpoint: *endpoint, DryRun: *dryRun}
+	for _, w := range workerConfig.Workers {
+		syncConfig.ListIDs = append(syncConfig.ListIDs, w.ListID)
+	}
+
+	report, err := webhook.SyncWebhooks(ctx, client, syncConfig)
+	if err != nil {
+		fmt.Fprintf(out, "❌ %v\n", err)
+		return 1
+	}
+
+	for _, res := range report.Results {
+		line := fmt.Sprintf("%-10s list=%-14s webhook=%s", res.Action, res.ListID, res.WebhookID)
+		if res.HealthStatus != "" || res.FailCount > 0 {
+			line += fmt.Sprintf(" (이전 상태: %s, fail_count=%d)", res.HealthStatus, res.FailCount)
+		}
+		if res.Err != nil {
+			line += " ❌ " + res.Err.Error()
+		}
+		fmt.Fprintln(out, line)
+	}
+
+	if *dryRun {
+		fmt.Fprintln(out, "\n(dry-run: 변경하지 않음)")
+		return 0
+	}
+
+	secrets := strings.Join(report.Secrets(), ",")
+	switch {
+	case secrets == "":
+	case *noWrite:
+		fmt.Fprintf(out, "\nWEBHOOK_SECRET=%s\n", secrets)
+	default:
+		if err := config.SetEnvFileValue(configPath, "WEBHOOK_SECRET", secrets); err != nil {
+			fmt.Fprintf(out, "❌ WEBHOOK_SECRET 저장 실패: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(out, "\nWEBHOOK_SECRET 저장: %s (재시작 후 적용)\n", configPath)
+	}
+
+	if report.Failed() {
+		return 1
+	}
+	return 0
+}
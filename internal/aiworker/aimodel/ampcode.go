@@ -245,3 +245,7 @@ curl -s -X POST http://localhost:%d/hook/task-complete -H 'Content-Type: applica
 작업이 완료되지 않았거나 에러가 발생한 경우에는 이 명령을 실행하지 마세요.
 ---`, h.hookServerPort)
 }
+
+func (h *AmpcodeHandler) GetProgressInstruction() string {
+	return buildProgressInstruction(h.hookServerPort)
+}
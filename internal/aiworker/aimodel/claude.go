@@ -3,12 +3,16 @@ package aimodel
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 // ClaudeHandler는 Claude Code 핸들러입니다.
 type ClaudeHandler struct {
 	hookServerPort int
 	terminalType   string
+	permissionMode string // 시작 권한 모드 (기본: plan)
+	continueLast   bool   // 작업 디렉토리의 마지막 세션 이어서 실행
+	mcpConfigPath  string // ai-worker MCP 서버 설정 파일 (설정하면 curl 대신 MCP 도구로 보고)
 }
 
 // NewClaudeHandler는 새 Claude 핸들러를 생성합니다.
@@ -16,9 +20,25 @@ func NewClaudeHandler(hookServerPort int, terminalType string) *ClaudeHandler {
 	return &ClaudeHandler{
 		hookServerPort: hookServerPort,
 		terminalType:   terminalType,
+		permissionMode: "plan",
 	}
 }
 
+// SetPermissionMode는 시작 권한 모드를 설정합니다. (plan, acceptEdits)
+func (h *ClaudeHandler) SetPermissionMode(mode string) {
+	h.permissionMode = mode
+}
+
+// SetContinueSession은 작업 디렉토리의 마지막 세션 이어서 실행 여부를 설정합니다.
+func (h *ClaudeHandler) SetContinueSession(continueSession bool) {
+	h.continueLast = continueSession
+}
+
+// SetMCPConfig는 ai-worker MCP 서버 설정 파일 경로를 설정합니다. 빈 문자열이면 MCP를 사용하지 않습니다.
+func (h *ClaudeHandler) SetMCPConfig(configPath string) {
+	h.mcpConfigPath = configPath
+}
+
 func (h *ClaudeHandler) GetType() AIModelType {
 	return AIModelClaude
 }
@@ -28,13 +48,28 @@ func (h *ClaudeHandler) GetPlanModeOption() string {
 }
 
 func (h *ClaudeHandler) BuildInvokeScript(workDir, promptFilePath, workerID string) string {
-	if h.terminalType == string(TerminalTypeWarp) {
-		return h.buildWarpScript(workDir, promptFilePath, workerID)
+	var script string
+	switch h.terminalType {
+	case string(TerminalTypeWarp):
+		script = h.buildWarpScript(workDir, promptFilePath, workerID)
+	case string(TerminalTypeITerm2):
+		script = h.buildITermScript(workDir, promptFilePath, workerID)
+	default:
+		script = h.buildTerminalScript(workDir, promptFilePath, workerID)
 	}
-	if h.terminalType == string(TerminalTypeITerm2) {
-		return h.buildITermScript(workDir, promptFilePath, workerID)
+	// 스크립트는 plan 모드 기준으로 작성되어 있으므로 다른 권한 모드는 옵션만 교체
+	if h.permissionMode != "" && h.permissionMode != "plan" {
+		script = strings.ReplaceAll(script, h.GetPlanModeOption(), "--permission-mode "+h.permissionMode)
+	}
+	if h.continueLast {
+		script = strings.ReplaceAll(script, "| claude --permission-mode", "| claude --continue --permission-mode")
 	}
-	return h.buildTerminalScript(workDir, promptFilePath, workerID)
+	if h.mcpConfigPath != "" {
+		// MCP 도구는 권한 확인 없이 호출하도록 서버 단위로 허용
+		option := fmt.Sprintf("--mcp-config '%s' --allowedTools %s", strings.ReplaceAll(h.mcpConfigPath, "'", "'\\''"), MCPToolPrefix)
+		script = strings.ReplaceAll(script, "| claude ", "| claude "+option+" ")
+	}
+	return script
 }
 
 func (h *ClaudeHandler) buildTerminalScript(workDir, promptFilePath, workerID string) string {
@@ -241,6 +276,9 @@ func (h *ClaudeHandler) Terminate(workerID string) error {
 }
 
 func (h *ClaudeHandler) GetTaskCompleteInstruction() string {
+	if h.mcpConfigPath != "" {
+		return buildMCPTaskCompleteInstruction()
+	}
 	return fmt.Sprintf(`
 
 ---
@@ -255,3 +293,10 @@ curl -s -X POST http://localhost:%d/hook/task-complete -H 'Content-Type: applica
 작업이 완료되지 않았거나 에러가 발생한 경우에는 이 명령을 실행하지 마세요.
 ---`, h.hookServerPort)
 }
+
+func (h *ClaudeHandler) GetProgressInstruction() string {
+	if h.mcpConfigPath != "" {
+		return buildMCPProgressInstruction()
+	}
+	return buildProgressInstruction(h.hookServerPort)
+}
@@ -197,6 +197,15 @@ func TestAIModelHandler_Interface(t *testing.T) {
 			_ = h.BuildTerminateScript("AI_01")
 			_ = h.GetPlanModeOption()
 			_ = h.GetTaskCompleteInstruction()
+
+			// 진행 상황 보고 지시는 모든 모델에 포함되어야 함
+			progress := h.GetProgressInstruction()
+			if !strings.Contains(progress, "/hook/progress") {
+				t.Error("지시에 /hook/progress가 포함되어야 함")
+			}
+			if !strings.Contains(progress, "8081") {
+				t.Error("지시에 포트 번호가 포함되어야 함")
+			}
 		})
 	}
 }
@@ -330,3 +339,66 @@ func TestAmpcodeHandler_iTerm2(t *testing.T) {
 		}
 	})
 }
+
+// TestSessionContinuer는 마지막 세션 이어서 실행 옵션을 테스트합니다.
+func TestSessionContinuer(t *testing.T) {
+	for _, terminal := range []TerminalType{TerminalTypeDefault, TerminalTypeWarp, TerminalTypeITerm2} {
+		claude := NewClaudeHandler(8081, string(terminal))
+		claude.SetPermissionMode("acceptEdits")
+		claude.SetContinueSession(true)
+		script := claude.BuildInvokeScript("/test/dir", "/tmp/prompt.txt", "AI_01")
+		if !strings.Contains(script, "| claude --continue --permission-mode acceptEdits") || strings.Contains(script, "| claude --permission-mode") {
+			t.Errorf("%s: claude 세션 이어서 실행 옵션 누락:\n%s", terminal, script)
+		}
+
+		opencode := NewOpenCodeHandler(8081, string(terminal))
+		opencode.SetContinueSession(true)
+		script = opencode.BuildInvokeScript("/test/dir", "/tmp/prompt.txt", "AI_01")
+		if !strings.Contains(script, "opencode --continue --prompt") || strings.Contains(script, "opencode --prompt") {
+			t.Errorf("%s: opencode 세션 이어서 실행 옵션 누락:\n%s", terminal, script)
+		}
+	}
+
+	var handler AIModelHandler = NewAmpcodeHandler(8081, "terminal")
+	if _, ok := handler.(SessionContinuer); ok {
+		t.Error("Ampcode는 세션 이어서 실행을 지원하지 않음")
+	}
+}
+
+// TestMCPConfigurer는 MCP 설정 시 실행 옵션과 보고 지시가 MCP 도구로 바뀌는지 테스트합니다.
+func TestMCPConfigurer(t *testing.T) {
+	for _, terminal := range []TerminalType{TerminalTypeDefault, TerminalTypeWarp, TerminalTypeITerm2} {
+		claude := NewClaudeHandler(8081, string(terminal))
+		claude.SetContinueSession(true)
+		claude.SetMCPConfig("/opt/ai-worker/mcp/AI_01.json")
+		script := claude.BuildInvokeScript("/test/dir", "/tmp/prompt.txt", "AI_01")
+		want := "| claude --mcp-config '/opt/ai-worker/mcp/AI_01.json' --allowedTools mcp__ai-worker --continue --permission-mode plan"
+		if !strings.Contains(script, want) {
+			t.Errorf("%s: MCP 설정 옵션 누락:\n%s", terminal, script)
+		}
+	}
+
+	claude := NewClaudeHandler(8081, "terminal")
+	if !strings.Contains(claude.GetTaskCompleteInstruction(), "curl") {
+		t.Error("MCP 미설정이면 curl 지시를 사용해야 함")
+	}
+	claude.SetMCPConfig("/tmp/mcp.json")
+	for _, instruction := range []string{claude.GetTaskCompleteInstruction(), claude.GetProgressInstruction()} {
+		if strings.Contains(instruction, "curl") || !strings.Contains(instruction, MCPServerName) {
+			t.Errorf("MCP 설정 시 도구 호출 지시여야 함:\n%s", instruction)
+		}
+	}
+	if !strings.Contains(claude.GetTaskCompleteInstruction(), "complete_task") || !strings.Contains(claude.GetProgressInstruction(), "post_progress") {
+		t.Error("완료/진행 보고 도구 이름 누락")
+	}
+	claude.SetMCPConfig("")
+	if strings.Contains(claude.BuildInvokeScript("/test/dir", "/tmp/prompt.txt", "AI_01"), "--mcp-config") {
+		t.Error("MCP 해제 후에는 옵션이 없어야 함")
+	}
+
+	for _, handler := range []AIModelHandler{NewOpenCodeHandler(8081, "terminal"), NewAmpcodeHandler(8081, "terminal")} {
+		if _, ok := handler.(MCPConfigurer); ok {
+			t.Errorf("%s는 MCP 설정을 지원하지 않음", handler.GetType())
+		}
+	}
+}
@@ -1,6 +1,8 @@
 // Package aimodel은 다양한 AI 코딩 도구를 추상화하는 패키지입니다.
 package aimodel
 
+import "fmt"
+
 // AIModelType은 사용할 AI 모델 종류입니다.
 type AIModelType string
 
@@ -40,6 +42,36 @@ type AIModelHandler interface {
 
 	// GetTaskCompleteInstruction은 작업 완료 알림 지시를 반환합니다.
 	GetTaskCompleteInstruction() string
+
+	// GetProgressInstruction은 진행 상황(heartbeat) 보고 지시를 반환합니다.
+	GetProgressInstruction() string
+}
+
+// PermissionModeSetter는 시작 권한 모드(계획 모드 생략 등)를 바꿀 수 있는 핸들러가 구현하는 인터페이스입니다.
+type PermissionModeSetter interface {
+	// SetPermissionMode는 시작 권한 모드를 설정합니다. (claude: plan, acceptEdits)
+	SetPermissionMode(mode string)
+}
+
+// SessionContinuer는 작업 디렉토리의 마지막 세션을 이어서 실행할 수 있는 핸들러가 구현하는 인터페이스입니다.
+// 구현하지 않은 핸들러는 새 세션으로 실행합니다.
+type SessionContinuer interface {
+	// SetContinueSession은 마지막 세션 이어서 실행 여부를 설정합니다. (claude/opencode: --continue)
+	SetContinueSession(continueSession bool)
+}
+
+// MCPServerName은 에이전트 MCP 설정에서 ai-worker 서버의 이름입니다.
+const MCPServerName = "ai-worker"
+
+// MCPToolPrefix는 Claude Code가 ai-worker MCP 도구에 붙이는 이름 접두사입니다. (권한 규칙에도 사용)
+const MCPToolPrefix = "mcp__" + MCPServerName
+
+// MCPConfigurer는 ai-worker MCP 서버에 연결해 실행할 수 있는 핸들러가 구현하는 인터페이스입니다.
+// MCP를 설정하면 진행 상황/완료 보고 지시가 curl 명령 대신 MCP 도구 호출로 바뀝니다.
+// 구현하지 않은 핸들러는 curl 지시를 그대로 사용합니다.
+type MCPConfigurer interface {
+	// SetMCPConfig는 MCP 서버 설정 파일 경로를 설정합니다. (claude: --mcp-config)
+	SetMCPConfig(configPath string)
 }
 
 // GetAIModelHandler는 AI 모델 타입에 맞는 핸들러를 반환합니다.
@@ -54,3 +86,82 @@ func GetAIModelHandler(modelType AIModelType, hookServerPort int, terminalType s
 		return NewClaudeHandler(hookServerPort, terminalType)
 	}
 }
+
+// buildProgressInstruction은 모든 AI 모델에 공통으로 사용하는 진행 상황 보고 지시를 생성합니다.
+// 에이전트는 주요 단계마다 /hook/progress로 stage를 전송합니다.
+func buildProgressInstruction(hookServerPort int) string {
+	return fmt.Sprintf(`
+
+---
+## 중요: 진행 상황 보고
+
+아래 단계에 도달할 때마다 명령을 실행하여 진행 상황을 보고하세요 (stage 값만 바꿔서 사용):
+
+- investigated  : 원인 조사 완료
+- plan_approved : 계획 승인됨
+- tests_written : 테스트 작성 완료
+- implemented   : 구현 완료
+- tests_passed  : 테스트 통과
+
+`+"`"+`bash
+curl -s -X POST http://localhost:%d/hook/progress -H 'Content-Type: application/json' -d '{"cwd": "'$(pwd)'", "stage": "investigated", "message": "한 줄 요약"}'
+`+"`"+`
+---`, hookServerPort)
+}
+
+// buildMCPProgressInstruction은 MCP 도구로 진행 상황을 보고하는 지시를 생성합니다.
+func buildMCPProgressInstruction() string {
+	return fmt.Sprintf(`
+
+---
+## 중요: 태스크 도구와 진행 상황 보고
+
+%[1]s MCP 서버의 도구로 태스크 정보를 조회하고 진행 상황을 보고하세요:
+
+- get_task, get_attachments, get_jira_issue : 태스크 설명, 댓글, 첨부파일, Jira 이슈 조회
+- ask_reporter : 요구사항이 불명확하면 보고자에게 질문 (답변은 get_task 댓글로 확인)
+- post_progress : 아래 단계에 도달할 때마다 stage와 한 줄 요약(message)을 보고
+
+- investigated  : 원인 조사 완료
+- plan_approved : 계획 승인됨
+- tests_written : 테스트 작성 완료
+- implemented   : 구현 완료
+- tests_passed  : 테스트 통과
+---`, MCPServerName)
+}
+
+// buildMCPTaskCompleteInstruction은 MCP 도구로 작업 완료를 알리는 지시를 생성합니다.
+func buildMCPTaskCompleteInstruction() string {
+	return fmt.Sprintf(`
+
+---
+## 중요: 작업 완료 알림
+
+모든 작업이 완료되면 반드시 %s MCP 서버의 complete_task 도구를 호출하여 완료를 알리세요.
+
+작업이 완료되지 않았거나 에러가 발생한 경우에는 호출하지 마세요.
+---`, MCPServerName)
+}
+
+// BuildReviewVerdictInstruction은 모든 AI 모델에 공통으로 사용하는 리뷰 판정 보고 지시를 생성합니다.
+// 리뷰 에이전트는 작업 완료 알림 대신 /hook/review-verdict로 판정을 전송합니다.
+func BuildReviewVerdictInstruction(hookServerPort int) string {
+	return fmt.Sprintf(`
+
+---
+## 중요: 리뷰 판정 보고
+
+코드를 수정하지 마세요. 리뷰를 마치면 반드시 아래 명령 중 하나를 실행하여 판정을 보고하세요.
+작업 완료 알림(task-complete)은 보내지 마세요.
+
+승인:
+`+"`"+`bash
+curl -s -X POST http://localhost:%d/hook/review-verdict -H 'Content-Type: application/json' -d '{"cwd": "'$(pwd)'", "verdict": "approve", "summary": "한 줄 요약"}'
+`+"`"+`
+
+수정 요청 (comments에 수정할 항목을 하나씩):
+`+"`"+`bash
+curl -s -X POST http://localhost:%d/hook/review-verdict -H 'Content-Type: application/json' -d '{"cwd": "'$(pwd)'", "verdict": "request_changes", "summary": "한 줄 요약", "comments": ["수정 항목 1", "수정 항목 2"]}'
+`+"`"+`
+---`, hookServerPort, hookServerPort)
+}
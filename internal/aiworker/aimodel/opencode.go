@@ -3,12 +3,14 @@ package aimodel
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 // OpenCodeHandler는 OpenCode 핸들러입니다.
 type OpenCodeHandler struct {
 	hookServerPort int
 	terminalType   string
+	continueLast   bool // 작업 디렉토리의 마지막 세션 이어서 실행
 }
 
 // NewOpenCodeHandler는 새 OpenCode 핸들러를 생성합니다.
@@ -28,14 +30,25 @@ func (h *OpenCodeHandler) GetPlanModeOption() string {
 	return "run"
 }
 
+// SetContinueSession은 작업 디렉토리의 마지막 세션 이어서 실행 여부를 설정합니다.
+func (h *OpenCodeHandler) SetContinueSession(continueSession bool) {
+	h.continueLast = continueSession
+}
+
 func (h *OpenCodeHandler) BuildInvokeScript(workDir, promptFilePath, workerID string) string {
-	if h.terminalType == string(TerminalTypeWarp) {
-		return h.buildWarpScript(workDir, promptFilePath, workerID)
+	var script string
+	switch h.terminalType {
+	case string(TerminalTypeWarp):
+		script = h.buildWarpScript(workDir, promptFilePath, workerID)
+	case string(TerminalTypeITerm2):
+		script = h.buildITermScript(workDir, promptFilePath, workerID)
+	default:
+		script = h.buildTerminalScript(workDir, promptFilePath, workerID)
 	}
-	if h.terminalType == string(TerminalTypeITerm2) {
-		return h.buildITermScript(workDir, promptFilePath, workerID)
+	if h.continueLast {
+		script = strings.ReplaceAll(script, "opencode --prompt", "opencode --continue --prompt")
 	}
-	return h.buildTerminalScript(workDir, promptFilePath, workerID)
+	return script
 }
 
 func (h *OpenCodeHandler) buildTerminalScript(workDir, promptFilePath, workerID string) string {
@@ -247,3 +260,7 @@ curl -s -X POST http://localhost:%d/hook/task-complete -H 'Content-Type: applica
 작업이 완료되지 않았거나 에러가 발생한 경우에는 이 명령을 실행하지 마세요.
 ---`, h.hookServerPort)
 }
+
+func (h *OpenCodeHandler) GetProgressInstruction() string {
+	return buildProgressInstruction(h.hookServerPort)
+}
@@ -0,0 +1,186 @@
+package aiworker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultArtifactMaxUpload는 ClickUp에 업로드할 패치의 기본 최대 크기(바이트)입니다.
+const DefaultArtifactMaxUpload = 10 * 1024 * 1024
+
+// ArtifactConfig는 실행 결과물(패치) 저장 및 업로드 설정입니다.
+type ArtifactConfig struct {
+	Dir            string // 실행별 결과물 디렉토리의 상위 경로
+	MaxUploadBytes int    // 업로드 최대 크기 (초과하면 gzip 압축, 압축 후에도 초과하면 업로드 생략)
+}
+
+// AttachmentUploader는 태스크 첨부파일 업로드를 지원하는 ClickUp 클라이언트가 구현하는 인터페이스입니다.
+type AttachmentUploader interface {
+	UploadAttachment(ctx context.Context, taskID, filename string, data []byte) error
+}
+
+// PatchBuilder는 작업 경로의 시작 커밋 대비 변경을 패치로 만듭니다.
+type PatchBuilder struct {
+	git func(ctx context.Context, env []string, dir string, args ...string) (string, error) // 출력을 그대로 반환 (테스트에서 교체)
+}
+
+// NewPatchBuilder는 새 PatchBuilder를 생성합니다.
+func NewPatchBuilder() *PatchBuilder {
+	return &PatchBuilder{git: gitOutput}
+}
+
+// Head는 dir의 현재 커밋을 반환합니다.
+func (b *PatchBuilder) Head(ctx context.Context, dir string) (string, error) {
+	out, err := b.git(ctx, nil, dir, "rev-parse", "HEAD")
+	return strings.TrimSpace(out), err
+}
+
+// Build는 base 커밋 대비 작업 트리의 변경(커밋, 미커밋, 추적하지 않는 새 파일 포함)을 바이너리 패치로 반환합니다.
+func (b *PatchBuilder) Build(ctx context.Context, dir, base string) ([]byte, error) {
+	out, err := b.diff(ctx, dir, base, "--binary")
+	return []byte(out), err
+}
+
+// ChangedFiles는 base 커밋 대비 작업 트리에서 추가/변경된 파일 경로(저장소 기준, 삭제 제외)를 반환합니다.
+func (b *PatchBuilder) ChangedFiles(ctx context.Context, dir, base string) ([]string, error) {
+	out, err := b.diff(ctx, dir, base, "--name-only", "--diff-filter=d")
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// diff는 base 커밋과 작업 트리 전체를 git diff args로 비교합니다.
+// 실제 인덱스를 건드리지 않도록 임시 인덱스에 작업 트리 전체를 추가해 비교합니다.
+func (b *PatchBuilder) diff(ctx context.Context, dir, base string, args ...string) (string, error) {
+	index, err := os.CreateTemp("", "ai-worker-index-*")
+	if err != nil {
+		return "", fmt.Errorf("임시 인덱스 생성 실패: %w", err)
+	}
+	index.Close()
+	os.Remove(index.Name()) // git이 새 인덱스를 만들도록 경로만 사용
+	defer os.Remove(index.Name())
+	env := []string{"GIT_INDEX_FILE=" + index.Name()}
+
+	if _, err := b.git(ctx, env, dir, "read-tree", base); err != nil {
+		return "", fmt.Errorf("git read-tree 실패: %w", err)
+	}
+	if _, err := b.git(ctx, env, dir, "add", "-A"); err != nil {
+		return "", fmt.Errorf("git add 실패: %w", err)
+	}
+	diffArgs := append([]string{"diff", "--cached"}, args...)
+	out, err := b.git(ctx, env, dir, append(diffArgs, base)...)
+	if err != nil {
+		return "", fmt.Errorf("git diff 실패: %w", err)
+	}
+	return out, nil
+}
+
+// recordBaseCommit은 에이전트 실행 전 작업 경로의 커밋을 패치 기준으로 기록합니다.
+// 패치 저장, 리뷰, TDD 점검이 설정된 경우에만 기록합니다.
+func (w *Worker) recordBaseCommit(ctx context.Context) {
+	if w.patches == nil {
+		return
+	}
+	head, err := w.patches.Head(ctx, w.config.SrcPath)
+	if err != nil {
+		fmt.Printf("[%s] ⚠️ 시작 커밋 확인 실패 (패치 생략): %v\n", w.config.ID, err)
+		head = ""
+	}
+	w.mu.Lock()
+	w.baseCommit = head
+	w.mu.Unlock()
+}
+
+// savePatch는 시작 커밋 대비 변경을 실행별 결과물 디렉토리에 저장하고 ClickUp 태스크에 첨부합니다.
+// 실패해도 완료 처리는 계속하며 저장한 패치 경로를 반환합니다.
+func (w *Worker) savePatch(ctx context.Context) string {
+	w.mu.Lock()
+	base, taskID, jiraID, startedAt := w.baseCommit, w.currentTaskID, w.currentJiraID, w.startedAt
+	w.mu.Unlock()
+	if w.artifacts == nil || base == "" {
+		return ""
+	}
+
+	patch, err := w.patches.Build(ctx, w.config.SrcPath, base)
+	if err != nil {
+		fmt.Printf("[%s] ⚠️ 패치 생성 실패: %v\n", w.config.ID, err)
+		return ""
+	}
+	if len(patch) == 0 {
+		fmt.Printf("[%s] 시작 커밋 대비 변경 없음 (패치 생략)\n", w.config.ID)
+		return ""
+	}
+
+	runDir := filepath.Join(w.artifacts.Dir, fmt.Sprintf("%s_%s_%s", startedAt.Format("20060102-150405"), w.config.ID, taskID))
+	if err := os.MkdirAll(runDir, 0755); err != nil {
+		fmt.Printf("[%s] ⚠️ 결과물 디렉토리 생성 실패: %v\n", w.config.ID, err)
+		return ""
+	}
+	path := filepath.Join(runDir, "patch.diff")
+	if err := os.WriteFile(path, patch, 0644); err != nil {
+		fmt.Printf("[%s] ⚠️ 패치 저장 실패: %v\n", w.config.ID, err)
+		return ""
+	}
+
+	name := jiraID
+	if name == "" {
+		name = taskID
+	}
+	filename := fmt.Sprintf("%s-%s.diff", name, time.Now().Format("20060102-150405"))
+	data := patch
+
+	// 크기 제한을 넘으면 gzip 압축
+	if len(data) > w.artifacts.MaxUploadBytes {
+		compressed, err := gzipBytes(data)
+		if err != nil {
+			fmt.Printf("[%s] ⚠️ 패치 압축 실패: %v\n", w.config.ID, err)
+			return path
+		}
+		if err := os.WriteFile(path+".gz", compressed, 0644); err != nil {
+			fmt.Printf("[%s] ⚠️ 압축 패치 저장 실패: %v\n", w.config.ID, err)
+		}
+		if len(compressed) > w.artifacts.MaxUploadBytes {
+			fmt.Printf("[%s] ⚠️ 패치가 업로드 제한을 초과해 첨부 생략: %d바이트 (압축 후, 최대 %d바이트): %s\n",
+				w.config.ID, len(compressed), w.artifacts.MaxUploadBytes, path)
+			return path
+		}
+		data, filename = compressed, filename+".gz"
+	}
+
+	uploader, ok := w.clickupClient.(AttachmentUploader)
+	if !ok {
+		return path
+	}
+	if err := uploader.UploadAttachment(ctx, taskID, filename, data); err != nil {
+		fmt.Printf("[%s] ⚠️ 패치 첨부 실패: %v\n", w.config.ID, err)
+		return path
+	}
+	fmt.Printf("[%s] 패치 첨부 완료: %s (%d바이트)\n", w.config.ID, filename, len(data))
+	return path
+}
+
+// gzipBytes는 data를 gzip으로 압축합니다.
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
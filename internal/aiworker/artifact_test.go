@@ -0,0 +1,174 @@
+package aiworker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// initTestRepo는 커밋 하나가 있는 임시 git 저장소를 만듭니다.
+func initTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git 없음")
+	}
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "test"},
+	} {
+		if _, err := runGit(context.Background(), dir, args...); err != nil {
+			t.Fatalf("git %v 실패: %v", args, err)
+		}
+	}
+	writeFile(t, filepath.Join(dir, "a.txt"), "hello\n")
+	writeFile(t, filepath.Join(dir, ".gitignore"), "*.log\n")
+	for _, args := range [][]string{{"add", "-A"}, {"commit", "-qm", "init"}} {
+		if _, err := runGit(context.Background(), dir, args...); err != nil {
+			t.Fatalf("git %v 실패: %v", args, err)
+		}
+	}
+	return dir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestPatchBuilder_Build는 커밋/미커밋/새 파일 변경이 패치에 포함되고 인덱스는 그대로인지 테스트합니다.
+func TestPatchBuilder_Build(t *testing.T) {
+	dir := initTestRepo(t)
+	ctx := context.Background()
+	b := NewPatchBuilder()
+	base, err := b.Head(ctx, dir)
+	if err != nil || len(base) != 40 {
+		t.Fatalf("시작 커밋 확인 실패: %q, %v", base, err)
+	}
+
+	if patch, err := b.Build(ctx, dir, base); err != nil || len(patch) != 0 {
+		t.Fatalf("변경이 없으면 빈 패치여야 함: %q, %v", patch, err)
+	}
+
+	// 커밋한 변경, 커밋하지 않은 변경, 추적하지 않는 새 파일, 무시 파일
+	writeFile(t, filepath.Join(dir, "a.txt"), "hello\nworld\n")
+	runGit(ctx, dir, "commit", "-qam", "agent commit")
+	writeFile(t, filepath.Join(dir, "a.txt"), "hello\nworld\n!\n")
+	writeFile(t, filepath.Join(dir, "new.txt"), "new file\n")
+	writeFile(t, filepath.Join(dir, "debug.log"), "ignored\n")
+
+	patch, err := b.Build(ctx, dir, base)
+	if err != nil {
+		t.Fatalf("패치 생성 실패: %v", err)
+	}
+	text := string(patch)
+	for _, want := range []string{"+world", "+!", "new file mode", "+new file"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("패치에 %q가 포함되어야 함:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "debug.log") {
+		t.Error("무시 파일은 패치에 포함하지 않아야 함")
+	}
+	if status, _ := runGit(ctx, dir, "status", "--porcelain"); !strings.Contains(status, "?? new.txt") {
+		t.Errorf("실제 인덱스를 변경하지 않아야 함: %q", status)
+	}
+
+	// 패치는 시작 커밋에 그대로 적용 가능해야 함
+	runGit(ctx, dir, "stash", "push", "-q", "--include-untracked")
+	runGit(ctx, dir, "checkout", "-q", base)
+	patchFile := filepath.Join(t.TempDir(), "p.diff")
+	writeFile(t, patchFile, text)
+	if _, err := runGit(ctx, dir, "apply", "--check", patchFile); err != nil {
+		t.Errorf("패치 적용 불가: %v", err)
+	}
+}
+
+// TestWorker_CompleteTask_Patch는 완료 시 패치를 저장하고 태스크에 첨부하는지 테스트합니다.
+func TestWorker_CompleteTask_Patch(t *testing.T) {
+	dir := initTestRepo(t)
+	artifactDir := t.TempDir()
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크", Description: "PROJ-9"}}}
+	config := WorkerConfig{
+		ID:        "AI_01",
+		ListID:    "list1",
+		SrcPath:   dir,
+		Artifacts: &ArtifactConfig{Dir: artifactDir},
+	}
+	worker := NewWorker(config, mockClient, &MockInvoker{Result: &InvokeResult{}}, "작업중", "개발완료", "")
+
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "fix.txt"), "fixed\n")
+	if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+		t.Fatalf("실행 전이 실패: %v", err)
+	}
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 처리 실패: %v", err)
+	}
+
+	if len(mockClient.Uploads) != 1 {
+		t.Fatalf("패치가 한 번 첨부되어야 함: %d", len(mockClient.Uploads))
+	}
+	upload := mockClient.Uploads[0]
+	if upload.TaskID != "task1" || !strings.HasPrefix(upload.Filename, "PROJ-9-") || !strings.HasSuffix(upload.Filename, ".diff") {
+		t.Errorf("첨부 정보 불일치: %s %s", upload.TaskID, upload.Filename)
+	}
+	if !strings.Contains(string(upload.Data), "+fixed") {
+		t.Errorf("첨부 패치 내용 불일치: %s", upload.Data)
+	}
+	saved, _ := filepath.Glob(filepath.Join(artifactDir, "*_AI_01_task1", "patch.diff"))
+	if len(saved) != 1 {
+		t.Errorf("실행별 결과물 디렉토리에 패치가 저장되어야 함: %v", saved)
+	}
+}
+
+// TestWorker_SavePatch_Compress는 업로드 제한을 넘는 패치를 압축해 첨부하는지 테스트합니다.
+func TestWorker_SavePatch_Compress(t *testing.T) {
+	dir := initTestRepo(t)
+	mockClient := &MockClickUpClient{}
+	config := WorkerConfig{ID: "AI_01", SrcPath: dir, Artifacts: &ArtifactConfig{Dir: t.TempDir(), MaxUploadBytes: 2048}}
+	worker := NewWorker(config, mockClient, &MockInvoker{}, "작업중", "개발완료", "")
+	ctx := context.Background()
+
+	worker.SetProcessing("task1", "태스크", "", "")
+	worker.recordBaseCommit(ctx)
+	writeFile(t, filepath.Join(dir, "big.txt"), strings.Repeat("same line\n", 1000))
+
+	if path := worker.savePatch(ctx); path == "" {
+		t.Fatal("패치가 저장되어야 함")
+	}
+	if len(mockClient.Uploads) != 1 || !strings.HasSuffix(mockClient.Uploads[0].Filename, ".diff.gz") {
+		t.Fatalf("압축 패치가 첨부되어야 함: %+v", mockClient.Uploads)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(mockClient.Uploads[0].Data))
+	if err != nil {
+		t.Fatalf("gzip 형식이어야 함: %v", err)
+	}
+	data, _ := io.ReadAll(zr)
+	if !strings.Contains(string(data), "+same line") {
+		t.Error("압축 해제한 패치 내용 불일치")
+	}
+
+	// 압축 후에도 제한을 넘으면 첨부 생략
+	worker.artifacts.MaxUploadBytes = 10
+	if path := worker.savePatch(ctx); path == "" {
+		t.Error("첨부를 생략해도 패치는 저장되어야 함")
+	}
+	if len(mockClient.Uploads) != 1 {
+		t.Error("제한을 넘는 패치는 첨부하지 않아야 함")
+	}
+}
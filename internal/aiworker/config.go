@@ -38,6 +38,16 @@ type WorkerConfig struct {
 	SrcPath      string              // Claude Code 실행 경로
 	TerminalType TerminalType        // 터미널 종류 (개별 설정, 없으면 전역 설정 사용)
 	AIModelType  aimodel.AIModelType // AI 모델 종류 (개별 설정, 없으면 전역 설정 사용)
+	SLA          SLA                 // 리스트 SLA (대기/실행 시간 상한)
+	WorkingHours *WorkingHours       // 새 태스크 시작 가능 시간대 (nil이면 항상)
+	Overrides    OverridePolicy      // 태스크별 모델/모드 오버라이드 허용 정책
+	AssigneeID   int                 // 풀 선점 시 지정할 ClickUp 담당자 ID (0이면 ClickUp 선점 안함)
+	RemoteRepo   string              // 원격 에이전트에서 실행할 저장소 이름 (비어있으면 로컬 실행)
+	Preflight    *PreflightConfig    // 태스크 시작 전 사전 점검 (nil이면 점검 안함)
+	GitPrep      *GitPrepConfig      // 태스크 시작 전 git 준비 / 종료 후 정리 (nil이면 안함)
+	Artifacts    *ArtifactConfig     // 완료 시 패치 저장/첨부 (nil이면 안함)
+	Review       *ReviewConfig       // 완료 전 리뷰 에이전트 검토 (nil이면 안함)
+	TDD          *TDDConfig          // 완료 시 TDD 규칙 점검 (nil이면 안함)
 }
 
 // DefaultConfig는 기본 설정을 반환합니다.
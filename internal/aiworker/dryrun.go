@@ -0,0 +1,307 @@
+package aiworker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// FixtureTask는 dry-run용 로컬 태스크 픽스처 항목입니다.
+// ClickUp API 태스크 형식에 소속 리스트 ID를 추가한 형태입니다.
+//
+//	[{"list_id": "901", "id": "abc123", "name": "로그인 버그", "description": "...", "status": {"status": "AI요청"}}]
+type FixtureTask struct {
+	ListID string `json:"list_id"`
+	clickup.Task
+}
+
+// LoadTaskFixture는 JSON 픽스처 파일에서 태스크 목록을 읽습니다.
+func LoadTaskFixture(path string) ([]FixtureTask, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("픽스처 파일 읽기 실패: %w", err)
+	}
+
+	var tasks []FixtureTask
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("픽스처 파싱 실패: %w", err)
+	}
+
+	for i, t := range tasks {
+		if t.ID == "" || t.ListID == "" {
+			return nil, fmt.Errorf("픽스처 %d번째 항목에 id/list_id 누락", i+1)
+		}
+	}
+
+	return tasks, nil
+}
+
+// DryRunAction은 dry-run 중 수행하지 않고 기록한 ClickUp 변경 작업입니다.
+type DryRunAction struct {
+	Time   time.Time
+	Action string // status, dates, move
+	TaskID string
+	Detail string
+}
+
+// DryRunClickUpClient는 ClickUp 변경 작업을 실제로 수행하지 않고 로그로 남기는 클라이언트입니다.
+// 조회는 실제 클라이언트(source) 또는 로컬 픽스처에서 수행하며,
+// 기록한 변경은 메모리 상의 태스크에 반영되어 이후 조회 결과에 나타납니다.
+type DryRunClickUpClient struct {
+	source ClickUpClientInterface // nil이면 픽스처만 사용 (오프라인)
+	logger *log.Logger
+
+	mu       sync.Mutex
+	tasks    map[string]*clickup.Task // 태스크 ID → 태스크 (dry-run 변경 반영)
+	taskList map[string]string        // 태스크 ID → 리스트 ID
+	order    []string                 // 픽스처/조회 순서
+	actions  []DryRunAction
+}
+
+// NewDryRunClickUpClient는 새 DryRunClickUpClient를 생성합니다.
+// source가 nil이면 fixtures만으로 동작합니다.
+func NewDryRunClickUpClient(source ClickUpClientInterface, fixtures []FixtureTask, logger *log.Logger) *DryRunClickUpClient {
+	c := &DryRunClickUpClient{
+		source:   source,
+		logger:   logger,
+		tasks:    make(map[string]*clickup.Task),
+		taskList: make(map[string]string),
+	}
+
+	for _, f := range fixtures {
+		task := f.Task
+		c.remember(&task, f.ListID)
+	}
+
+	return c
+}
+
+// remember는 태스크를 메모리에 등록합니다. 이미 있으면 기존(변경 반영된) 태스크를 유지합니다.
+// 호출자가 mu를 잡고 있거나 생성 중이어야 합니다.
+func (c *DryRunClickUpClient) remember(task *clickup.Task, listID string) {
+	if _, ok := c.tasks[task.ID]; ok {
+		return
+	}
+	c.tasks[task.ID] = task
+	c.order = append(c.order, task.ID)
+	if listID != "" {
+		c.taskList[task.ID] = listID
+	}
+}
+
+// GetTask는 태스크를 조회합니다. 메모리에 없으면 source에서 조회합니다.
+func (c *DryRunClickUpClient) GetTask(ctx context.Context, taskID string) (*clickup.Task, error) {
+	c.mu.Lock()
+	task, ok := c.tasks[taskID]
+	c.mu.Unlock()
+
+	if ok {
+		copied := *task
+		return &copied, nil
+	}
+
+	if c.source == nil {
+		return nil, fmt.Errorf("픽스처에 태스크 없음: %s", taskID)
+	}
+
+	fetched, err := c.source.GetTask(ctx, taskID)
+	if err != nil || fetched == nil {
+		return fetched, err
+	}
+
+	c.mu.Lock()
+	c.remember(fetched, "")
+	task = c.tasks[taskID]
+	c.mu.Unlock()
+
+	copied := *task
+	return &copied, nil
+}
+
+// GetTasks는 리스트의 태스크를 조회합니다.
+// dry-run으로 다른 리스트로 이동한 태스크는 제외하고, 상태 변경은 반영합니다.
+func (c *DryRunClickUpClient) GetTasks(ctx context.Context, listID string, opts *clickup.GetTasksOptions) ([]*clickup.Task, error) {
+	if c.source != nil {
+		fetched, err := c.source.GetTasks(ctx, listID, opts)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		for _, t := range fetched {
+			c.remember(t, listID)
+			if _, ok := c.taskList[t.ID]; !ok {
+				c.taskList[t.ID] = listID
+			}
+		}
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*clickup.Task
+	for _, id := range c.order {
+		if c.taskList[id] != listID {
+			continue
+		}
+		copied := *c.tasks[id]
+		result = append(result, &copied)
+	}
+	return result, nil
+}
+
+// UpdateTaskStatus는 상태 변경을 기록합니다.
+func (c *DryRunClickUpClient) UpdateTaskStatus(ctx context.Context, taskID, status string) error {
+	c.mu.Lock()
+	from := ""
+	if task, ok := c.tasks[taskID]; ok {
+		from = task.Status.Status
+		task.Status.Status = status
+	}
+	c.mu.Unlock()
+
+	c.record("status", taskID, fmt.Sprintf("'%s' → '%s'", from, status))
+	return nil
+}
+
+// UpdateTaskDates는 날짜 변경을 기록합니다.
+func (c *DryRunClickUpClient) UpdateTaskDates(ctx context.Context, taskID string, startDate, dueDate *time.Time) error {
+	detail := ""
+	if startDate != nil {
+		detail += "start=" + startDate.Format(time.RFC3339) + " "
+	}
+	if dueDate != nil {
+		detail += "due=" + dueDate.Format(time.RFC3339)
+	}
+
+	c.record("dates", taskID, strings.TrimSpace(detail))
+	return nil
+}
+
+// MoveTaskToList는 리스트 이동을 기록합니다.
+func (c *DryRunClickUpClient) MoveTaskToList(ctx context.Context, taskID, listID string) error {
+	c.mu.Lock()
+	from := c.taskList[taskID]
+	c.taskList[taskID] = listID
+	c.mu.Unlock()
+
+	c.record("move", taskID, fmt.Sprintf("리스트 '%s' → '%s'", from, listID))
+	return nil
+}
+
+// GetActions는 기록된 변경 작업 목록의 복사본을 반환합니다.
+func (c *DryRunClickUpClient) GetActions() []DryRunAction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	actions := make([]DryRunAction, len(c.actions))
+	copy(actions, c.actions)
+	return actions
+}
+
+// record는 변경 작업을 기록하고 로그로 남깁니다.
+func (c *DryRunClickUpClient) record(action, taskID, detail string) {
+	c.mu.Lock()
+	c.actions = append(c.actions, DryRunAction{
+		Time:   time.Now(),
+		Action: action,
+		TaskID: taskID,
+		Detail: detail,
+	})
+	c.mu.Unlock()
+
+	if c.logger != nil {
+		c.logger.Printf("[DRY-RUN] ClickUp %s: task=%s %s", action, taskID, detail)
+	}
+}
+
+// DryRunInvoker는 AI 도구를 실행하지 않고 프롬프트와 실행 스크립트를 디스크에 저장하는 Invoker입니다.
+// FakeAgent가 설정되면 저장 후 Hook 엔드포인트를 호출하여 작업 수명주기를 재현합니다.
+type DryRunInvoker struct {
+	renderer  *DefaultInvoker // 프롬프트/스크립트 렌더링용 (실행하지 않음)
+	outputDir string
+	agent     *FakeAgent
+	logger    *log.Logger
+}
+
+// NewDryRunInvoker는 새 DryRunInvoker를 생성합니다.
+func NewDryRunInvoker(renderer *DefaultInvoker, outputDir string, logger *log.Logger) *DryRunInvoker {
+	return &DryRunInvoker{
+		renderer:  renderer,
+		outputDir: outputDir,
+		logger:    logger,
+	}
+}
+
+// SetFakeAgent는 실행 후 Hook을 호출할 가짜 에이전트를 설정합니다.
+func (i *DryRunInvoker) SetFakeAgent(agent *FakeAgent) {
+	i.agent = agent
+}
+
+// InvokePlan은 최종 프롬프트와 실행 스크립트를 <outputDir>/<workerID>_<시각>/ 아래에 저장합니다.
+// prompt.txt는 AI 도구에 전달될 프롬프트, invoke.applescript는 osascript로 실행될 스크립트입니다.
+func (i *DryRunInvoker) InvokePlan(ctx context.Context, workDir, prompt, workerID string) (*InvokeResult, error) {
+	fullPrompt := i.renderer.AddTDDSuffix(prompt)
+
+	runDir := filepath.Join(i.outputDir, fmt.Sprintf("%s_%s", workerID, time.Now().Format("20060102_150405.000")))
+	if err := os.MkdirAll(runDir, 0755); err != nil {
+		return nil, fmt.Errorf("dry-run 디렉토리 생성 실패: %w", err)
+	}
+
+	promptPath := filepath.Join(runDir, "prompt.txt")
+	if err := os.WriteFile(promptPath, []byte(fullPrompt), 0644); err != nil {
+		return nil, fmt.Errorf("프롬프트 저장 실패: %w", err)
+	}
+
+	script := i.renderer.BuildAppleScriptWithFile(workDir, promptPath, workerID)
+	scriptPath := filepath.Join(runDir, "invoke.applescript")
+	if err := os.WriteFile(scriptPath, []byte(script), 0644); err != nil {
+		return nil, fmt.Errorf("실행 스크립트 저장 실패: %w", err)
+	}
+
+	if i.logger != nil {
+		i.logger.Printf("[DRY-RUN] %s 실행 생략, 프롬프트/스크립트 저장: %s", workerID, runDir)
+	}
+
+	if i.agent != nil {
+		go func() {
+			if err := i.agent.Run(ctx, workDir); err != nil && i.logger != nil {
+				i.logger.Printf("[DRY-RUN] 가짜 에이전트 실패 (%s): %v", workerID, err)
+			}
+		}()
+	}
+
+	return &InvokeResult{
+		WorkDir:   workDir,
+		Prompt:    fullPrompt,
+		StartedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// Invoke는 opts의 모델/모드로 렌더링한 프롬프트와 실행 스크립트를 저장합니다.
+func (i *DryRunInvoker) Invoke(ctx context.Context, workDir, prompt, workerID string, opts RunOptions) (*InvokeResult, error) {
+	withOptions := *i
+	withOptions.renderer = i.renderer.WithOptions(opts)
+	return withOptions.InvokePlan(ctx, workDir, prompt, workerID)
+}
+
+// Terminate는 터미널 종료를 생략하고 로그만 남깁니다.
+func (i *DryRunInvoker) Terminate(workerID string) error {
+	if i.logger != nil {
+		i.logger.Printf("[DRY-RUN] %s 터미널 종료 생략", workerID)
+	}
+	return nil
+}
+
+// IsSessionAlive는 dry-run에서 실행 중인 세션이 없으므로 항상 false를 반환합니다.
+func (i *DryRunInvoker) IsSessionAlive(workerID string) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,180 @@
+package aiworker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TestLoadTaskFixture는 JSON 픽스처 로드와 검증을 테스트합니다.
+func TestLoadTaskFixture(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tasks.json")
+	os.WriteFile(path, []byte(`[
+		{"list_id": "list1", "id": "t1", "name": "첫 번째", "status": {"status": "AI요청"}},
+		{"list_id": "list2", "id": "t2", "name": "두 번째"}
+	]`), 0644)
+
+	tasks, err := LoadTaskFixture(path)
+	if err != nil {
+		t.Fatalf("픽스처 로드 실패: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].ListID != "list1" || tasks[0].Status.Status != "AI요청" {
+		t.Errorf("픽스처 내용 불일치: %+v", tasks)
+	}
+
+	badPath := filepath.Join(dir, "bad.json")
+	os.WriteFile(badPath, []byte(`[{"id": "t1"}]`), 0644)
+	if _, err := LoadTaskFixture(badPath); err == nil {
+		t.Error("list_id 누락 시 에러여야 함")
+	}
+}
+
+// TestDryRunClickUpClient_Fixture는 픽스처 기반 조회와 변경 기록을 테스트합니다.
+func TestDryRunClickUpClient_Fixture(t *testing.T) {
+	ctx := context.Background()
+	client := NewDryRunClickUpClient(nil, []FixtureTask{
+		{ListID: "list1", Task: clickup.Task{ID: "t1", Status: clickup.TaskStatus{Status: "AI요청"}}},
+		{ListID: "list1", Task: clickup.Task{ID: "t2"}},
+		{ListID: "list2", Task: clickup.Task{ID: "t3"}},
+	}, nil)
+
+	tasks, _ := client.GetTasks(ctx, "list1", nil)
+	if len(tasks) != 2 || tasks[0].ID != "t1" {
+		t.Fatalf("list1 태스크 불일치: %d", len(tasks))
+	}
+
+	client.UpdateTaskStatus(ctx, "t1", "작업중")
+	now := time.Now()
+	client.UpdateTaskDates(ctx, "t1", &now, nil)
+	client.MoveTaskToList(ctx, "t2", "done")
+
+	task, _ := client.GetTask(ctx, "t1")
+	if task.Status.Status != "작업중" {
+		t.Errorf("상태 변경이 반영되어야 함: %s", task.Status.Status)
+	}
+
+	tasks, _ = client.GetTasks(ctx, "list1", nil)
+	if len(tasks) != 1 {
+		t.Errorf("이동한 태스크는 제외되어야 함: %d", len(tasks))
+	}
+
+	actions := client.GetActions()
+	if len(actions) != 3 {
+		t.Fatalf("기록된 작업 개수 불일치: %d", len(actions))
+	}
+	if actions[0].Action != "status" || !strings.Contains(actions[0].Detail, "'AI요청' → '작업중'") {
+		t.Errorf("상태 변경 기록 불일치: %+v", actions[0])
+	}
+	if actions[2].Action != "move" || !strings.Contains(actions[2].Detail, "'list1' → 'done'") {
+		t.Errorf("이동 기록 불일치: %+v", actions[2])
+	}
+
+	if _, err := client.GetTask(ctx, "unknown"); err == nil {
+		t.Error("픽스처에 없는 태스크는 에러여야 함")
+	}
+}
+
+// TestDryRunClickUpClient_Source는 실제 클라이언트 조회 결과에 dry-run 변경을 덧씌우는지 테스트합니다.
+func TestDryRunClickUpClient_Source(t *testing.T) {
+	ctx := context.Background()
+	source := &MockClickUpClient{
+		Tasks: []*clickup.Task{{ID: "t1", Status: clickup.TaskStatus{Status: "AI요청"}}},
+	}
+	client := NewDryRunClickUpClient(source, nil, nil)
+
+	client.GetTasks(ctx, "list1", nil)
+	client.UpdateTaskStatus(ctx, "t1", "개발완료")
+
+	tasks, _ := client.GetTasks(ctx, "list1", nil)
+	if len(tasks) != 1 || tasks[0].Status.Status != "개발완료" {
+		t.Errorf("조회 결과에 dry-run 상태가 반영되어야 함: %+v", tasks)
+	}
+	if source.UpdateCalled {
+		t.Error("실제 클라이언트의 변경 API는 호출되지 않아야 함")
+	}
+}
+
+// TestDryRunInvoker는 프롬프트와 실행 스크립트를 디스크에 저장하는지 테스트합니다.
+func TestDryRunInvoker(t *testing.T) {
+	dir := t.TempDir()
+	renderer := NewDefaultInvokerWithModel(8081, TerminalTypeDefault, aimodel.AIModelClaude)
+	invoker := NewDryRunInvoker(renderer, dir, nil)
+
+	result, err := invoker.InvokePlan(context.Background(), "/src/project", "# 로그인 버그", "AI_01")
+	if err != nil {
+		t.Fatalf("InvokePlan 실패: %v", err)
+	}
+
+	runDirs, _ := filepath.Glob(filepath.Join(dir, "AI_01_*"))
+	if len(runDirs) != 1 {
+		t.Fatalf("실행 디렉토리 개수 불일치: %v", runDirs)
+	}
+
+	prompt, err := os.ReadFile(filepath.Join(runDirs[0], "prompt.txt"))
+	if err != nil {
+		t.Fatalf("prompt.txt 읽기 실패: %v", err)
+	}
+	if string(prompt) != result.Prompt || !strings.Contains(string(prompt), "/hook/task-complete") {
+		t.Error("저장된 프롬프트는 완료 지시를 포함한 최종 프롬프트여야 함")
+	}
+
+	script, err := os.ReadFile(filepath.Join(runDirs[0], "invoke.applescript"))
+	if err != nil {
+		t.Fatalf("invoke.applescript 읽기 실패: %v", err)
+	}
+	if !strings.Contains(string(script), "/src/project") || !strings.Contains(string(script), "prompt.txt") {
+		t.Errorf("스크립트에 작업 디렉토리/프롬프트 경로가 포함되어야 함:\n%s", script)
+	}
+
+	// Worker 종료는 Invoker에 위임되어 osascript를 실행하지 않음
+	worker := NewWorker(WorkerConfig{ID: "AI_01"}, nil, invoker, "작업중", "개발완료", "")
+	if err := worker.TerminateClaude(); err != nil {
+		t.Errorf("dry-run 종료는 에러가 없어야 함: %v", err)
+	}
+}
+
+// TestFakeAgent는 가짜 에이전트가 수명주기 순서대로 Hook을 호출하는지 테스트합니다.
+func TestFakeAgent(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	var lastBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		lastBody = string(body)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	agent := NewFakeAgent(server.URL, 0, nil)
+	if err := agent.Run(context.Background(), "/src/project"); err != nil {
+		t.Fatalf("가짜 에이전트 실패: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(paths) != 7 {
+		t.Fatalf("Hook 호출 횟수 불일치: %v", paths)
+	}
+	if paths[0] != "/hook/progress" || paths[1] != "/hook/plan-ready" || paths[6] != "/hook/task-complete" {
+		t.Errorf("Hook 호출 순서 불일치: %v", paths)
+	}
+	if !strings.Contains(lastBody, `"cwd":"/src/project"`) {
+		t.Errorf("완료 페이로드에 cwd가 포함되어야 함: %s", lastBody)
+	}
+}
@@ -0,0 +1,98 @@
+package aiworker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/hookserver"
+)
+
+// FakeAgent는 실제 AI 에이전트 대신 Hook 엔드포인트를 호출하여 작업 수명주기를 재현합니다.
+// dry-run 모드에서 프롬프트 템플릿, 상태 설정, 알림을 end-to-end로 확인할 때 사용합니다.
+type FakeAgent struct {
+	baseURL    string
+	stepDelay  time.Duration
+	httpClient *http.Client
+	logger     *log.Logger
+}
+
+// fakeAgentStep은 가짜 에이전트가 호출하는 Hook 단계입니다.
+type fakeAgentStep struct {
+	path    string
+	payload interface{}
+}
+
+// NewFakeAgent는 새 FakeAgent를 생성합니다.
+// baseURL은 Hook 서버 주소 (예: http://localhost:8081), stepDelay는 단계 사이 대기 시간입니다.
+func NewFakeAgent(baseURL string, stepDelay time.Duration, logger *log.Logger) *FakeAgent {
+	return &FakeAgent{
+		baseURL:    baseURL,
+		stepDelay:  stepDelay,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		logger:     logger,
+	}
+}
+
+// Run은 조사 → Plan 완료 → 계획 승인 → 테스트 작성 → 구현 → 테스트 통과 → 작업 완료 순서로 Hook을 호출합니다.
+func (a *FakeAgent) Run(ctx context.Context, workDir string) error {
+	progress := func(stage, message string) fakeAgentStep {
+		return fakeAgentStep{"/hook/progress", hookserver.ProgressPayload{Cwd: workDir, Stage: stage, Message: message}}
+	}
+
+	steps := []fakeAgentStep{
+		progress(hookserver.StageInvestigated, "dry-run: 원인 조사"),
+		{"/hook/plan-ready", hookserver.PlanReadyPayload{Cwd: workDir, PlanTitle: "dry-run 계획"}},
+		progress(hookserver.StagePlanApproved, ""),
+		progress(hookserver.StageTestsWritten, ""),
+		progress(hookserver.StageImplemented, ""),
+		progress(hookserver.StageTestsPassed, ""),
+		{"/hook/task-complete", hookserver.TaskCompletePayload{Cwd: workDir, Status: "completed"}},
+	}
+
+	for _, step := range steps {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(a.stepDelay):
+		}
+
+		if err := a.post(ctx, step.path, step.payload); err != nil {
+			return fmt.Errorf("%s 호출 실패: %w", step.path, err)
+		}
+		if a.logger != nil {
+			a.logger.Printf("[DRY-RUN] 가짜 에이전트 → %s", step.path)
+		}
+	}
+
+	return nil
+}
+
+// post는 Hook 엔드포인트에 JSON 페이로드를 전송합니다.
+func (a *FakeAgent) post(ctx context.Context, path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("페이로드 직렬화 실패: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", a.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("상태코드 %d", resp.StatusCode)
+	}
+	return nil
+}
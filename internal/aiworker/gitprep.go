@@ -0,0 +1,270 @@
+package aiworker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// GitPrepStep은 태스크 시작 전 git 준비 단계입니다. 설정 순서와 관계없이 아래 순서로 실행합니다.
+type GitPrepStep string
+
+const (
+	GitStepFetch    GitPrepStep = "fetch"    // 원격 기준 브랜치 fetch
+	GitStepCheckout GitPrepStep = "checkout" // 기준 브랜치 체크아웃
+	GitStepReset    GitPrepStep = "reset"    // 원격 기준 브랜치로 hard reset (checkout 필요)
+	GitStepBranch   GitPrepStep = "branch"   // 태스크 브랜치 생성 (Jira ID 또는 태스크 ID)
+)
+
+// AllGitPrepSteps는 모든 git 준비 단계를 실행 순서대로 나열합니다.
+var AllGitPrepSteps = []GitPrepStep{GitStepFetch, GitStepCheckout, GitStepReset, GitStepBranch}
+
+// CleanupPolicy는 태스크 종료(완료/실패/취소) 후 작업 트리 정리 방식입니다.
+type CleanupPolicy string
+
+const (
+	CleanupKeep    CleanupPolicy = "keep"    // 태스크 브랜치와 작업 트리를 그대로 유지 (기본값)
+	CleanupStash   CleanupPolicy = "stash"   // 커밋되지 않은 변경을 stash하고 기준 브랜치로 복귀 (태스크 브랜치 유지)
+	CleanupDiscard CleanupPolicy = "discard" // 변경을 버리고 기준 브랜치로 복귀한 뒤 태스크 브랜치 삭제 (실패/취소만, 완료는 유지)
+)
+
+// 기본 git 준비 설정
+const (
+	DefaultGitRemote       = "origin"
+	DefaultGitBaseBranch   = "main"
+	DefaultGitBranchPrefix = "ai/"
+)
+
+// ParseCleanupPolicy는 문자열을 정리 정책으로 변환합니다. 빈 문자열이면 keep입니다.
+func ParseCleanupPolicy(s string) (CleanupPolicy, error) {
+	switch p := CleanupPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return CleanupKeep, nil
+	case CleanupKeep, CleanupStash, CleanupDiscard:
+		return p, nil
+	default:
+		return "", fmt.Errorf("알 수 없는 정리 정책: %q (keep, stash, discard)", s)
+	}
+}
+
+// ParseGitPrepSteps는 콤마로 구분된 준비 단계를 파싱합니다. "on"/"all"이면 모든 단계입니다.
+func ParseGitPrepSteps(s string) ([]GitPrepStep, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return nil, nil
+	case "on", "all":
+		return AllGitPrepSteps, nil
+	}
+	enabled := make(map[GitPrepStep]bool)
+	for _, item := range strings.Split(s, ",") {
+		step := GitPrepStep(strings.ToLower(strings.TrimSpace(item)))
+		if step == "" {
+			continue
+		}
+		valid := false
+		for _, known := range AllGitPrepSteps {
+			valid = valid || step == known
+		}
+		if !valid {
+			return nil, fmt.Errorf("알 수 없는 git 준비 단계: %q (fetch, checkout, reset, branch)", step)
+		}
+		enabled[step] = true
+	}
+	// checkout 없이 reset하면 이전 태스크 브랜치 등 현재 브랜치를 기준 브랜치로 덮어씀
+	if enabled[GitStepReset] && !enabled[GitStepCheckout] {
+		return nil, fmt.Errorf("git 준비 단계 reset은 checkout과 함께 사용해야 합니다 (현재 브랜치를 덮어쓰지 않도록)")
+	}
+	var steps []GitPrepStep
+	for _, step := range AllGitPrepSteps {
+		if enabled[step] {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+// GitPrepConfig는 태스크 시작 전 git 준비와 종료 후 정리 설정입니다.
+type GitPrepConfig struct {
+	Steps        []GitPrepStep // 실행할 준비 단계
+	Remote       string        // 원격 이름 (기본: origin)
+	BaseBranch   string        // 기준 브랜치 (기본: main)
+	BranchPrefix string        // 태스크 브랜치 접두사 (기본: ai/)
+	Cleanup      CleanupPolicy // 종료 후 정리 방식
+}
+
+// GitPrep은 Worker의 작업 경로에서 git 준비/정리 명령을 실행합니다.
+type GitPrep struct {
+	config GitPrepConfig
+	git    func(ctx context.Context, dir string, args ...string) (string, error) // 테스트에서 교체
+}
+
+// NewGitPrep은 새 GitPrep을 생성합니다. 비어있는 설정은 기본값을 사용합니다.
+func NewGitPrep(config GitPrepConfig) *GitPrep {
+	if config.Remote == "" {
+		config.Remote = DefaultGitRemote
+	}
+	if config.BaseBranch == "" {
+		config.BaseBranch = DefaultGitBaseBranch
+	}
+	if config.BranchPrefix == "" {
+		config.BranchPrefix = DefaultGitBranchPrefix
+	}
+	if config.Cleanup == "" {
+		config.Cleanup = CleanupKeep
+	}
+	return &GitPrep{config: config, git: runGit}
+}
+
+// branchUnsafe는 브랜치 이름에 쓰지 않는 문자입니다.
+var branchUnsafe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// TaskBranch는 Jira ID(없으면 태스크 ID)로 태스크 브랜치 이름을 만듭니다.
+func (g *GitPrep) TaskBranch(jiraID, taskID string) string {
+	name := jiraID
+	if name == "" {
+		name = taskID
+	}
+	return g.config.BranchPrefix + strings.Trim(branchUnsafe.ReplaceAllString(name, "-"), "-.")
+}
+
+// maxBranchSuffix는 태스크 브랜치 이름이 겹칠 때 붙여볼 최대 번호입니다.
+const maxBranchSuffix = 99
+
+// newTaskBranch는 아직 없는 태스크 브랜치 이름을 찾습니다.
+// 같은 이름의 브랜치가 있으면 이전 시도의 작업을 덮어쓰지 않도록 -2, -3 ... 접미사를 붙입니다.
+func (g *GitPrep) newTaskBranch(ctx context.Context, dir, name string) (string, error) {
+	for i := 1; i <= maxBranchSuffix; i++ {
+		candidate := name
+		if i > 1 {
+			candidate = fmt.Sprintf("%s-%d", name, i)
+		}
+		out, err := g.git(ctx, dir, "branch", "--list", candidate)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(out) == "" {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("사용할 수 있는 태스크 브랜치 이름 없음: %s-%d까지 모두 존재", name, maxBranchSuffix)
+}
+
+// Prepare는 설정된 준비 단계를 순서대로 실행하고 만든 태스크 브랜치 이름을 반환합니다.
+// 태스크 브랜치는 항상 새로 만들며(기존 브랜치는 덮어쓰지 않음), branch 단계가 없으면 빈 문자열을 반환합니다.
+func (g *GitPrep) Prepare(ctx context.Context, dir, jiraID, taskID string) (string, error) {
+	remoteBranch := g.config.Remote + "/" + g.config.BaseBranch
+	var branch string
+	for _, step := range g.config.Steps {
+		var err error
+		switch step {
+		case GitStepFetch:
+			_, err = g.git(ctx, dir, "fetch", "--quiet", g.config.Remote, g.config.BaseBranch)
+		case GitStepCheckout:
+			_, err = g.git(ctx, dir, "checkout", "--quiet", g.config.BaseBranch)
+		case GitStepReset:
+			_, err = g.git(ctx, dir, "reset", "--quiet", "--hard", remoteBranch)
+		case GitStepBranch:
+			branch, err = g.newTaskBranch(ctx, dir, g.TaskBranch(jiraID, taskID))
+			if err == nil {
+				_, err = g.git(ctx, dir, "checkout", "--quiet", "-b", branch)
+			}
+		}
+		if err != nil {
+			return "", fmt.Errorf("git %s 실패: %w", step, err)
+		}
+	}
+	return branch, nil
+}
+
+// Cleanup은 정리 정책에 따라 작업 트리를 정리합니다.
+// branch가 있으면 stash/discard 후 기준 브랜치로 돌아가고, discard면 태스크 브랜치를 삭제합니다.
+func (g *GitPrep) Cleanup(ctx context.Context, dir, branch string) error {
+	switch g.config.Cleanup {
+	case CleanupStash:
+		status, err := g.git(ctx, dir, "status", "--porcelain")
+		if err != nil {
+			return fmt.Errorf("git status 실패: %w", err)
+		}
+		if status != "" {
+			message := fmt.Sprintf("ai-worker cleanup %s %s", branch, time.Now().Format("2006-01-02 15:04:05"))
+			if _, err := g.git(ctx, dir, "stash", "push", "--include-untracked", "-m", message); err != nil {
+				return fmt.Errorf("git stash 실패: %w", err)
+			}
+		}
+	case CleanupDiscard:
+		if _, err := g.git(ctx, dir, "reset", "--quiet", "--hard"); err != nil {
+			return fmt.Errorf("git reset 실패: %w", err)
+		}
+		if _, err := g.git(ctx, dir, "clean", "-fd"); err != nil {
+			return fmt.Errorf("git clean 실패: %w", err)
+		}
+	default:
+		return nil
+	}
+
+	if branch == "" {
+		return nil
+	}
+	if _, err := g.git(ctx, dir, "checkout", "--quiet", g.config.BaseBranch); err != nil {
+		return fmt.Errorf("기준 브랜치 %s 체크아웃 실패: %w", g.config.BaseBranch, err)
+	}
+	if g.config.Cleanup == CleanupDiscard {
+		if _, err := g.git(ctx, dir, "branch", "-D", branch); err != nil {
+			return fmt.Errorf("태스크 브랜치 %s 삭제 실패: %w", branch, err)
+		}
+	}
+	return nil
+}
+
+// prepareGit은 태스크 시작 전 git 준비를 실행하고 태스크 브랜치를 기록합니다.
+func (w *Worker) prepareGit(ctx context.Context, jiraID, taskID string) error {
+	if w.gitPrep == nil {
+		return nil
+	}
+	branch, err := w.gitPrep.Prepare(ctx, w.config.SrcPath, jiraID, taskID)
+	if err != nil {
+		return fmt.Errorf("git 준비 실패: %w", err)
+	}
+	if branch != "" {
+		fmt.Printf("[%s] 태스크 브랜치: %s\n", w.config.ID, branch)
+	}
+	w.mu.Lock()
+	w.taskBranch = branch
+	w.gitPrepared = true
+	w.mu.Unlock()
+	return nil
+}
+
+// cleanupGit은 종료 상태 진입 시 정리 정책에 따라 작업 트리를 정리합니다. (OnEnter 훅)
+// git 준비를 하지 않은 태스크는 정리하지 않습니다.
+func (w *Worker) cleanupGit(ctx context.Context, t Transition) {
+	w.mu.Lock()
+	branch, prepared := w.taskBranch, w.gitPrepared
+	w.taskBranch, w.gitPrepared = "", false
+	w.mu.Unlock()
+	if !prepared {
+		return
+	}
+
+	// 완료된 작업은 버리지 않음 (discard는 실패/취소에만 적용)
+	if w.gitPrep.config.Cleanup == CleanupDiscard && t.To == StateCompleted {
+		fmt.Printf("[%s] 완료된 작업은 discard하지 않고 태스크 브랜치 %s를 유지합니다\n", w.config.ID, branch)
+		return
+	}
+	if err := w.gitPrep.Cleanup(ctx, w.config.SrcPath, branch); err != nil {
+		fmt.Printf("[%s] ⚠️ 작업 트리 정리 실패 (%s): %v\n", w.config.ID, w.gitPrep.config.Cleanup, err)
+		return
+	}
+	if w.gitPrep.config.Cleanup != CleanupKeep {
+		fmt.Printf("[%s] 작업 트리 정리 완료 (%s)\n", w.config.ID, w.gitPrep.config.Cleanup)
+	}
+}
+
+// TaskBranch는 현재 태스크의 git 브랜치를 반환합니다. (git 준비를 하지 않았으면 빈 문자열)
+func (w *Worker) TaskBranch() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.taskBranch
+}
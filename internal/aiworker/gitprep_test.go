@@ -0,0 +1,233 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TestParseGitPrepSteps는 git 준비 단계 파싱과 실행 순서 정렬을 테스트합니다.
+func TestParseGitPrepSteps(t *testing.T) {
+	steps, err := ParseGitPrepSteps("branch, fetch")
+	if err != nil || !reflect.DeepEqual(steps, []GitPrepStep{GitStepFetch, GitStepBranch}) {
+		t.Errorf("단계는 실행 순서로 정렬되어야 함: %v, %v", steps, err)
+	}
+	if steps, _ := ParseGitPrepSteps("on"); !reflect.DeepEqual(steps, AllGitPrepSteps) {
+		t.Errorf("on은 모든 단계여야 함: %v", steps)
+	}
+	if steps, _ := ParseGitPrepSteps(""); steps != nil {
+		t.Errorf("빈 값은 준비 안함이어야 함: %v", steps)
+	}
+	if _, err := ParseGitPrepSteps("fetch,rebase"); err == nil {
+		t.Error("알 수 없는 단계는 에러여야 함")
+	}
+	if _, err := ParseGitPrepSteps("fetch,reset,branch"); err == nil {
+		t.Error("checkout 없는 reset은 현재 브랜치를 덮어쓰므로 에러여야 함")
+	}
+	if _, err := ParseGitPrepSteps("checkout,reset"); err != nil {
+		t.Errorf("checkout과 함께인 reset은 허용되어야 함: %v", err)
+	}
+}
+
+// TestGitPrep_TaskBranch는 태스크 브랜치 이름 생성을 테스트합니다.
+func TestGitPrep_TaskBranch(t *testing.T) {
+	g := NewGitPrep(GitPrepConfig{})
+	if got := g.TaskBranch("PROJ-123", "abc"); got != "ai/PROJ-123" {
+		t.Errorf("Jira ID 브랜치 불일치: %s", got)
+	}
+	if got := g.TaskBranch("", "86a1 b2/c"); got != "ai/86a1-b2-c" {
+		t.Errorf("태스크 ID 브랜치 불일치: %s", got)
+	}
+}
+
+// TestGitPrep_Prepare는 준비 단계별 git 명령을 테스트합니다.
+func TestGitPrep_Prepare(t *testing.T) {
+	git := &fakeGit{}
+	g := NewGitPrep(GitPrepConfig{Steps: AllGitPrepSteps, Remote: "upstream", BaseBranch: "develop"})
+	g.git = git.run
+
+	branch, err := g.Prepare(context.Background(), "/repo", "PROJ-1", "task1")
+	if err != nil || branch != "ai/PROJ-1" {
+		t.Fatalf("준비 결과 불일치: %s, %v", branch, err)
+	}
+	want := []string{
+		"fetch --quiet upstream develop",
+		"checkout --quiet develop",
+		"reset --quiet --hard upstream/develop",
+		"branch --list ai/PROJ-1",
+		"checkout --quiet -b ai/PROJ-1",
+	}
+	if !reflect.DeepEqual(git.calls, want) {
+		t.Errorf("git 명령 불일치:\n got %v\nwant %v", git.calls, want)
+	}
+
+	// 실패하면 이후 단계를 실행하지 않음
+	git = &fakeGit{errs: map[string]error{"checkout": errors.New("local changes")}}
+	g.git = git.run
+	if _, err := g.Prepare(context.Background(), "/repo", "", "task1"); err == nil || !strings.Contains(err.Error(), "git checkout 실패") {
+		t.Errorf("체크아웃 실패 에러 불일치: %v", err)
+	}
+	if len(git.calls) != 2 {
+		t.Errorf("실패 후 단계를 중단해야 함: %v", git.calls)
+	}
+}
+
+// TestGitPrep_Prepare_ExistingBranch는 같은 이름의 태스크 브랜치가 있으면 덮어쓰지 않고 접미사를 붙이는지 테스트합니다.
+func TestGitPrep_Prepare_ExistingBranch(t *testing.T) {
+	existing := map[string]bool{"ai/PROJ-1": true, "ai/PROJ-1-2": true}
+	var calls []string
+	g := NewGitPrep(GitPrepConfig{Steps: []GitPrepStep{GitStepBranch}})
+	g.git = func(ctx context.Context, dir string, args ...string) (string, error) {
+		calls = append(calls, strings.Join(args, " "))
+		if args[0] == "branch" && existing[args[2]] {
+			return "  " + args[2], nil
+		}
+		return "", nil
+	}
+
+	branch, err := g.Prepare(context.Background(), "/repo", "PROJ-1", "task1")
+	if err != nil || branch != "ai/PROJ-1-3" {
+		t.Fatalf("기존 브랜치를 피한 이름이어야 함: %s, %v", branch, err)
+	}
+	if last := calls[len(calls)-1]; last != "checkout --quiet -b ai/PROJ-1-3" {
+		t.Errorf("새 브랜치로 체크아웃해야 함: %v", calls)
+	}
+}
+
+// TestGitPrep_Cleanup은 정리 정책별 git 명령을 테스트합니다.
+func TestGitPrep_Cleanup(t *testing.T) {
+	tests := []struct {
+		policy CleanupPolicy
+		status string
+		branch string
+		want   []string // 명령 접두사
+	}{
+		{CleanupKeep, " M a.go", "ai/PROJ-1", nil},
+		{CleanupStash, " M a.go", "ai/PROJ-1", []string{"status", "stash push --include-untracked -m ai-worker cleanup ai/PROJ-1", "checkout --quiet main"}},
+		{CleanupStash, "", "ai/PROJ-1", []string{"status", "checkout --quiet main"}},
+		{CleanupDiscard, " M a.go", "ai/PROJ-1", []string{"reset --quiet --hard", "clean -fd", "checkout --quiet main", "branch -D ai/PROJ-1"}},
+		{CleanupDiscard, "", "", []string{"reset --quiet --hard", "clean -fd"}},
+	}
+
+	for _, tt := range tests {
+		git := &fakeGit{outputs: map[string]string{"status": tt.status}}
+		g := NewGitPrep(GitPrepConfig{Cleanup: tt.policy})
+		g.git = git.run
+		if err := g.Cleanup(context.Background(), "/repo", tt.branch); err != nil {
+			t.Fatalf("%s 정리 실패: %v", tt.policy, err)
+		}
+		if len(git.calls) != len(tt.want) {
+			t.Fatalf("%s 명령 개수 불일치: %v", tt.policy, git.calls)
+		}
+		for i, prefix := range tt.want {
+			if !strings.HasPrefix(git.calls[i], prefix) {
+				t.Errorf("%s 명령 불일치: got %q, want %q", tt.policy, git.calls[i], prefix)
+			}
+		}
+	}
+}
+
+// TestWorker_GitPrepLifecycle은 태스크 시작 시 브랜치를 만들고 종료 시 정리하는지 테스트합니다.
+func TestWorker_GitPrepLifecycle(t *testing.T) {
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크", Description: "PROJ-7"}}}
+	config := WorkerConfig{
+		ID:      "AI_01",
+		ListID:  "list1",
+		SrcPath: "/repo",
+		GitPrep: &GitPrepConfig{Steps: []GitPrepStep{GitStepBranch}, Cleanup: CleanupDiscard},
+	}
+	worker := NewWorker(config, mockClient, &MockInvoker{Result: &InvokeResult{}}, "작업중", "개발완료", "")
+	git := &fakeGit{}
+	worker.gitPrep.git = git.run
+
+	if err := worker.ProcessTask(context.Background(), "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	if worker.TaskBranch() != "ai/PROJ-7" {
+		t.Errorf("태스크 브랜치 불일치: %q", worker.TaskBranch())
+	}
+
+	git.calls = nil
+	if err := worker.Cancel(context.Background(), "사용자 취소"); err != nil {
+		t.Fatalf("취소 실패: %v", err)
+	}
+	if len(git.calls) != 4 || git.calls[3] != "branch -D ai/PROJ-7" {
+		t.Errorf("취소 시 discard 정리가 실행되어야 함: %v", git.calls)
+	}
+	if worker.TaskBranch() != "" {
+		t.Error("정리 후 태스크 브랜치가 비워져야 함")
+	}
+
+	// 준비 실패 시 태스크 상태를 바꾸지 않고 정리하지 않음
+	mockClient.StatusUpdates = nil
+	git = &fakeGit{errs: map[string]error{"checkout": errors.New("conflict")}}
+	worker.gitPrep.git = git.run
+	if err := worker.ProcessTask(context.Background(), "task1"); err == nil {
+		t.Fatal("git 준비 실패 시 에러여야 함")
+	}
+	if len(mockClient.StatusUpdates) != 0 || worker.IsProcessing() {
+		t.Errorf("git 준비 실패 시 태스크를 시작하지 않아야 함: %v", mockClient.StatusUpdates)
+	}
+	if len(git.calls) != 2 {
+		t.Errorf("준비하지 않은 작업 트리는 정리하지 않아야 함: %v", git.calls)
+	}
+	// 완료된 작업은 discard 정책이어도 버리지 않음
+	git = &fakeGit{}
+	worker.gitPrep.git = git.run
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+		t.Fatalf("실행 전이 실패: %v", err)
+	}
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 처리 실패: %v", err)
+	}
+	if len(git.calls) != 2 || !strings.HasPrefix(git.calls[1], "checkout --quiet -b") {
+		t.Errorf("완료 시 작업 트리를 정리하지 않아야 함: %v", git.calls)
+	}
+}
+
+// TestWorker_CancelDuringGitPrep은 git 준비 중 취소되면 태스크를 작업중으로 바꾸거나 에이전트를 실행하지 않는지 테스트합니다.
+func TestWorker_CancelDuringGitPrep(t *testing.T) {
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "task1", Name: "태스크", Description: "PROJ-7", Status: clickup.TaskStatus{Status: "진행 전"}},
+	}}
+	config := WorkerConfig{
+		ID:      "AI_01",
+		ListID:  "list1",
+		SrcPath: "/repo",
+		GitPrep: &GitPrepConfig{Steps: []GitPrepStep{GitStepBranch}, Cleanup: CleanupDiscard},
+	}
+	invoker := &MockInvoker{Result: &InvokeResult{}}
+	worker := NewWorker(config, mockClient, invoker, "작업중", "개발완료", "")
+	git := &fakeGit{}
+	worker.gitPrep.git = func(ctx context.Context, dir string, args ...string) (string, error) {
+		if args[0] == "checkout" && worker.IsProcessing() {
+			if err := worker.Cancel(ctx, "사용자 취소"); err != nil {
+				t.Errorf("취소 실패: %v", err)
+			}
+		}
+		return git.run(ctx, dir, args...)
+	}
+
+	if err := worker.ProcessTask(context.Background(), "task1"); !errors.Is(err, ErrRunCancelled) {
+		t.Fatalf("준비 중 취소 시 ErrRunCancelled여야 함: %v", err)
+	}
+	for _, update := range mockClient.StatusUpdates {
+		if update.Status == "작업중" {
+			t.Errorf("취소된 태스크를 작업중으로 바꾸면 안 됨: %v", mockClient.StatusUpdates)
+		}
+	}
+	if invoker.InvokeCalled || worker.IsProcessing() {
+		t.Error("취소된 태스크의 에이전트를 실행하면 안 됨")
+	}
+	if last := git.calls[len(git.calls)-1]; !strings.HasPrefix(last, "branch -D") || worker.TaskBranch() != "" {
+		t.Errorf("취소 후 만든 태스크 브랜치를 정리해야 함: %v", git.calls)
+	}
+}
@@ -16,6 +16,38 @@ type ClaudeInvoker interface {
 	InvokePlan(ctx context.Context, workDir, prompt, workerID string) (*InvokeResult, error)
 }
 
+// OptionInvoker는 태스크별 실행 옵션(모델/모드)을 지원하는 Invoker가 구현하는 인터페이스입니다.
+// 구현하지 않은 Invoker는 Worker 기본 모델의 plan 모드로 실행합니다.
+type OptionInvoker interface {
+	Invoke(ctx context.Context, workDir, prompt, workerID string, opts RunOptions) (*InvokeResult, error)
+}
+
+// AgentTerminator는 에이전트 종료를 직접 처리하는 Invoker가 구현하는 인터페이스입니다.
+// 구현하지 않은 Invoker는 터미널 핸들러로 창을 종료합니다.
+type AgentTerminator interface {
+	Terminate(workerID string) error
+}
+
+// AgentSessionChecker는 에이전트 세션 생존 확인을 직접 처리하는 Invoker가 구현하는 인터페이스입니다.
+// 구현하지 않은 Invoker는 터미널 핸들러로 창을 확인합니다.
+type AgentSessionChecker interface {
+	IsSessionAlive(workerID string) (bool, error)
+}
+
+// AgentAvailabilityChecker는 태스크를 시작하기 전에 실행할 에이전트가 있는지 확인하는 Invoker가 구현하는 인터페이스입니다.
+// model은 태스크 오버라이드가 반영된 실행 모델입니다. (비어있으면 Worker 기본 모델)
+// 에이전트가 없으면 ErrAgentUnavailable을 감싼 에러를 반환하며, 태스크는 다음 폴링에서 다시 시도됩니다.
+type AgentAvailabilityChecker interface {
+	CheckAvailable(config WorkerConfig, model aimodel.AIModelType) error
+}
+
+// ReviewInvoker는 리뷰 에이전트 실행을 지원하는 Invoker가 구현하는 인터페이스입니다.
+// 프롬프트에 TDD/완료 알림 지시 대신 리뷰 판정 보고 지시를 붙여 model로 실행합니다.
+// 구현하지 않은 Invoker는 리뷰 단계를 생략합니다.
+type ReviewInvoker interface {
+	InvokeReview(ctx context.Context, workDir, prompt, workerID string, model aimodel.AIModelType) (*InvokeResult, error)
+}
+
 // InvokeResult는 Claude Code 실행 결과입니다.
 type InvokeResult struct {
 	WorkDir   string // 작업 디렉토리
@@ -28,6 +60,7 @@ type DefaultInvoker struct {
 	hookServerPort int
 	terminalType   TerminalType
 	aiModelHandler aimodel.AIModelHandler
+	mcpConfigPath  string // ai-worker MCP 서버 설정 파일 (MCP를 지원하는 모델에만 적용)
 }
 
 // NewDefaultInvoker는 새 DefaultInvoker를 생성합니다.
@@ -76,12 +109,62 @@ func (i *DefaultInvoker) GetAIModelType() aimodel.AIModelType {
 	return i.aiModelHandler.GetType()
 }
 
+// SetMCPConfig는 에이전트가 연결할 ai-worker MCP 서버 설정 파일 경로를 설정합니다.
+// MCP를 지원하지 않는 모델은 curl 지시를 그대로 사용합니다. 빈 문자열이면 MCP를 사용하지 않습니다.
+func (i *DefaultInvoker) SetMCPConfig(configPath string) {
+	i.mcpConfigPath = configPath
+	if configurer, ok := i.aiModelHandler.(aimodel.MCPConfigurer); ok {
+		configurer.SetMCPConfig(configPath)
+	}
+}
+
+// WithOptions는 opts의 모델/모드로 실행하는 DefaultInvoker 사본을 반환합니다.
+func (i *DefaultInvoker) WithOptions(opts RunOptions) *DefaultInvoker {
+	model := opts.Model
+	if model == "" {
+		model = i.aiModelHandler.GetType()
+	}
+	// 핸들러는 공유 상태이므로 항상 새로 생성
+	handler := aimodel.GetAIModelHandler(model, i.hookServerPort, string(i.terminalType))
+	if setter, ok := handler.(aimodel.PermissionModeSetter); ok && opts.Mode != "" {
+		setter.SetPermissionMode(opts.Mode.PermissionMode())
+	}
+	if continuer, ok := handler.(aimodel.SessionContinuer); ok && opts.Continue {
+		continuer.SetContinueSession(true)
+	}
+	if configurer, ok := handler.(aimodel.MCPConfigurer); ok && i.mcpConfigPath != "" {
+		configurer.SetMCPConfig(i.mcpConfigPath)
+	}
+
+	copied := *i
+	copied.aiModelHandler = handler
+	return &copied
+}
+
+// Invoke는 opts의 모델/모드로 AI 모델을 실행합니다.
+func (i *DefaultInvoker) Invoke(ctx context.Context, workDir, prompt, workerID string, opts RunOptions) (*InvokeResult, error) {
+	return i.WithOptions(opts).InvokePlan(ctx, workDir, prompt, workerID)
+}
+
 // InvokePlan은 AI 모델을 플랜 모드로 실행합니다.
 // macOS에서 새 터미널 창을 열어 실행합니다.
 func (i *DefaultInvoker) InvokePlan(ctx context.Context, workDir, prompt, workerID string) (*InvokeResult, error) {
 	// TDD 문구 추가
-	fullPrompt := i.AddTDDSuffix(prompt)
+	return i.run(ctx, workDir, i.AddTDDSuffix(prompt), workerID)
+}
 
+// InvokeReview는 model 리뷰 에이전트를 실행합니다.
+// 코드 수정 없이 판정만 보고하므로 TDD/완료 알림 대신 리뷰 판정 보고 지시를 붙이며,
+// 판정 보고(curl)를 위해 계획 모드가 아닌 acceptEdits 모드로 시작합니다.
+// 리뷰 에이전트는 작업 Worker의 MCP 세션(완료 도구 등)을 사용하지 않습니다.
+func (i *DefaultInvoker) InvokeReview(ctx context.Context, workDir, prompt, workerID string, model aimodel.AIModelType) (*InvokeResult, error) {
+	reviewer := i.WithOptions(RunOptions{Model: model, Mode: ModeDirect})
+	reviewer.SetMCPConfig("")
+	return reviewer.run(ctx, workDir, prompt+aimodel.BuildReviewVerdictInstruction(i.hookServerPort), workerID)
+}
+
+// run은 fullPrompt를 임시 파일에 저장하고 새 터미널에서 AI 모델을 실행합니다.
+func (i *DefaultInvoker) run(ctx context.Context, workDir, fullPrompt, workerID string) (*InvokeResult, error) {
 	// 프롬프트를 임시 파일에 저장 (이스케이프 문제 회피)
 	tmpFile, err := os.CreateTemp("", "claude_prompt_*.txt")
 	if err != nil {
@@ -151,7 +234,7 @@ end tell
 	return script
 }
 
-// AddTDDSuffix는 프롬프트에 TDD 문구와 작업 완료 알림 지시를 추가합니다.
+// AddTDDSuffix는 프롬프트에 TDD 문구, 진행 상황 보고 지시, 작업 완료 알림 지시를 추가합니다.
 // 이미 TDD 관련 내용이 있으면 TDD 문구는 추가하지 않습니다.
 func (i *DefaultInvoker) AddTDDSuffix(prompt string) string {
 	result := prompt
@@ -161,6 +244,9 @@ func (i *DefaultInvoker) AddTDDSuffix(prompt string) string {
 		result += "\n\nTDD 방식으로 개발 진행."
 	}
 
+	// 진행 상황 보고 지시 추가
+	result += i.aiModelHandler.GetProgressInstruction()
+
 	// 작업 완료 알림 지시 추가
 	result += i.GetTaskCompleteInstruction()
 
@@ -88,6 +88,15 @@ func TestDefaultInvoker_AddTDDSuffix(t *testing.T) {
 		if !strings.Contains(result, "task-complete") {
 			t.Error("작업 완료 알림 지시가 포함되어야 함")
 		}
+
+		// 진행 상황 보고 지시가 완료 지시보다 앞에 추가되어야 함
+		progressIdx := strings.Index(result, "/hook/progress")
+		if progressIdx < 0 {
+			t.Fatal("진행 상황 보고 지시가 포함되어야 함")
+		}
+		if progressIdx > strings.Index(result, "task-complete") {
+			t.Error("진행 상황 보고 지시는 완료 지시보다 앞에 있어야 함")
+		}
 	})
 
 	t.Run("이미 TDD 포함", func(t *testing.T) {
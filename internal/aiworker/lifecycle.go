@@ -0,0 +1,140 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TaskState는 AI 작업 실행의 수명 주기 상태입니다.
+type TaskState string
+
+const (
+	StateIdle             TaskState = "idle"              // 처리 중인 태스크 없음
+	StateQueued           TaskState = "queued"            // 처리 대상으로 선택됨
+	StatePreparing        TaskState = "preparing"         // ClickUp 상태 변경, 프롬프트 생성
+	StatePlanning         TaskState = "planning"          // 에이전트가 계획 수립 중 (plan 모드)
+	StateAwaitingApproval TaskState = "awaiting_approval" // 계획 검토/승인 대기
+	StateExecuting        TaskState = "executing"         // 승인된 계획 실행 중
+	StateVerifying        TaskState = "verifying"         // 완료 보고 후 결과 확인 및 완료 처리 중
+	StateReviewing        TaskState = "reviewing"         // 리뷰 에이전트가 변경 사항 검토 중
+	StateCompleted        TaskState = "completed"         // 작업 완료
+	StateFailed           TaskState = "failed"            // 실패 (API 에러, Context 초과, 완료 처리 실패 등)
+	StateCancelled        TaskState = "cancelled"         // 취소 (사용자 취소, 태스크 삭제/이동/취소 상태)
+	StateRateLimited      TaskState = "rate_limited"      // API 사용량 한도 대기
+)
+
+// transitions는 상태별 허용되는 다음 상태 목록입니다.
+// 종료 상태(완료/실패/취소)에서는 전이할 수 없으며, 처리 종료 시 Reset으로 유휴 상태가 됩니다.
+var transitions = map[TaskState][]TaskState{
+	StateIdle:             {StateQueued},
+	StateQueued:           {StatePreparing, StateFailed, StateCancelled},
+	StatePreparing:        {StatePlanning, StateFailed, StateCancelled},
+	StatePlanning:         {StateAwaitingApproval, StateExecuting, StateRateLimited, StateFailed, StateCancelled},
+	StateAwaitingApproval: {StatePlanning, StateExecuting, StateFailed, StateCancelled},
+	StateExecuting:        {StateVerifying, StateRateLimited, StateFailed, StateCancelled},
+	StateVerifying:        {StateExecuting, StateReviewing, StateCompleted, StateFailed, StateCancelled},
+	StateReviewing:        {StateVerifying, StateExecuting, StateFailed, StateCancelled},
+	StateRateLimited:      {StatePlanning, StateExecuting, StateFailed, StateCancelled},
+}
+
+// IsTerminal은 종료 상태(완료/실패/취소)인지 확인합니다.
+func (s TaskState) IsTerminal() bool {
+	return s == StateCompleted || s == StateFailed || s == StateCancelled
+}
+
+// CanTransition은 from에서 to로의 전이가 허용되는지 확인합니다.
+func CanTransition(from, to TaskState) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrIllegalTransition은 허용되지 않는 상태 전이 요청 시 반환됩니다.
+var ErrIllegalTransition = errors.New("허용되지 않는 상태 전이")
+
+// Transition은 상태 전이 기록입니다.
+type Transition struct {
+	From   TaskState
+	To     TaskState
+	Reason string // 전이 사유 (알림 본문으로도 사용)
+	At     time.Time
+}
+
+// TransitionHook은 상태 진입 시 실행되는 부수 효과입니다. (Slack 알림, 에이전트 종료 등)
+type TransitionHook func(ctx context.Context, t Transition)
+
+// StateMachine은 검증된 전이만 허용하는 태스크 수명 주기 상태 머신입니다.
+// 허용되지 않는 전이는 로그를 남기고 거부하며, 전이 후 진입 상태에 등록된 훅을 순서대로 실행합니다.
+type StateMachine struct {
+	mu    sync.Mutex
+	state TaskState
+	hooks map[TaskState][]TransitionHook
+	logf  func(format string, args ...interface{})
+}
+
+// NewStateMachine은 initial 상태의 새 StateMachine을 생성합니다.
+func NewStateMachine(initial TaskState) *StateMachine {
+	return &StateMachine{
+		state: initial,
+		hooks: make(map[TaskState][]TransitionHook),
+		logf:  func(format string, args ...interface{}) { fmt.Printf(format+"\n", args...) },
+	}
+}
+
+// SetLogf는 거부된 전이를 기록할 로그 함수를 설정합니다.
+func (m *StateMachine) SetLogf(logf func(format string, args ...interface{})) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logf = logf
+}
+
+// State는 현재 상태를 반환합니다.
+func (m *StateMachine) State() TaskState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// OnEnter는 state 진입 시 실행할 훅을 등록합니다.
+func (m *StateMachine) OnEnter(state TaskState, hook TransitionHook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks[state] = append(m.hooks[state], hook)
+}
+
+// Transition은 to 상태로 전이하고 진입 훅을 실행합니다.
+// 허용되지 않는 전이는 상태를 바꾸지 않고 ErrIllegalTransition을 반환합니다.
+// 훅은 잠금 밖에서 실행되므로 훅 안에서 State를 조회할 수 있습니다.
+func (m *StateMachine) Transition(ctx context.Context, to TaskState, reason string) (Transition, error) {
+	m.mu.Lock()
+	from := m.state
+	if !CanTransition(from, to) {
+		logf := m.logf
+		m.mu.Unlock()
+		logf("⚠️ 상태 전이 거부: %s → %s (%s)", from, to, reason)
+		return Transition{}, fmt.Errorf("%w: %s → %s", ErrIllegalTransition, from, to)
+	}
+	m.state = to
+	hooks := append([]TransitionHook(nil), m.hooks[to]...)
+	m.mu.Unlock()
+
+	t := Transition{From: from, To: to, Reason: reason, At: time.Now()}
+	for _, hook := range hooks {
+		hook(ctx, t)
+	}
+	return t, nil
+}
+
+// Reset은 검증과 훅 없이 상태를 설정합니다.
+// 새 실행 시작, 처리 종료, 재시작 후 복원에 사용합니다.
+func (m *StateMachine) Reset(state TaskState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state = state
+}
@@ -0,0 +1,202 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// terminatingInvoker는 에이전트 종료 요청을 기록하는 테스트용 Invoker입니다.
+type terminatingInvoker struct {
+	MockInvoker
+	terminated []string
+}
+
+func (i *terminatingInvoker) Terminate(workerID string) error {
+	i.terminated = append(i.terminated, workerID)
+	return nil
+}
+
+// advanceTo는 Worker를 주어진 상태들로 순서대로 전이합니다.
+func advanceTo(t *testing.T, w *Worker, states ...TaskState) {
+	t.Helper()
+	for _, state := range states {
+		if _, err := w.Transition(context.Background(), state, "테스트"); err != nil {
+			t.Fatalf("%s 전이 실패: %v", state, err)
+		}
+	}
+}
+
+// recordTransitions는 모든 상태 진입을 기록하는 훅을 등록합니다.
+// 종료 상태는 훅 실행 시점의 태스크 ID를 함께 기록합니다.
+func recordTransitions(w *Worker) *[]string {
+	var got []string
+	states := []TaskState{StateQueued, StatePreparing, StatePlanning, StateAwaitingApproval, StateExecuting,
+		StateVerifying, StateReviewing, StateRateLimited, StateCompleted, StateFailed, StateCancelled}
+	for _, state := range states {
+		w.OnEnter(state, func(ctx context.Context, t Transition) {
+			entry := string(t.To)
+			if t.To.IsTerminal() {
+				entry += ":" + w.GetCurrentTaskID()
+			}
+			got = append(got, entry)
+		})
+	}
+	return &got
+}
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to TaskState
+		want     bool
+	}{
+		{StateIdle, StateQueued, true},
+		{StateQueued, StatePreparing, true},
+		{StatePlanning, StateAwaitingApproval, true},
+		{StateAwaitingApproval, StateExecuting, true},
+		{StateExecuting, StateVerifying, true},
+		{StateVerifying, StateCompleted, true},
+		{StateRateLimited, StateExecuting, true},
+		{StateVerifying, StateReviewing, true},
+		{StateReviewing, StateVerifying, true},
+		{StateReviewing, StateExecuting, true},
+		{StateReviewing, StateCompleted, false},
+		{StateExecuting, StateReviewing, false},
+		{StateQueued, StateCompleted, false},
+		{StatePlanning, StateVerifying, false},
+		{StateCompleted, StateExecuting, false},
+		{StateFailed, StateQueued, false},
+		{StateExecuting, StateExecuting, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("%s → %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+// TestStateMachine_RejectsIllegalTransition은 허용되지 않는 전이의 거부와 로그를 테스트합니다.
+func TestStateMachine_RejectsIllegalTransition(t *testing.T) {
+	m := NewStateMachine(StateQueued)
+	var logged []string
+	m.SetLogf(func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+	hookCalled := false
+	m.OnEnter(StateCompleted, func(ctx context.Context, t Transition) { hookCalled = true })
+
+	_, err := m.Transition(context.Background(), StateCompleted, "완료")
+	if !errors.Is(err, ErrIllegalTransition) {
+		t.Fatalf("ErrIllegalTransition이어야 함: %v", err)
+	}
+	if m.State() != StateQueued || hookCalled {
+		t.Errorf("거부된 전이는 상태/훅에 영향이 없어야 함: state=%s hook=%v", m.State(), hookCalled)
+	}
+	if len(logged) != 1 {
+		t.Errorf("거부된 전이는 로그를 남겨야 함: %v", logged)
+	}
+}
+
+// TestWorker_Lifecycle은 태스크 처리 전체 흐름의 상태 전이와 부수 효과를 테스트합니다.
+func TestWorker_Lifecycle(t *testing.T) {
+	client := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "테스트 태스크", Status: clickup.TaskStatus{Status: "진행 전"}}}}
+	invoker := &terminatingInvoker{MockInvoker: MockInvoker{Result: &InvokeResult{}}}
+	worker := NewWorker(WorkerConfig{ID: "AI_01", ListID: "list1", SrcPath: "/test"}, client, invoker, "작업중", "개발완료", "")
+	got := recordTransitions(worker)
+
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	if worker.TaskState() != StatePlanning {
+		t.Fatalf("에이전트 실행 후 planning이어야 함: %s", worker.TaskState())
+	}
+
+	// 계획 보고가 두 번 오면 계획 수정을 거쳐 다시 승인 대기
+	if err := worker.PlanReady(ctx, "계획"); err != nil {
+		t.Fatalf("승인 대기 전이 실패: %v", err)
+	}
+	if err := worker.PlanReady(ctx, "수정된 계획"); err != nil {
+		t.Fatalf("계획 수정 전이 실패: %v", err)
+	}
+
+	// 승인 대기 중 완료 보고는 실행 단계를 거쳐야 함
+	if err := worker.CompleteTask(ctx); !errors.Is(err, ErrIllegalTransition) {
+		t.Fatalf("승인 대기에서 바로 완료할 수 없어야 함: %v", err)
+	}
+	if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+		t.Fatalf("실행 전이 실패: %v", err)
+	}
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 처리 실패: %v", err)
+	}
+
+	want := []string{"preparing", "planning", "awaiting_approval", "planning", "awaiting_approval",
+		"executing", "verifying", "completed:task1"}
+	if fmt.Sprint(*got) != fmt.Sprint(want) {
+		t.Errorf("전이 순서 불일치:\n got %v\nwant %v", *got, want)
+	}
+	if worker.TaskState() != StateIdle || worker.IsProcessing() {
+		t.Errorf("완료 후 유휴 상태여야 함: %s", worker.TaskState())
+	}
+}
+
+// TestWorker_FailAndCancel은 실패/취소 전이의 ClickUp 상태 변경과 에이전트 종료를 테스트합니다.
+func TestWorker_FailAndCancel(t *testing.T) {
+	ctx := context.Background()
+
+	// 에이전트 실행 후 실패: 에이전트 종료 + 보류
+	client := &MockClickUpClient{}
+	invoker := &terminatingInvoker{}
+	worker := NewWorker(WorkerConfig{ID: "AI_01"}, client, invoker, "작업중", "개발완료", "")
+	worker.SetProcessing("task1", "테스트", "", "진행 전")
+	advanceTo(t, worker, StatePreparing, StatePlanning)
+
+	if err := worker.Fail(ctx, "API 에러"); err != nil {
+		t.Fatalf("실패 전이 실패: %v", err)
+	}
+	if len(invoker.terminated) != 1 || len(client.StatusUpdates) != 1 || client.StatusUpdates[0].Status != StatusHold {
+		t.Errorf("실패 시 에이전트 종료 및 보류 변경 필요: terminated=%v updates=%v", invoker.terminated, client.StatusUpdates)
+	}
+	if worker.IsProcessing() {
+		t.Error("실패 후 처리 상태가 클리어되어야 함")
+	}
+
+	// 준비 중 실패: ClickUp/에이전트 변경 없음
+	client = &MockClickUpClient{}
+	invoker = &terminatingInvoker{}
+	worker = NewWorker(WorkerConfig{ID: "AI_01"}, client, invoker, "작업중", "개발완료", "")
+	worker.SetProcessing("task1", "테스트", "", "진행 전")
+	advanceTo(t, worker, StatePreparing)
+	worker.Fail(ctx, "상태 변경 실패")
+	if len(invoker.terminated) != 0 || len(client.StatusUpdates) != 0 {
+		t.Errorf("준비 중 실패는 부수 효과가 없어야 함: terminated=%v updates=%v", invoker.terminated, client.StatusUpdates)
+	}
+
+	// 사용자 취소: 원래 상태로 롤백, 사유에 원래 상태 포함
+	client = &MockClickUpClient{}
+	worker = NewWorker(WorkerConfig{ID: "AI_01"}, client, &terminatingInvoker{}, "작업중", "개발완료", "")
+	var reason string
+	worker.OnEnter(StateCancelled, func(ctx context.Context, t Transition) { reason = t.Reason })
+	worker.SetProcessing("task1", "테스트", "", "진행 전")
+	advanceTo(t, worker, StatePreparing, StatePlanning, StateAwaitingApproval)
+
+	if err := worker.Cancel(ctx, "사용자 취소"); err != nil {
+		t.Fatalf("취소 실패: %v", err)
+	}
+	if len(client.StatusUpdates) != 1 || client.StatusUpdates[0].Status != "진행 전" {
+		t.Errorf("취소 시 원래 상태로 롤백해야 함: %v", client.StatusUpdates)
+	}
+	if reason != "사용자 취소 (원래 상태로 롤백: 진행 전)" {
+		t.Errorf("취소 사유 불일치: %q", reason)
+	}
+
+	// 종료 후 중복 취소는 거부
+	if err := worker.Abort(ctx, "태스크 삭제됨"); err != nil {
+		t.Errorf("처리 중이 아니면 무시해야 함: %v", err)
+	}
+}
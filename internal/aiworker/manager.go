@@ -2,6 +2,7 @@ package aiworker
 
 import (
 	"context"
+	"errors"
 	"log"
 	"sync"
 	"time"
@@ -9,28 +10,60 @@ import (
 
 // Manager는 여러 Worker를 관리합니다.
 type Manager struct {
-	config    Config
-	workers   []*Worker
-	aiListIDs map[string]bool // AI 리스트 ID 맵 (빠른 조회용)
-	mu        sync.RWMutex
-	logger    *log.Logger
+	config     Config
+	workers    []*Worker
+	pools      map[string][]*Worker // 리스트 ID → 리스트를 공유하는 Worker 풀 (설정 순서)
+	poolNext   map[string]int       // 풀별 다음 배정 위치 (유휴 Worker 순환 배정)
+	claims     map[string]*Worker   // 태스크 ID → 선점한 Worker (프로세스 내 중복 처리 방지)
+	mu         sync.Mutex
+	logger     *log.Logger
+	stateStore StateStore // Worker 상태 저장소 (재시작 복구용)
+	slaTracker *SLATracker
+	waitClock  *WaitClock                                // 리스트별 대기 태스크의 대기 시작 시각
+	slaHandler func(ctx context.Context, alert SLAAlert) // SLA 위반/해소 알림 (nil이면 점검 안함)
+	scheduler  *Scheduler                                // 실행 슬롯 배정 (동시 실행/업무 시간/일일 한도)
+
+	preflightHandler  func(ctx context.Context, failure *PreflightError) // 사전 점검 실패 알림 (nil이면 알리지 않음)
+	preflightFailures map[string]string                                  // Worker ID → 마지막으로 알린 사전 점검 실패 (중복 알림 방지)
 }
 
 // NewManager는 새 Manager를 생성합니다.
 func NewManager(config Config) *Manager {
 	m := &Manager{
-		config:    config,
-		workers:   make([]*Worker, 0, len(config.Workers)),
-		aiListIDs: make(map[string]bool),
+		config:     config,
+		workers:    make([]*Worker, 0, len(config.Workers)),
+		pools:      make(map[string][]*Worker),
+		poolNext:   make(map[string]int),
+		claims:     make(map[string]*Worker),
+		slaTracker: NewSLATracker(),
+		waitClock:  NewWaitClock(),
+		scheduler:  NewScheduler(SchedulerConfig{}),
+
+		preflightFailures: make(map[string]string),
 	}
 
 	// Worker 생성
 	for _, wc := range config.Workers {
 		worker := NewWorker(wc, nil, nil, config.StatusWorking, config.StatusCompleted, config.CompletedListID)
 		m.workers = append(m.workers, worker)
-		m.aiListIDs[wc.ListID] = true
+		m.pools[wc.ListID] = append(m.pools[wc.ListID], worker)
+	}
+
+	// 여러 Worker가 공유하는 리스트는 풀로 동작 (ClickUp 선점, 작업중 태스크 제외)
+	for _, pool := range m.pools {
+		if len(pool) > 1 {
+			for _, w := range pool {
+				w.pooled = true
+			}
+		}
 	}
 
+	// 에이전트 실행 시 일일 한도 차감 확정, 실행 종료 시 슬롯과 태스크 선점 반환
+	m.OnEnter(StatePlanning, m.commitSlot)
+	m.OnEnter(StateCompleted, m.releaseSlot)
+	m.OnEnter(StateFailed, m.releaseSlot)
+	m.OnEnter(StateCancelled, m.releaseSlot)
+
 	return m
 }
 
@@ -53,15 +86,128 @@ func (m *Manager) SetInvoker(invoker ClaudeInvoker) {
 	}
 }
 
+// OnEnter는 모든 Worker의 state 진입 시 실행할 부수 효과를 등록합니다.
+func (m *Manager) OnEnter(state TaskState, hook func(ctx context.Context, w *Worker, t Transition)) {
+	for _, w := range m.workers {
+		w.OnEnter(state, func(ctx context.Context, t Transition) {
+			hook(ctx, w, t)
+		})
+	}
+}
+
+// SetScheduler는 실행 슬롯 스케줄러를 설정합니다. Start 전에 호출해야 합니다.
+func (m *Manager) SetScheduler(scheduler *Scheduler) {
+	m.scheduler = scheduler
+}
+
+// StartTask는 태스크를 Worker에 선점하고 스케줄러에서 실행 슬롯을 받은 뒤 처리합니다.
+// 에이전트 확인과 슬롯 배정은 태스크 오버라이드가 반영된 실행 모델 기준입니다.
+// 다른 Worker가 선점한 태스크면 ErrTaskClaimed, 슬롯을 받지 못하면 거부 사유 에러를 반환하며,
+// 태스크는 다음 폴링에서 다시 시도됩니다. 준비 중 취소되면 ErrRunCancelled를 반환합니다.
+func (m *Manager) StartTask(ctx context.Context, worker *Worker, taskID string) error {
+	config := worker.GetConfig()
+	if err := m.claimLocal(taskID, worker); err != nil {
+		return err
+	}
+	task, err := worker.fetchTask(ctx, taskID)
+	if err != nil {
+		m.releaseClaim(worker)
+		return err
+	}
+	opts := worker.resolveRunOptions(task)
+	if checker, ok := worker.invoker.(AgentAvailabilityChecker); ok {
+		if err := checker.CheckAvailable(config, opts.Model); err != nil {
+			m.releaseClaim(worker)
+			return err
+		}
+	}
+	if err := m.scheduler.Acquire(config, opts.Model); err != nil {
+		m.releaseClaim(worker)
+		return err
+	}
+
+	m.logf("[%s] 태스크 처리 시작: %s (모델: %s)", config.ID, taskID, opts.Model)
+	err = worker.processTask(ctx, task, opts)
+	m.reportPreflight(ctx, config.ID, err)
+	if err != nil && !errors.Is(err, ErrRunCancelled) && !worker.IsProcessing() {
+		// 종료 상태 전이 없이 끝난 경우 (취소는 종료 훅에서 이미 반환)
+		m.scheduler.Release(config.ID)
+		m.releaseClaim(worker)
+	}
+	return err
+}
+
+// commitSlot은 에이전트 실행으로 Worker 슬롯의 일일 한도 차감을 확정합니다.
+func (m *Manager) commitSlot(ctx context.Context, w *Worker, t Transition) {
+	m.scheduler.Commit(w.GetConfig().ID)
+}
+
+// releaseSlot은 Worker의 실행 슬롯과 태스크 선점을 반환합니다.
+func (m *Manager) releaseSlot(ctx context.Context, w *Worker, t Transition) {
+	m.scheduler.Release(w.GetConfig().ID)
+	m.releaseClaim(w)
+}
+
+// SetSLAHandler는 SLA 위반/해소 시 호출할 함수를 설정합니다. Start 전에 호출해야 합니다.
+func (m *Manager) SetSLAHandler(handler func(ctx context.Context, alert SLAAlert)) {
+	m.slaHandler = handler
+}
+
+// SetPreflightHandler는 사전 점검 실패 시 호출할 함수를 설정합니다. Start 전에 호출해야 합니다.
+// 같은 Worker의 같은 실패는 한 번만 알리며, 태스크가 정상 시작되면 다시 알릴 수 있습니다.
+func (m *Manager) SetPreflightHandler(handler func(ctx context.Context, failure *PreflightError)) {
+	m.preflightHandler = handler
+}
+
+// reportPreflight는 StartTask 결과가 사전 점검 실패면 알리고, 그 외에는 마지막 실패 기록을 지웁니다.
+func (m *Manager) reportPreflight(ctx context.Context, workerID string, err error) {
+	var failure *PreflightError
+	m.mu.Lock()
+	if !errors.As(err, &failure) {
+		delete(m.preflightFailures, workerID)
+		m.mu.Unlock()
+		return
+	}
+	message := failure.Error()
+	if m.preflightFailures[workerID] == message {
+		m.mu.Unlock()
+		return
+	}
+	m.preflightFailures[workerID] = message
+	m.mu.Unlock()
+
+	if m.preflightHandler != nil {
+		m.preflightHandler(ctx, failure)
+	}
+}
+
 // GetWorkers는 모든 Worker를 반환합니다.
 func (m *Manager) GetWorkers() []*Worker {
 	return m.workers
 }
 
 // GetWorkerByListID는 리스트 ID로 Worker를 찾습니다.
+// 풀이면 유휴 Worker를 순서대로 돌아가며 반환하고, 모두 처리 중이면 첫 Worker를 반환합니다.
 func (m *Manager) GetWorkerByListID(listID string) *Worker {
+	pool := m.pools[listID]
+	if len(pool) == 0 {
+		return nil
+	}
+	if len(pool) > 1 {
+		if w := m.nextIdleWorker(listID); w != nil {
+			return w
+		}
+	}
+	return pool[0]
+}
+
+// GetWorkerByTaskID는 태스크를 처리 중인 Worker를 찾습니다. 없으면 nil을 반환합니다.
+func (m *Manager) GetWorkerByTaskID(taskID string) *Worker {
+	if taskID == "" {
+		return nil
+	}
 	for _, w := range m.workers {
-		if w.config.ListID == listID {
+		if w.IsProcessing() && w.GetCurrentTaskID() == taskID {
 			return w
 		}
 	}
@@ -90,7 +236,7 @@ func (m *Manager) GetWorkerBySrcPath(srcPath string) *Worker {
 
 // IsAIList는 주어진 리스트 ID가 AI 리스트인지 확인합니다.
 func (m *Manager) IsAIList(listID string) bool {
-	return m.aiListIDs[listID]
+	return len(m.pools[listID]) > 0
 }
 
 // AllIdle은 모든 Worker가 유휴 상태인지 확인합니다.
@@ -116,9 +262,69 @@ func (m *Manager) Start(ctx context.Context) {
 		}(worker)
 	}
 
+	if m.slaHandler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.runSLAMonitor(ctx)
+		}()
+	}
+
 	wg.Wait()
 }
 
+// runSLAMonitor는 SLACheckInterval마다 SLA를 점검합니다.
+func (m *Manager) runSLAMonitor(ctx context.Context) {
+	ticker := time.NewTicker(SLACheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CheckSLA(ctx, time.Now())
+		}
+	}
+}
+
+// CheckSLA는 리스트별 대기 SLA와 Worker별 실행 SLA를 점검하고 새 위반과 해소된 위반을 알립니다.
+// 풀 리스트의 대기 태스크는 첫 Worker의 SLA 설정으로 한 번만 점검하며,
+// 태스크 조회에 실패하면 해당 리스트의 대기 SLA 상태는 유지합니다.
+func (m *Manager) CheckSLA(ctx context.Context, now time.Time) {
+	if m.slaHandler == nil {
+		return
+	}
+
+	var alerts []SLAAlert
+	for _, worker := range m.workers {
+		config := worker.GetConfig()
+		if config.SLA.MaxWait > 0 && m.pools[config.ListID][0] == worker {
+			tasks, err := m.WaitingTasks(ctx, config.ListID)
+			if err != nil {
+				m.logf("[%s] SLA 점검용 태스크 조회 실패: %v", config.ID, err)
+			} else {
+				since := m.waitClock.Observe(config.ListID, tasks, now)
+				raised, resolved := m.slaTracker.Update(config.ListID, SLAKindWait, EvaluateWaitSLA(config, tasks, since, now), now)
+				alerts = append(append(alerts, raised...), resolved...)
+			}
+		}
+		if config.SLA.MaxRun > 0 {
+			raised, resolved := m.slaTracker.Update(config.ID, SLAKindRun, EvaluateRunSLA(worker, now), now)
+			alerts = append(append(alerts, raised...), resolved...)
+		}
+	}
+
+	for _, alert := range alerts {
+		if alert.Resolved {
+			m.logf("[%s] SLA 위반 해소 (%s): %s", alert.Scope(), alert.Kind, alert.TaskID)
+		} else {
+			m.logf("[%s] SLA 위반 (%s): %s %v > %v", alert.Scope(), alert.Kind, alert.TaskID, alert.Elapsed.Round(time.Minute), alert.Limit)
+		}
+		m.slaHandler(ctx, alert)
+	}
+}
+
 // runWorker는 개별 Worker의 처리 루프를 실행합니다.
 func (m *Manager) runWorker(ctx context.Context, worker *Worker) {
 	config := worker.GetConfig()
@@ -128,6 +334,7 @@ func (m *Manager) runWorker(ctx context.Context, worker *Worker) {
 
 	// 폴링 간격 (CPU 100% 방지)
 	pollInterval := 10 * time.Second
+	lastDenied := "" // 같은 슬롯 거부 사유는 한 번만 기록
 
 	for {
 		select {
@@ -149,17 +356,27 @@ func (m *Manager) runWorker(ctx context.Context, worker *Worker) {
 					continue
 				}
 
-				// 첫 번째 대기 태스크 처리
-				if len(tasks) > 0 {
-					task := tasks[0]
-					if m.logger != nil {
-						m.logger.Printf("[%s] 태스크 처리 시작: %s", config.ID, task.ID)
-					}
-
-					if err := worker.ProcessTask(ctx, task.ID); err != nil {
-						if m.logger != nil {
-							m.logger.Printf("[%s] 태스크 처리 실패: %v", config.ID, err)
+				// 다른 Worker가 선점하지 않은 첫 번째 대기 태스크 처리
+				if task := m.firstUnclaimed(worker, tasks); task != nil {
+					err := m.StartTask(ctx, worker, task.ID)
+					switch {
+					case errors.Is(err, ErrTaskClaimed):
+						m.logf("[%s] 다른 Worker가 선점한 태스크 건너뜀: %s", config.ID, task.ID)
+					case errors.Is(err, ErrWorkerBusy):
+						// 웹훅으로 이미 다른 태스크를 시작하는 중
+					case errors.Is(err, ErrRunCancelled):
+						lastDenied = ""
+						m.logf("[%s] 준비 중 취소된 태스크: %s", config.ID, task.ID)
+					case IsSlotDenied(err), errors.Is(err, ErrPreflightFailed):
+						if err.Error() != lastDenied {
+							lastDenied = err.Error()
+							m.logf("[%s] 태스크 시작 대기 (%s): %v", config.ID, task.ID, err)
 						}
+					case err != nil:
+						lastDenied = ""
+						m.logf("[%s] 태스크 처리 실패: %v", config.ID, err)
+					default:
+						lastDenied = ""
 					}
 				}
 			}
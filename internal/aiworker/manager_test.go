@@ -1,6 +1,7 @@
 package aiworker
 
 import (
+	"context"
 	"testing"
 )
 
@@ -106,3 +107,32 @@ func TestManager_AllIdle(t *testing.T) {
 		t.Error("처리 완료 후 AllIdle이어야 함")
 	}
 }
+
+// TestManager_GetWorkerByTaskIDAndAbort는 태스크 ID로 Worker 조회와 작업 중단을 테스트합니다.
+func TestManager_GetWorkerByTaskIDAndAbort(t *testing.T) {
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	config.AddWorker("AI_02", "list2", "/path2")
+
+	manager := NewManager(config)
+	manager.SetInvoker(NewDryRunInvoker(NewDefaultInvoker(), t.TempDir(), nil))
+
+	workers := manager.GetWorkers()
+	workers[1].SetProcessing("task2", "Test Task", "", "open")
+
+	if manager.GetWorkerByTaskID("task1") != nil || manager.GetWorkerByTaskID("") != nil {
+		t.Error("처리 중이 아닌 태스크는 nil이어야 함")
+	}
+
+	worker := manager.GetWorkerByTaskID("task2")
+	if worker != workers[1] {
+		t.Fatal("task2를 처리 중인 AI_02를 찾아야 함")
+	}
+
+	if err := worker.Abort(context.Background(), "태스크 삭제됨"); err != nil {
+		t.Errorf("작업 중단 실패: %v", err)
+	}
+	if worker.IsProcessing() || worker.TaskState() != StateIdle || manager.GetWorkerByTaskID("task2") != nil {
+		t.Error("작업 중단 후 처리 상태가 클리어되어야 함")
+	}
+}
@@ -0,0 +1,153 @@
+package aiworker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// AgentMode는 에이전트 실행 방식입니다.
+type AgentMode string
+
+const (
+	ModePlan   AgentMode = "plan"   // 계획 수립 후 승인받아 실행 (기본값)
+	ModeDirect AgentMode = "direct" // 계획 모드 없이 바로 실행
+	ModeReview AgentMode = "review" // 코드를 수정하지 않고 분석/리뷰 결과만 보고
+)
+
+// 태스크별 오버라이드를 지정하는 태그 접두사와 커스텀 필드 이름입니다. (대소문자 무시)
+const (
+	TagPrefixModel       = "model:"
+	TagPrefixMode        = "mode:"
+	CustomFieldNameModel = "AI Model"
+	CustomFieldNameMode  = "AI Mode"
+)
+
+// PermissionMode는 모드에 맞는 Claude Code 시작 권한 모드를 반환합니다.
+func (m AgentMode) PermissionMode() string {
+	if m == ModeDirect {
+		return "acceptEdits"
+	}
+	return "plan"
+}
+
+// PromptSuffix는 모드별로 프롬프트에 덧붙일 지시를 반환합니다.
+func (m AgentMode) PromptSuffix() string {
+	if m != ModeReview {
+		return ""
+	}
+	return "\n\n## 리뷰 전용 작업\n코드를 수정하지 마세요. 원인 분석과 수정 제안만 정리해 보고한 뒤 작업 완료 알림을 보내세요."
+}
+
+// ParseAgentMode는 모드 문자열을 파싱합니다.
+func ParseAgentMode(s string) (AgentMode, error) {
+	switch mode := AgentMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case ModePlan, ModeDirect, ModeReview:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("알 수 없는 실행 모드: %q (plan, direct, review)", s)
+	}
+}
+
+// parseModelType은 모델 문자열을 파싱합니다.
+func parseModelType(s string) (aimodel.AIModelType, error) {
+	switch model := aimodel.AIModelType(strings.ToLower(strings.TrimSpace(s))); model {
+	case aimodel.AIModelClaude, aimodel.AIModelOpenCode, aimodel.AIModelAmpcode:
+		return model, nil
+	default:
+		return "", fmt.Errorf("알 수 없는 AI 모델: %q (claude, opencode, ampcode)", s)
+	}
+}
+
+// RunOptions는 태스크 실행에 사용할 모델과 모드입니다.
+type RunOptions struct {
+	Model    aimodel.AIModelType
+	Mode     AgentMode
+	Continue bool // 작업 디렉토리의 마지막 세션 이어서 실행 (리뷰 수정 요청 전달)
+}
+
+// OverridePolicy는 리스트에서 태스크별로 허용하는 모델/모드 오버라이드입니다.
+// 비어있으면 해당 항목의 오버라이드를 허용하지 않습니다.
+type OverridePolicy struct {
+	Models []aimodel.AIModelType
+	Modes  []AgentMode
+}
+
+// allowsModel은 모델 오버라이드가 허용되는지 확인합니다.
+func (p OverridePolicy) allowsModel(model aimodel.AIModelType) bool {
+	for _, allowed := range p.Models {
+		if allowed == model {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsMode는 모드 오버라이드가 허용되는지 확인합니다.
+func (p OverridePolicy) allowsMode(mode AgentMode) bool {
+	for _, allowed := range p.Modes {
+		if allowed == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// taskOverrideValues는 태스크 태그와 커스텀 필드에서 모델/모드 지정값을 찾습니다. 태그가 우선합니다.
+func taskOverrideValues(task *clickup.Task) (model, mode string) {
+	for _, tag := range task.Tags {
+		name := strings.ToLower(strings.TrimSpace(tag.Name))
+		if v, ok := strings.CutPrefix(name, TagPrefixModel); ok && model == "" {
+			model = v
+		}
+		if v, ok := strings.CutPrefix(name, TagPrefixMode); ok && mode == "" {
+			mode = v
+		}
+	}
+	for _, field := range task.CustomFields {
+		switch {
+		case model == "" && strings.EqualFold(field.Name, CustomFieldNameModel):
+			model = field.Text()
+		case mode == "" && strings.EqualFold(field.Name, CustomFieldNameMode):
+			mode = field.Text()
+		}
+	}
+	return model, mode
+}
+
+// ResolveRunOptions는 Worker 기본값에 태스크 오버라이드를 정책에 따라 적용합니다.
+// 잘못되었거나 허용되지 않은 오버라이드는 무시하고 사유를 rejected로 반환합니다.
+func ResolveRunOptions(config WorkerConfig, task *clickup.Task) (opts RunOptions, rejected []string) {
+	opts = RunOptions{Model: config.AIModelType, Mode: ModePlan}
+	model, mode := taskOverrideValues(task)
+
+	if model != "" {
+		parsed, err := parseModelType(model)
+		switch {
+		case err != nil:
+			rejected = append(rejected, err.Error())
+		case parsed == opts.Model:
+		case !config.Overrides.allowsModel(parsed):
+			rejected = append(rejected, fmt.Sprintf("리스트에서 허용하지 않는 모델: %s", parsed))
+		default:
+			opts.Model = parsed
+		}
+	}
+
+	if mode != "" {
+		parsed, err := ParseAgentMode(mode)
+		switch {
+		case err != nil:
+			rejected = append(rejected, err.Error())
+		case parsed == opts.Mode:
+		case !config.Overrides.allowsMode(parsed):
+			rejected = append(rejected, fmt.Sprintf("리스트에서 허용하지 않는 모드: %s", parsed))
+		default:
+			opts.Mode = parsed
+		}
+	}
+
+	return opts, rejected
+}
@@ -0,0 +1,199 @@
+package aiworker
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// OptionMockInvoker는 실행 옵션을 기록하는 테스트용 Invoker입니다.
+type OptionMockInvoker struct {
+	MockInvoker
+	LastOptions RunOptions
+}
+
+func (m *OptionMockInvoker) Invoke(ctx context.Context, workDir, prompt, workerID string, opts RunOptions) (*InvokeResult, error) {
+	m.LastOptions = opts
+	return m.InvokePlan(ctx, workDir, prompt, workerID)
+}
+
+// TestResolveRunOptions는 태그/커스텀 필드 오버라이드 적용을 테스트합니다.
+func TestResolveRunOptions(t *testing.T) {
+	allowAll := OverridePolicy{
+		Models: []aimodel.AIModelType{aimodel.AIModelClaude, aimodel.AIModelOpenCode},
+		Modes:  []AgentMode{ModeDirect, ModeReview},
+	}
+	modeField := clickup.CustomField{
+		Name: "AI Mode",
+		Type: "drop_down",
+		TypeConfig: clickup.CustomFieldTypeConfig{Options: []clickup.CustomFieldOption{
+			{ID: "opt-plan", Name: "plan", OrderIndex: 0},
+			{ID: "opt-review", Name: "review", OrderIndex: 1},
+		}},
+		Value: float64(1),
+	}
+
+	tests := []struct {
+		name         string
+		policy       OverridePolicy
+		task         *clickup.Task
+		want         RunOptions
+		wantRejected int
+	}{
+		{
+			name: "오버라이드 없음",
+			task: &clickup.Task{},
+			want: RunOptions{Model: aimodel.AIModelClaude, Mode: ModePlan},
+		},
+		{
+			name:   "태그로 모델/모드 지정",
+			policy: allowAll,
+			task:   &clickup.Task{Tags: []clickup.Tag{{Name: "Model:OpenCode"}, {Name: "mode:direct"}}},
+			want:   RunOptions{Model: aimodel.AIModelOpenCode, Mode: ModeDirect},
+		},
+		{
+			name:   "드롭다운 커스텀 필드",
+			policy: allowAll,
+			task:   &clickup.Task{CustomFields: []clickup.CustomField{modeField}},
+			want:   RunOptions{Model: aimodel.AIModelClaude, Mode: ModeReview},
+		},
+		{
+			name:   "태그가 커스텀 필드보다 우선",
+			policy: allowAll,
+			task: &clickup.Task{
+				Tags:         []clickup.Tag{{Name: "mode:direct"}},
+				CustomFields: []clickup.CustomField{modeField},
+			},
+			want: RunOptions{Model: aimodel.AIModelClaude, Mode: ModeDirect},
+		},
+		{
+			name:         "정책에서 허용하지 않음",
+			task:         &clickup.Task{Tags: []clickup.Tag{{Name: "model:opencode"}, {Name: "mode:direct"}}},
+			want:         RunOptions{Model: aimodel.AIModelClaude, Mode: ModePlan},
+			wantRejected: 2,
+		},
+		{
+			name:         "알 수 없는 값",
+			policy:       allowAll,
+			task:         &clickup.Task{Tags: []clickup.Tag{{Name: "model:gpt"}, {Name: "mode:yolo"}}},
+			want:         RunOptions{Model: aimodel.AIModelClaude, Mode: ModePlan},
+			wantRejected: 2,
+		},
+		{
+			name: "기본값과 같으면 정책 없이 허용",
+			task: &clickup.Task{Tags: []clickup.Tag{{Name: "model:claude"}, {Name: "mode:plan"}}},
+			want: RunOptions{Model: aimodel.AIModelClaude, Mode: ModePlan},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := WorkerConfig{ID: "AI_01", AIModelType: aimodel.AIModelClaude, Overrides: tt.policy}
+			got, rejected := ResolveRunOptions(config, tt.task)
+			if got != tt.want {
+				t.Errorf("옵션 불일치: got %+v, want %+v", got, tt.want)
+			}
+			if len(rejected) != tt.wantRejected {
+				t.Errorf("거부 사유 개수 불일치: got %v, want %d", rejected, tt.wantRejected)
+			}
+		})
+	}
+}
+
+// TestWorker_ProcessTask_RunOptions는 태스크 오버라이드가 Invoker와 상태에 반영되는지 테스트합니다.
+func TestWorker_ProcessTask_RunOptions(t *testing.T) {
+	mockClient := &MockClickUpClient{
+		Tasks: []*clickup.Task{
+			{
+				ID:   "task1",
+				Name: "리뷰 태스크",
+				Tags: []clickup.Tag{{Name: "model:opencode"}, {Name: "mode:review"}},
+			},
+		},
+	}
+	invoker := &OptionMockInvoker{
+		MockInvoker: MockInvoker{Result: &InvokeResult{StartedAt: time.Now().Format(time.RFC3339)}},
+	}
+	config := WorkerConfig{
+		ID:          "AI_01",
+		ListID:      "list1",
+		SrcPath:     "/test/path",
+		AIModelType: aimodel.AIModelClaude,
+		Overrides: OverridePolicy{
+			Models: []aimodel.AIModelType{aimodel.AIModelOpenCode},
+			Modes:  []AgentMode{ModeReview},
+		},
+	}
+	worker := NewWorker(config, mockClient, invoker, "작업중", "개발완료", "")
+
+	if err := worker.ProcessTask(context.Background(), "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+
+	want := RunOptions{Model: aimodel.AIModelOpenCode, Mode: ModeReview}
+	if invoker.LastOptions != want {
+		t.Errorf("Invoker 옵션 불일치: got %+v, want %+v", invoker.LastOptions, want)
+	}
+	if !strings.Contains(invoker.LastPrompt, "리뷰 전용 작업") {
+		t.Error("리뷰 모드 프롬프트 지시가 포함되어야 함")
+	}
+	if worker.RunOptions() != want {
+		t.Errorf("Worker 실행 옵션 불일치: %+v", worker.RunOptions())
+	}
+
+	state := worker.State()
+	if state.Model != "opencode" || state.Mode != ModeReview {
+		t.Errorf("상태에 모델/모드가 저장되어야 함: %+v", state)
+	}
+
+	worker.ClearProcessing()
+	if worker.RunOptions() != (RunOptions{}) {
+		t.Errorf("처리 종료 후 실행 옵션이 초기화되어야 함: %+v", worker.RunOptions())
+	}
+}
+
+// TestDefaultInvoker_WithOptions는 실행 옵션에 따른 모델/권한 모드 전환을 테스트합니다.
+func TestDefaultInvoker_WithOptions(t *testing.T) {
+	invoker := NewDefaultInvoker()
+
+	direct := invoker.WithOptions(RunOptions{Mode: ModeDirect})
+	script := direct.BuildAppleScriptWithFile("/test/dir", "/tmp/prompt.txt", "AI_01")
+	if !strings.Contains(script, "--permission-mode acceptEdits") {
+		t.Error("direct 모드는 acceptEdits 권한 모드로 실행되어야 함")
+	}
+	if direct.GetAIModelType() != aimodel.AIModelClaude {
+		t.Errorf("모델 미지정 시 기존 모델 유지: %s", direct.GetAIModelType())
+	}
+
+	plan := invoker.WithOptions(RunOptions{Mode: ModePlan})
+	if !strings.Contains(plan.BuildAppleScriptWithFile("/test/dir", "/tmp/prompt.txt", "AI_01"), "--permission-mode plan") {
+		t.Error("plan 모드는 계획 모드로 실행되어야 함")
+	}
+
+	if got := invoker.WithOptions(RunOptions{Model: aimodel.AIModelOpenCode}).GetAIModelType(); got != aimodel.AIModelOpenCode {
+		t.Errorf("모델 오버라이드 불일치: %s", got)
+	}
+	if invoker.GetAIModelType() != aimodel.AIModelClaude {
+		t.Error("원본 Invoker는 변경되지 않아야 함")
+	}
+
+	resumed := invoker.WithOptions(RunOptions{Mode: ModeDirect, Continue: true})
+	if !strings.Contains(resumed.BuildAppleScriptWithFile("/test/dir", "/tmp/prompt.txt", "AI_01"), "claude --continue --permission-mode acceptEdits") {
+		t.Error("Continue 옵션은 마지막 세션을 이어서 실행해야 함")
+	}
+	if strings.Contains(direct.BuildAppleScriptWithFile("/test/dir", "/tmp/prompt.txt", "AI_01"), "--continue") {
+		t.Error("Continue 옵션이 없으면 새 세션으로 실행해야 함")
+	}
+
+	invoker.SetMCPConfig("/tmp/mcp.json")
+	if !strings.Contains(invoker.WithOptions(RunOptions{Mode: ModeDirect}).BuildAppleScriptWithFile("/test/dir", "/tmp/prompt.txt", "AI_01"), "--mcp-config '/tmp/mcp.json'") {
+		t.Error("MCP 설정은 옵션 사본에도 유지되어야 함")
+	}
+	if strings.Contains(invoker.WithOptions(RunOptions{Model: aimodel.AIModelOpenCode}).AddTDDSuffix("작업"), "complete_task") {
+		t.Error("MCP를 지원하지 않는 모델은 curl 지시를 사용해야 함")
+	}
+}
@@ -0,0 +1,216 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// ClaimSettleDelay는 ClickUp에서 태스크를 선점한 뒤 다른 Worker의 동시 선점을 확인하기 전까지 기다리는 시간입니다.
+const ClaimSettleDelay = 2 * time.Second
+
+// ErrTaskClaimed는 다른 Worker가 이미 선점한 태스크를 시작하려 할 때 반환됩니다.
+var ErrTaskClaimed = errors.New("다른 Worker가 선점한 태스크")
+
+// ErrWorkerBusy는 Worker가 이미 다른 태스크를 선점하고 있을 때 반환됩니다.
+var ErrWorkerBusy = errors.New("Worker가 다른 태스크를 처리 중")
+
+// TaskClaimer는 Worker 풀 선점(상태와 담당자 동시 변경)을 지원하는 ClickUp 클라이언트가 구현하는 인터페이스입니다.
+type TaskClaimer interface {
+	ClaimTask(ctx context.Context, taskID, status string, assigneeID int) error
+	UnassignTask(ctx context.Context, taskID string, assigneeID int) error
+}
+
+// claimTask는 풀 Worker가 ClickUp에서 태스크를 선점합니다.
+// 선점 직전에 태스크를 다시 조회해 이미 작업중이면(다른 담당자가 선점을 마침) 선점하지 않고 ErrTaskClaimed를 반환합니다.
+// 작업중 상태와 담당자를 한 번에 설정하고 잠시 뒤 다시 조회해, 같은 시점에 다른 담당자도 선점했으면
+// ID가 가장 작은 담당자만 선점에 성공합니다. 선점에 실패하면 추가한 담당자를 제거하고 ErrTaskClaimed를 반환합니다.
+// 풀이 아니거나 담당자 ID가 없거나 클라이언트가 선점을 지원하지 않으면 선점하지 않고 claimed=false를 반환합니다.
+func (w *Worker) claimTask(ctx context.Context, taskID string) (claimed bool, err error) {
+	claimer, ok := w.clickupClient.(TaskClaimer)
+	if !ok || !w.pooled || w.config.AssigneeID == 0 {
+		return false, nil
+	}
+
+	// 처리 시작 시 조회한 태스크는 사전 점검 동안 바뀌었을 수 있으므로 선점 직전 상태 기준으로 판단
+	task, err := w.clickupClient.GetTask(ctx, taskID)
+	if err != nil || task == nil {
+		if err == nil {
+			err = fmt.Errorf("태스크를 찾을 수 없음: %s", taskID)
+		}
+		return false, fmt.Errorf("태스크 선점 전 조회 실패: %w", err)
+	}
+	if strings.EqualFold(task.Status.Status, w.statusWorking) {
+		return false, ErrTaskClaimed
+	}
+
+	originalStatus := task.Status.Status
+
+	// 선점 전부터 있던 담당자는 경쟁 대상이 아님
+	before := make(map[int]bool, len(task.Assignees))
+	for _, user := range task.Assignees {
+		before[user.ID] = true
+	}
+
+	if err := claimer.ClaimTask(ctx, task.ID, w.statusWorking, w.config.AssigneeID); err != nil {
+		return false, fmt.Errorf("태스크 선점 실패: %w", err)
+	}
+
+	if w.claimSettle > 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(w.claimSettle):
+		}
+	}
+
+	current, err := w.clickupClient.GetTask(ctx, task.ID)
+	if err != nil || current == nil {
+		w.abandonClaim(ctx, claimer, task.ID, originalStatus)
+		if err == nil {
+			err = fmt.Errorf("태스크를 찾을 수 없음: %s", task.ID)
+		}
+		return false, fmt.Errorf("태스크 선점 확인 실패: %w", err)
+	}
+
+	winner := w.config.AssigneeID
+	for _, user := range current.Assignees {
+		if !before[user.ID] && user.ID < winner {
+			winner = user.ID
+		}
+	}
+	if winner != w.config.AssigneeID || !current.HasAssignee(w.config.AssigneeID) ||
+		!strings.EqualFold(current.Status.Status, w.statusWorking) {
+		if err := claimer.UnassignTask(ctx, task.ID, w.config.AssigneeID); err != nil {
+			fmt.Printf("[%s] ⚠️ 선점 실패 태스크 담당자 제거 실패: %v\n", w.config.ID, err)
+		}
+		return false, ErrTaskClaimed
+	}
+	return true, nil
+}
+
+// abandonClaim은 선점한 태스크의 담당자를 제거하고 선점 전 상태로 되돌립니다.
+func (w *Worker) abandonClaim(ctx context.Context, claimer TaskClaimer, taskID, originalStatus string) {
+	if err := claimer.UnassignTask(ctx, taskID, w.config.AssigneeID); err != nil {
+		fmt.Printf("[%s] ⚠️ 선점 담당자 제거 실패: %v\n", w.config.ID, err)
+	}
+	if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, originalStatus); err != nil {
+		fmt.Printf("[%s] ⚠️ 선점 상태 롤백 실패: %v\n", w.config.ID, err)
+	}
+}
+
+// releaseTaskClaim은 선점에 성공한 태스크를 에이전트 실행 전에 포기할 때 담당자와 원래 상태를 되돌립니다.
+func (w *Worker) releaseTaskClaim(ctx context.Context, taskID, originalStatus string) {
+	if claimer, ok := w.clickupClient.(TaskClaimer); ok {
+		w.abandonClaim(ctx, claimer, taskID, originalStatus)
+	}
+}
+
+// GetPool은 리스트를 공유하는 Worker 풀을 설정 순서대로 반환합니다.
+func (m *Manager) GetPool(listID string) []*Worker {
+	return m.pools[listID]
+}
+
+// WaitingTasks는 리스트에서 처리를 기다리는 태스크를 풀 첫 Worker로 한 번만 조회합니다.
+// 풀 Worker가 처리 중인 태스크는 제외합니다.
+func (m *Manager) WaitingTasks(ctx context.Context, listID string) ([]*clickup.Task, error) {
+	pool := m.pools[listID]
+	if len(pool) == 0 {
+		return nil, nil
+	}
+	tasks, err := pool[0].GetPendingTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	processing := make(map[string]bool, len(pool))
+	for _, w := range pool {
+		if taskID := w.GetCurrentTaskID(); taskID != "" {
+			processing[taskID] = true
+		}
+	}
+	waiting := make([]*clickup.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if !processing[task.ID] {
+			waiting = append(waiting, task)
+		}
+	}
+	return waiting, nil
+}
+
+// nextIdleWorker는 풀에서 유휴 Worker를 순서대로 돌아가며 반환합니다. 모두 처리 중이면 nil을 반환합니다.
+func (m *Manager) nextIdleWorker(listID string) *Worker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pool := m.pools[listID]
+	for i := range pool {
+		idx := (m.poolNext[listID] + i) % len(pool)
+		if w := pool[idx]; !w.IsProcessing() && m.claimOf(w) == "" {
+			m.poolNext[listID] = idx + 1
+			return w
+		}
+	}
+	return nil
+}
+
+// claimLocal은 프로세스 안에서 태스크를 Worker에 선점합니다.
+// 다른 Worker가 선점한 태스크면 ErrTaskClaimed, Worker가 다른 태스크를 선점 중이면 ErrWorkerBusy를 반환합니다.
+func (m *Manager) claimLocal(taskID string, w *Worker) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if owner, ok := m.claims[taskID]; ok {
+		if owner == w {
+			return nil
+		}
+		return ErrTaskClaimed
+	}
+	if m.claimOf(w) != "" {
+		return ErrWorkerBusy
+	}
+	m.claims[taskID] = w
+	return nil
+}
+
+// claimOf는 Worker가 선점한 태스크 ID를 반환합니다. m.mu를 잡은 상태에서 호출해야 합니다.
+func (m *Manager) claimOf(w *Worker) string {
+	for taskID, owner := range m.claims {
+		if owner == w {
+			return taskID
+		}
+	}
+	return ""
+}
+
+// releaseClaim은 Worker의 태스크 선점을 해제합니다.
+func (m *Manager) releaseClaim(w *Worker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if taskID := m.claimOf(w); taskID != "" {
+		delete(m.claims, taskID)
+	}
+}
+
+// isClaimedByOther는 태스크가 다른 Worker에 선점되어 있는지 확인합니다.
+func (m *Manager) isClaimedByOther(taskID string, w *Worker) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	owner, ok := m.claims[taskID]
+	return ok && owner != w
+}
+
+// firstUnclaimed는 대기 태스크 중 다른 Worker가 선점하지 않은 첫 태스크를 반환합니다.
+func (m *Manager) firstUnclaimed(w *Worker, tasks []*clickup.Task) *clickup.Task {
+	for _, task := range tasks {
+		if !m.isClaimedByOther(task.ID, w) {
+			return task
+		}
+	}
+	return nil
+}
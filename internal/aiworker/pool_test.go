@@ -0,0 +1,257 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// MockClaimClient는 ClickUp 선점을 지원하는 테스트용 클라이언트입니다.
+// Rival이 0이 아니면 선점 시 다른 담당자도 동시에 선점한 것처럼 추가합니다.
+type MockClaimClient struct {
+	MockClickUpClient
+	Rival      int
+	Claims     []int
+	Unassigned []int
+}
+
+func (m *MockClaimClient) ClaimTask(ctx context.Context, taskID, status string, assigneeID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Claims = append(m.Claims, assigneeID)
+	for _, task := range m.Tasks {
+		if task.ID == taskID {
+			task.Status.Status = status
+			task.Assignees = append(task.Assignees, clickup.User{ID: assigneeID})
+			if m.Rival != 0 {
+				task.Assignees = append(task.Assignees, clickup.User{ID: m.Rival})
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MockClaimClient) UnassignTask(ctx context.Context, taskID string, assigneeID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Unassigned = append(m.Unassigned, assigneeID)
+	return nil
+}
+
+// newPoolManager는 list1을 공유하는 AI_01, AI_02 풀과 단독 AI_03을 가진 Manager를 생성합니다.
+func newPoolManager(client ClickUpClientInterface) *Manager {
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	config.AddWorker("AI_02", "list1", "/path2")
+	config.AddWorker("AI_03", "list2", "/path3")
+	config.Workers[0].AssigneeID = 200
+	config.Workers[1].AssigneeID = 100
+
+	m := NewManager(config)
+	m.SetClickUpClient(client)
+	m.SetInvoker(&MockInvoker{Result: &InvokeResult{}})
+	for _, w := range m.GetWorkers() {
+		w.claimSettle = 0
+	}
+	return m
+}
+
+// TestManager_Pool은 리스트를 공유하는 Worker 풀 구성과 유휴 Worker 순환 배정을 테스트합니다.
+func TestManager_Pool(t *testing.T) {
+	m := newPoolManager(&MockClickUpClient{})
+
+	if len(m.GetPool("list1")) != 2 || len(m.GetPool("list2")) != 1 {
+		t.Fatalf("풀 구성 불일치: list1=%d, list2=%d", len(m.GetPool("list1")), len(m.GetPool("list2")))
+	}
+	if !m.GetWorkers()[0].pooled || m.GetWorkers()[2].pooled {
+		t.Error("리스트를 공유하는 Worker만 풀 Worker여야 함")
+	}
+
+	first := m.GetWorkerByListID("list1")
+	second := m.GetWorkerByListID("list1")
+	if first == second {
+		t.Error("유휴 Worker는 순서대로 배정되어야 함")
+	}
+
+	first.SetProcessing("task1", "태스크", "", "")
+	for i := 0; i < 2; i++ {
+		if got := m.GetWorkerByListID("list1"); got != second {
+			t.Errorf("처리 중인 Worker는 건너뛰어야 함: got %s", got.GetConfig().ID)
+		}
+	}
+
+	second.SetProcessing("task2", "태스크", "", "")
+	if got := m.GetWorkerByListID("list1"); got != m.GetPool("list1")[0] {
+		t.Error("모두 처리 중이면 첫 Worker를 반환해야 함")
+	}
+}
+
+// TestManager_StartTask_LocalClaim은 프로세스 안에서 같은 태스크를 두 Worker가 시작하지 못하는지 테스트합니다.
+func TestManager_StartTask_LocalClaim(t *testing.T) {
+	client := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크"}}}
+	m := newPoolManager(client)
+	pool := m.GetPool("list1")
+
+	if err := m.StartTask(context.Background(), pool[0], "task1"); err != nil {
+		t.Fatalf("태스크 시작 실패: %v", err)
+	}
+	if err := m.StartTask(context.Background(), pool[1], "task1"); !errors.Is(err, ErrTaskClaimed) {
+		t.Errorf("다른 Worker가 선점한 태스크는 ErrTaskClaimed여야 함: %v", err)
+	}
+	if task := m.firstUnclaimed(pool[1], client.Tasks); task != nil {
+		t.Errorf("선점된 태스크는 대기 태스크에서 제외되어야 함: %s", task.ID)
+	}
+
+	// 완료되면 선점 해제
+	pool[0].Transition(context.Background(), StateFailed, "테스트")
+	pool[0].ClearProcessing()
+	if task := m.firstUnclaimed(pool[1], client.Tasks); task == nil {
+		t.Error("종료된 태스크의 선점은 해제되어야 함")
+	}
+}
+
+// TestWorker_ClaimTask는 ClickUp 선점과 동시 선점 시 담당자 ID 비교를 테스트합니다.
+func TestWorker_ClaimTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		workerIndex int // 0: 담당자 200, 1: 담당자 100
+		rival       int
+		wantErr     error
+	}{
+		{name: "단독 선점", workerIndex: 0},
+		{name: "동시 선점 - 작은 ID가 승리", workerIndex: 1, rival: 150},
+		{name: "동시 선점 - 큰 ID는 양보", workerIndex: 0, rival: 150, wantErr: ErrTaskClaimed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockClaimClient{
+				MockClickUpClient: MockClickUpClient{Tasks: []*clickup.Task{{
+					ID:        "task1",
+					Status:    clickup.TaskStatus{Status: "to do"},
+					Assignees: []clickup.User{{ID: 50}}, // 기존 담당자는 경쟁 대상 아님
+				}}},
+				Rival: tt.rival,
+			}
+			m := newPoolManager(client)
+			worker := m.GetPool("list1")[tt.workerIndex]
+			assignee := worker.GetConfig().AssigneeID
+
+			err := m.StartTask(context.Background(), worker, "task1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("에러 불일치: got %v, want %v", err, tt.wantErr)
+			}
+
+			if len(client.Claims) != 1 || client.Claims[0] != assignee {
+				t.Errorf("선점 요청 불일치: %v", client.Claims)
+			}
+			if tt.wantErr != nil {
+				if len(client.Unassigned) != 1 || client.Unassigned[0] != assignee {
+					t.Errorf("선점 실패 시 담당자를 제거해야 함: %v", client.Unassigned)
+				}
+				if worker.IsProcessing() {
+					t.Error("선점 실패 시 처리 상태가 아니어야 함")
+				}
+				return
+			}
+
+			// 선점에 성공하면 상태 변경 요청을 다시 보내지 않음
+			if len(client.StatusUpdates) != 0 {
+				t.Errorf("선점 후 상태 변경 요청이 없어야 함: %v", client.StatusUpdates)
+			}
+			if !worker.IsProcessing() {
+				t.Error("선점 성공 시 처리 상태여야 함")
+			}
+		})
+	}
+}
+
+// TestWorker_ClaimTask_AfterSettled는 처리 시작 시 조회한 태스크가 오래되어, 다른 담당자가 이미 선점을 마친 경우
+// ID가 더 작은 Worker라도 기존 선점자에게 양보하는지 테스트합니다.
+func TestWorker_ClaimTask_AfterSettled(t *testing.T) {
+	client := &MockClaimClient{MockClickUpClient: MockClickUpClient{Tasks: []*clickup.Task{{
+		ID:        "task1",
+		Status:    clickup.TaskStatus{Status: "작업중"},
+		Assignees: []clickup.User{{ID: 150}}, // 다른 프로세스의 Worker가 선점 완료
+	}}}}
+	m := newPoolManager(client)
+	worker := m.GetPool("list1")[1] // 담당자 100
+
+	stale := &clickup.Task{ID: "task1", Status: clickup.TaskStatus{Status: "to do"}}
+	if err := worker.processTask(context.Background(), stale, RunOptions{}); !errors.Is(err, ErrTaskClaimed) {
+		t.Fatalf("이미 선점된 태스크는 ErrTaskClaimed여야 함: %v", err)
+	}
+	if len(client.Claims) != 0 || len(client.StatusUpdates) != 0 {
+		t.Errorf("기존 선점자가 있으면 선점/상태 변경을 하지 않아야 함: claims=%v, updates=%v", client.Claims, client.StatusUpdates)
+	}
+	if task := client.Tasks[0]; len(task.Assignees) != 1 || task.Assignees[0].ID != 150 || worker.IsProcessing() {
+		t.Errorf("기존 선점자가 유지되어야 함: %+v", task.Assignees)
+	}
+}
+
+// TestWorker_ClaimTask_PrepareFailed는 선점 후 git 준비에 실패하면 담당자와 원래 상태를 되돌리는지 테스트합니다.
+func TestWorker_ClaimTask_PrepareFailed(t *testing.T) {
+	client := &MockClaimClient{MockClickUpClient: MockClickUpClient{Tasks: []*clickup.Task{{
+		ID:     "task1",
+		Status: clickup.TaskStatus{Status: "to do"},
+	}}}}
+	m := newPoolManager(client)
+	worker := m.GetPool("list1")[0]
+	worker.gitPrep = NewGitPrep(GitPrepConfig{Steps: []GitPrepStep{GitStepBranch}})
+	git := &fakeGit{errs: map[string]error{"checkout": errors.New("conflict")}}
+	worker.gitPrep.git = git.run
+
+	if err := m.StartTask(context.Background(), worker, "task1"); err == nil {
+		t.Fatal("git 준비 실패 시 에러여야 함")
+	}
+	if len(client.Unassigned) != 1 || client.Unassigned[0] != 200 {
+		t.Errorf("선점한 담당자를 제거해야 함: %v", client.Unassigned)
+	}
+	if len(client.StatusUpdates) != 1 || client.StatusUpdates[0].Status != "to do" {
+		t.Errorf("원래 상태로 되돌려야 함: %v", client.StatusUpdates)
+	}
+	if worker.IsProcessing() {
+		t.Error("준비 실패 시 처리 상태가 아니어야 함")
+	}
+}
+
+// TestWorker_GetPendingTasks_Pool은 풀 Worker가 작업중 태스크를 대기 태스크에서 제외하는지 테스트합니다.
+func TestWorker_GetPendingTasks_Pool(t *testing.T) {
+	client := &MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "task1", Status: clickup.TaskStatus{Status: "작업중"}},
+		{ID: "task2", Status: clickup.TaskStatus{Status: "to do"}},
+	}}
+	m := newPoolManager(client)
+
+	pooled, err := m.GetPool("list1")[0].GetPendingTasks(context.Background())
+	if err != nil {
+		t.Fatalf("태스크 조회 실패: %v", err)
+	}
+	if len(pooled) != 1 || pooled[0].ID != "task2" {
+		t.Errorf("풀 Worker는 작업중 태스크를 제외해야 함: %d개", len(pooled))
+	}
+
+	single, _ := m.GetPool("list2")[0].GetPendingTasks(context.Background())
+	if len(single) != 2 {
+		t.Errorf("단독 Worker는 기존처럼 작업중 태스크를 포함해야 함: %d개", len(single))
+	}
+}
+
+// TestPoolOwner는 재시작 복구 시 작업중 태스크를 담당할 풀 Worker 선택을 테스트합니다.
+func TestPoolOwner(t *testing.T) {
+	m := newPoolManager(&MockClickUpClient{})
+	pool := m.GetPool("list1")
+
+	states := map[string]WorkerState{"AI_02": {WorkerID: "AI_02", Processing: true, TaskID: "task1"}}
+	if got := poolOwner(pool, states, &clickup.Task{ID: "task1"}); got != pool[1] {
+		t.Error("저장된 상태의 Worker가 우선이어야 함")
+	}
+	if got := poolOwner(pool, nil, &clickup.Task{ID: "task2", Assignees: []clickup.User{{ID: 200}}}); got != pool[0] {
+		t.Error("담당자가 일치하는 Worker가 복구해야 함")
+	}
+	if got := poolOwner(pool, nil, &clickup.Task{ID: "task3", Assignees: []clickup.User{{ID: 999}}}); got != nil {
+		t.Error("다른 프로세스가 선점한 태스크는 건드리지 않아야 함")
+	}
+}
@@ -0,0 +1,199 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// ErrPreflightFailed는 태스크 시작 전 사전 점검에 실패했을 때 반환됩니다.
+// 태스크는 변경되지 않으며 다음 폴링에서 다시 점검합니다.
+var ErrPreflightFailed = errors.New("사전 점검 실패")
+
+// errDiskCheckUnsupported는 디스크 공간 확인을 지원하지 않는 플랫폼에서 반환됩니다. (점검 생략)
+var errDiskCheckUnsupported = errors.New("디스크 공간 확인 미지원")
+
+// DirtyPolicy는 작업 트리에 커밋되지 않은 변경이 있을 때의 처리 방식입니다.
+type DirtyPolicy string
+
+const (
+	DirtyFail  DirtyPolicy = "fail"  // 사전 점검 실패 (기본값)
+	DirtyStash DirtyPolicy = "stash" // git stash로 보관 후 진행
+)
+
+// DefaultMinFreeMB는 사전 점검의 기본 최소 여유 디스크 공간(MB)입니다.
+const DefaultMinFreeMB = 1024
+
+// PreflightConfig는 태스크 시작 전 사전 점검 설정입니다.
+type PreflightConfig struct {
+	DirtyPolicy DirtyPolicy // 커밋되지 않은 변경 처리 방식
+	BaseBranch  string      // origin보다 뒤처지지 않았는지 확인할 기준 브랜치 (비어있으면 확인 안함)
+	MinFreeMB   uint64      // 최소 여유 디스크 공간 (0이면 확인 안함)
+	Tools       []string    // 에이전트 외에 PATH에 있어야 하는 실행 파일 (예: ffmpeg)
+}
+
+// PreflightError는 사전 점검 실패 내역입니다. errors.Is(err, ErrPreflightFailed)로 확인합니다.
+type PreflightError struct {
+	WorkerID string
+	TaskID   string
+	TaskName string
+	Failures []string // 실패한 점검 항목별 사유
+}
+
+func (e *PreflightError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPreflightFailed, strings.Join(e.Failures, "; "))
+}
+
+func (e *PreflightError) Unwrap() error {
+	return ErrPreflightFailed
+}
+
+// Preflight는 작업 경로, 도구, 기준 브랜치, 디스크 공간을 점검합니다.
+type Preflight struct {
+	config    PreflightConfig
+	git       func(ctx context.Context, dir string, args ...string) (string, error) // 테스트에서 교체
+	lookPath  func(file string) (string, error)
+	freeSpace func(path string) (uint64, error)
+}
+
+// NewPreflight는 새 Preflight를 생성합니다.
+func NewPreflight(config PreflightConfig) *Preflight {
+	return &Preflight{
+		config:    config,
+		git:       runGit,
+		lookPath:  exec.LookPath,
+		freeSpace: diskFreeBytes,
+	}
+}
+
+// Run은 workDir에서 model 에이전트를 실행할 수 있는지 점검하고 실패 사유 목록을 반환합니다.
+// 모든 점검이 통과했을 때만 정책에 따라 커밋되지 않은 변경을 stash하며, 이때 stash 메시지를 반환합니다.
+func (p *Preflight) Run(ctx context.Context, workDir string, model aimodel.AIModelType) (failures []string, stash string) {
+	// 도구 확인
+	for _, tool := range append([]string{agentBinary(model)}, p.config.Tools...) {
+		if _, err := p.lookPath(tool); err != nil {
+			failures = append(failures, fmt.Sprintf("%s 실행 파일 없음 (PATH 확인)", tool))
+		}
+	}
+
+	// 작업 경로 확인 (없으면 git 점검 생략)
+	if info, err := os.Stat(workDir); err != nil || !info.IsDir() {
+		return append(failures, fmt.Sprintf("작업 경로 없음: %s", workDir)), ""
+	}
+	if out, err := p.git(ctx, workDir, "rev-parse", "--is-inside-work-tree"); err != nil || out != "true" {
+		return append(failures, fmt.Sprintf("git 저장소가 아님: %s", workDir)), ""
+	}
+
+	// 기준 브랜치 최신 여부
+	if branch := p.config.BaseBranch; branch != "" {
+		if _, err := p.git(ctx, workDir, "fetch", "--quiet", "origin", branch); err != nil {
+			failures = append(failures, fmt.Sprintf("origin/%s fetch 실패: %v", branch, err))
+		} else if out, err := p.git(ctx, workDir, "rev-list", "--count", branch+"..origin/"+branch); err != nil {
+			failures = append(failures, fmt.Sprintf("기준 브랜치 %s 비교 실패: %v", branch, err))
+		} else if behind, _ := strconv.Atoi(out); behind > 0 {
+			failures = append(failures, fmt.Sprintf("기준 브랜치 %s가 origin보다 %d커밋 뒤처짐", branch, behind))
+		}
+	}
+
+	// 디스크 공간
+	if p.config.MinFreeMB > 0 {
+		free, err := p.freeSpace(workDir)
+		switch {
+		case errors.Is(err, errDiskCheckUnsupported):
+		case err != nil:
+			failures = append(failures, fmt.Sprintf("디스크 공간 확인 실패: %v", err))
+		case free/(1024*1024) < p.config.MinFreeMB:
+			failures = append(failures, fmt.Sprintf("디스크 여유 공간 부족: %dMB (최소 %dMB)", free/(1024*1024), p.config.MinFreeMB))
+		}
+	}
+
+	// 작업 트리 변경 확인 (다른 점검이 모두 통과했을 때만 stash)
+	status, err := p.git(ctx, workDir, "status", "--porcelain")
+	if err != nil {
+		return append(failures, fmt.Sprintf("git status 실패: %v", err)), ""
+	}
+	if status == "" {
+		return failures, ""
+	}
+	changes := len(strings.Split(status, "\n"))
+	if p.config.DirtyPolicy != DirtyStash {
+		return append(failures, fmt.Sprintf("커밋되지 않은 변경 %d개", changes)), ""
+	}
+	if len(failures) > 0 {
+		return failures, ""
+	}
+	stash = fmt.Sprintf("ai-worker preflight %s (변경 %d개)", time.Now().Format("2006-01-02 15:04:05"), changes)
+	if _, err := p.git(ctx, workDir, "stash", "push", "--include-untracked", "-m", stash); err != nil {
+		return []string{fmt.Sprintf("커밋되지 않은 변경 %d개 stash 실패: %v", changes, err)}, ""
+	}
+	return nil, stash
+}
+
+// runPreflight는 태스크 시작 전 사전 점검을 실행합니다.
+// 실패하면 태스크를 변경하지 않고 *PreflightError를 반환합니다.
+func (w *Worker) runPreflight(ctx context.Context, task *clickup.Task, model aimodel.AIModelType) error {
+	if w.preflight == nil {
+		return nil
+	}
+	failures, stash := w.preflight.Run(ctx, w.config.SrcPath, model)
+	if stash != "" {
+		fmt.Printf("[%s] 커밋되지 않은 변경 stash: %s\n", w.config.ID, stash)
+	}
+	if len(failures) > 0 {
+		return &PreflightError{
+			WorkerID: w.config.ID,
+			TaskID:   task.ID,
+			TaskName: task.Name,
+			Failures: failures,
+		}
+	}
+	return nil
+}
+
+// agentBinary는 AI 모델의 실행 파일 이름을 반환합니다.
+func agentBinary(model aimodel.AIModelType) string {
+	switch model {
+	case aimodel.AIModelOpenCode:
+		return "opencode"
+	case aimodel.AIModelAmpcode:
+		return "amp"
+	default:
+		return "claude"
+	}
+}
+
+// runGit은 dir에서 git 명령을 실행하고 앞뒤 공백을 제거한 출력을 반환합니다.
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	return runGitEnv(ctx, nil, dir, args...)
+}
+
+// runGitEnv는 환경변수 env를 추가해 dir에서 git 명령을 실행하고 앞뒤 공백을 제거한 출력을 반환합니다.
+func runGitEnv(ctx context.Context, env []string, dir string, args ...string) (string, error) {
+	out, err := gitOutput(ctx, env, dir, args...)
+	return strings.TrimSpace(out), err
+}
+
+// gitOutput은 환경변수 env를 추가해 dir에서 git 명령을 실행하고 출력을 그대로 반환합니다. (패치 등)
+func gitOutput(ctx context.Context, env []string, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return string(out), nil
+}
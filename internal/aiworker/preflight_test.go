@@ -0,0 +1,221 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// fakeGit은 git 명령별 출력을 돌려주고 실행한 명령을 기록합니다.
+type fakeGit struct {
+	outputs map[string]string // "status --porcelain" → 출력
+	errs    map[string]error
+	calls   []string
+}
+
+func (f *fakeGit) run(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := strings.Join(args, " ")
+	f.calls = append(f.calls, cmd)
+	for prefix, err := range f.errs {
+		if strings.HasPrefix(cmd, prefix) {
+			return "", err
+		}
+	}
+	for prefix, out := range f.outputs {
+		if strings.HasPrefix(cmd, prefix) {
+			return out, nil
+		}
+	}
+	return "", nil
+}
+
+// newTestPreflight는 모든 도구가 있고 디스크가 충분한 Preflight를 생성합니다.
+func newTestPreflight(config PreflightConfig, git *fakeGit) *Preflight {
+	p := NewPreflight(config)
+	p.git = git.run
+	p.lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+	p.freeSpace = func(path string) (uint64, error) { return 10 * 1024 * 1024 * 1024, nil }
+	return p
+}
+
+// TestPreflight_Run은 점검 항목별 실패 사유를 테스트합니다.
+func TestPreflight_Run(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name   string
+		config PreflightConfig
+		git    *fakeGit
+		setup  func(p *Preflight)
+		dir    string
+		want   []string // 실패 사유에 포함되어야 하는 문구
+	}{
+		{
+			name: "모두 통과",
+			git:  &fakeGit{outputs: map[string]string{"rev-parse": "true"}},
+		},
+		{
+			name: "작업 경로 없음",
+			git:  &fakeGit{},
+			dir:  dir + "/missing",
+			want: []string{"작업 경로 없음"},
+		},
+		{
+			name: "git 저장소 아님",
+			git:  &fakeGit{errs: map[string]error{"rev-parse": errors.New("not a git repository")}},
+			want: []string{"git 저장소가 아님"},
+		},
+		{
+			name:   "도구 없음",
+			config: PreflightConfig{Tools: []string{"ffmpeg"}},
+			git:    &fakeGit{outputs: map[string]string{"rev-parse": "true"}},
+			setup: func(p *Preflight) {
+				p.lookPath = func(file string) (string, error) { return "", errors.New("not found") }
+			},
+			want: []string{"claude 실행 파일 없음", "ffmpeg 실행 파일 없음"},
+		},
+		{
+			name:   "기준 브랜치 뒤처짐",
+			config: PreflightConfig{BaseBranch: "main"},
+			git:    &fakeGit{outputs: map[string]string{"rev-parse": "true", "rev-list": "3"}},
+			want:   []string{"main가 origin보다 3커밋 뒤처짐"},
+		},
+		{
+			name:   "디스크 부족",
+			config: PreflightConfig{MinFreeMB: 2048},
+			git:    &fakeGit{outputs: map[string]string{"rev-parse": "true"}},
+			setup: func(p *Preflight) {
+				p.freeSpace = func(path string) (uint64, error) { return 512 * 1024 * 1024, nil }
+			},
+			want: []string{"디스크 여유 공간 부족: 512MB"},
+		},
+		{
+			name:   "디스크 확인 미지원은 생략",
+			config: PreflightConfig{MinFreeMB: 2048},
+			git:    &fakeGit{outputs: map[string]string{"rev-parse": "true"}},
+			setup: func(p *Preflight) {
+				p.freeSpace = func(path string) (uint64, error) { return 0, errDiskCheckUnsupported }
+			},
+		},
+		{
+			name: "커밋되지 않은 변경",
+			git:  &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": " M a.go\n?? b.go"}},
+			want: []string{"커밋되지 않은 변경 2개"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPreflight(tt.config, tt.git)
+			if tt.setup != nil {
+				tt.setup(p)
+			}
+			workDir := dir
+			if tt.dir != "" {
+				workDir = tt.dir
+			}
+			failures, stash := p.Run(context.Background(), workDir, aimodel.AIModelClaude)
+			if stash != "" {
+				t.Errorf("stash 정책이 아니면 stash하지 않아야 함: %s", stash)
+			}
+			if len(failures) != len(tt.want) {
+				t.Fatalf("실패 사유 개수 불일치: got %v, want %v", failures, tt.want)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(failures[i], want) {
+					t.Errorf("실패 사유 불일치: got %q, want %q 포함", failures[i], want)
+				}
+			}
+		})
+	}
+}
+
+// TestPreflight_Stash는 stash 정책에서 다른 점검이 모두 통과했을 때만 stash하는지 테스트합니다.
+func TestPreflight_Stash(t *testing.T) {
+	dir := t.TempDir()
+	config := PreflightConfig{DirtyPolicy: DirtyStash, MinFreeMB: 1024}
+
+	git := &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": " M a.go"}}
+	failures, stash := newTestPreflight(config, git).Run(context.Background(), dir, aimodel.AIModelClaude)
+	if len(failures) != 0 || !strings.Contains(stash, "변경 1개") {
+		t.Fatalf("stash 결과 불일치: %v, %q", failures, stash)
+	}
+	if last := git.calls[len(git.calls)-1]; !strings.HasPrefix(last, "stash push --include-untracked -m") {
+		t.Errorf("stash 명령 불일치: %s", last)
+	}
+
+	// 디스크가 부족하면 stash하지 않음
+	git = &fakeGit{outputs: map[string]string{"rev-parse": "true", "status": " M a.go"}}
+	p := newTestPreflight(config, git)
+	p.freeSpace = func(path string) (uint64, error) { return 0, nil }
+	failures, stash = p.Run(context.Background(), dir, aimodel.AIModelClaude)
+	if len(failures) != 1 || stash != "" {
+		t.Errorf("다른 점검이 실패하면 stash하지 않아야 함: %v, %q", failures, stash)
+	}
+	for _, call := range git.calls {
+		if strings.HasPrefix(call, "stash") {
+			t.Error("stash 명령이 실행되지 않아야 함")
+		}
+	}
+}
+
+// TestWorker_ProcessTask_PreflightFailed는 사전 점검 실패 시 태스크를 변경하지 않는지 테스트합니다.
+func TestWorker_ProcessTask_PreflightFailed(t *testing.T) {
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크"}}}
+	invoker := &MockInvoker{Result: &InvokeResult{}}
+	config := WorkerConfig{
+		ID:        "AI_01",
+		ListID:    "list1",
+		SrcPath:   t.TempDir() + "/missing",
+		Preflight: &PreflightConfig{},
+	}
+	worker := NewWorker(config, mockClient, invoker, "작업중", "개발완료", "")
+
+	err := worker.ProcessTask(context.Background(), "task1")
+	if !errors.Is(err, ErrPreflightFailed) {
+		t.Fatalf("ErrPreflightFailed여야 함: %v", err)
+	}
+	var failure *PreflightError
+	if !errors.As(err, &failure) || failure.TaskID != "task1" || failure.WorkerID != "AI_01" {
+		t.Errorf("실패 정보 불일치: %+v", failure)
+	}
+	if len(mockClient.StatusUpdates) != 0 || mockClient.UpdateDatesCalled {
+		t.Error("사전 점검 실패 시 태스크를 변경하지 않아야 함")
+	}
+	if invoker.InvokeCalled || worker.IsProcessing() {
+		t.Error("사전 점검 실패 시 에이전트를 실행하지 않아야 함")
+	}
+}
+
+// TestManager_PreflightAlert는 같은 사전 점검 실패를 한 번만 알리는지 테스트합니다.
+func TestManager_PreflightAlert(t *testing.T) {
+	m := NewManager(DefaultConfig())
+	var alerts []string
+	m.SetPreflightHandler(func(ctx context.Context, failure *PreflightError) {
+		alerts = append(alerts, failure.Error())
+	})
+	ctx := context.Background()
+	diskFull := &PreflightError{WorkerID: "AI_01", TaskID: "task1", Failures: []string{"디스크 부족"}}
+	dirty := &PreflightError{WorkerID: "AI_01", TaskID: "task1", Failures: []string{"커밋되지 않은 변경 1개"}}
+
+	m.reportPreflight(ctx, "AI_01", diskFull)
+	m.reportPreflight(ctx, "AI_01", diskFull)
+	if len(alerts) != 1 {
+		t.Fatalf("같은 실패는 한 번만 알려야 함: %v", alerts)
+	}
+	m.reportPreflight(ctx, "AI_01", dirty)
+	if len(alerts) != 2 {
+		t.Fatalf("실패 사유가 바뀌면 다시 알려야 함: %v", alerts)
+	}
+
+	// 정상 시작 후에는 같은 실패도 다시 알림
+	m.reportPreflight(ctx, "AI_01", nil)
+	m.reportPreflight(ctx, "AI_01", dirty)
+	if len(alerts) != 3 {
+		t.Errorf("정상 시작 후 실패는 다시 알려야 함: %v", alerts)
+	}
+}
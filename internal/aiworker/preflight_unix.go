@@ -0,0 +1,14 @@
+//go:build !windows
+
+package aiworker
+
+import "syscall"
+
+// diskFreeBytes는 path가 있는 파일 시스템의 사용 가능한 공간(바이트)을 반환합니다.
+func diskFreeBytes(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
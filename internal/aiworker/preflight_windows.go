@@ -0,0 +1,8 @@
+//go:build windows
+
+package aiworker
+
+// diskFreeBytes는 Windows에서 지원하지 않으며 errDiskCheckUnsupported를 반환합니다. (점검 생략)
+func diskFreeBytes(path string) (uint64, error) {
+	return 0, errDiskCheckUnsupported
+}
@@ -0,0 +1,72 @@
+package aiworker
+
+import "time"
+
+// ProgressEntry는 에이전트가 보고한 단일 진행 단계입니다.
+type ProgressEntry struct {
+	Stage      string    // 진행 단계 (예: "investigated", "tests_passed")
+	Message    string    // 부가 설명
+	ReportedAt time.Time // 보고 시각
+}
+
+// RecordProgress는 진행 단계를 기록하고 heartbeat 시각을 갱신합니다.
+// 처리 중이 아니면 무시하고 false를 반환합니다.
+// 같은 단계가 다시 보고되면 기존 항목을 최신 내용으로 갱신합니다.
+func (w *Worker) RecordProgress(stage, message string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.processing {
+		return false
+	}
+
+	now := time.Now()
+	w.lastHeartbeat = now
+
+	for i := range w.progress {
+		if w.progress[i].Stage == stage {
+			w.progress[i].Message = message
+			w.progress[i].ReportedAt = now
+			return true
+		}
+	}
+
+	w.progress = append(w.progress, ProgressEntry{
+		Stage:      stage,
+		Message:    message,
+		ReportedAt: now,
+	})
+	return true
+}
+
+// GetProgress는 현재 태스크의 진행 단계 목록 복사본을 반환합니다.
+func (w *Worker) GetProgress() []ProgressEntry {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	entries := make([]ProgressEntry, len(w.progress))
+	copy(entries, w.progress)
+	return entries
+}
+
+// GetLastHeartbeat는 마지막 heartbeat 시각을 반환합니다.
+// 처리 중이 아니면 zero time을 반환합니다.
+func (w *Worker) GetLastHeartbeat() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lastHeartbeat
+}
+
+// SetProgressMessageTS는 진행 상황 Slack 메시지 타임스탬프를 저장합니다.
+func (w *Worker) SetProgressMessageTS(ts string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.progressMessageTS = ts
+}
+
+// GetProgressMessageTS는 진행 상황 Slack 메시지 타임스탬프를 반환합니다.
+func (w *Worker) GetProgressMessageTS() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.progressMessageTS
+}
@@ -0,0 +1,50 @@
+package aiworker
+
+import (
+	"testing"
+)
+
+// TestWorker_RecordProgress는 진행 단계 기록과 heartbeat 갱신을 테스트합니다.
+func TestWorker_RecordProgress(t *testing.T) {
+	config := WorkerConfig{ID: "AI_01", ListID: "list1", SrcPath: "/test"}
+	worker := NewWorker(config, nil, nil, "작업중", "개발완료", "")
+
+	// 처리 중이 아니면 기록되지 않음
+	if worker.RecordProgress("investigated", "") {
+		t.Error("처리 중이 아니면 기록되지 않아야 함")
+	}
+	if !worker.GetLastHeartbeat().IsZero() {
+		t.Error("처리 중이 아니면 heartbeat가 없어야 함")
+	}
+
+	worker.SetProcessing("task1", "Test Task", "ITSM-1234", "open")
+	started := worker.GetLastHeartbeat()
+	if started.IsZero() {
+		t.Fatal("처리 시작 시 heartbeat가 설정되어야 함")
+	}
+
+	worker.RecordProgress("investigated", "원인 파악")
+	worker.RecordProgress("tests_written", "테스트 2개")
+	worker.RecordProgress("investigated", "원인 재확인")
+
+	progress := worker.GetProgress()
+	if len(progress) != 2 {
+		t.Fatalf("진행 단계 개수 불일치: got %d, want 2", len(progress))
+	}
+	if progress[0].Stage != "investigated" || progress[0].Message != "원인 재확인" {
+		t.Errorf("중복 단계는 갱신되어야 함: got %+v", progress[0])
+	}
+	if worker.GetLastHeartbeat().Before(started) {
+		t.Error("heartbeat가 갱신되어야 함")
+	}
+
+	// 처리 완료 시 진행 상황 초기화
+	worker.SetProgressMessageTS("1700000000.000100")
+	worker.ClearProcessing()
+	if len(worker.GetProgress()) != 0 {
+		t.Error("ClearProcessing 후 진행 단계가 비어야 함")
+	}
+	if worker.GetProgressMessageTS() != "" {
+		t.Error("ClearProcessing 후 메시지 타임스탬프가 비어야 함")
+	}
+}
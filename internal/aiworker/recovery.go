@@ -0,0 +1,204 @@
+package aiworker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// RecoveryPolicy는 재시작 시 에이전트 세션이 없는 작업중 태스크를 처리하는 정책입니다.
+type RecoveryPolicy string
+
+const (
+	// RecoveryRollback은 원래 상태로 되돌립니다. 이후 일반 폴링/웹훅 흐름에 따라 다시 처리됩니다.
+	RecoveryRollback RecoveryPolicy = "rollback"
+	// RecoveryRequeue는 원래 상태로 되돌린 뒤 담당 Worker가 바로 다시 처리합니다.
+	RecoveryRequeue RecoveryPolicy = "requeue"
+	// RecoveryHold는 보류 상태로 바꾸어 사람이 확인할 때까지 처리하지 않습니다.
+	RecoveryHold RecoveryPolicy = "hold"
+)
+
+// StatusHold는 RecoveryHold 정책에서 사용하는 보류 상태입니다.
+const StatusHold = "보류"
+
+// ParseRecoveryPolicy는 문자열을 복구 정책으로 변환합니다. 빈 문자열이면 rollback입니다.
+func ParseRecoveryPolicy(s string) (RecoveryPolicy, error) {
+	switch RecoveryPolicy(s) {
+	case "":
+		return RecoveryRollback, nil
+	case RecoveryRollback, RecoveryRequeue, RecoveryHold:
+		return RecoveryPolicy(s), nil
+	default:
+		return "", fmt.Errorf("알 수 없는 복구 정책: %q (rollback, requeue, hold)", s)
+	}
+}
+
+// 복구 결과 Action 상수
+const (
+	RecoveryReattached = "reattached" // 실행 중인 에이전트에 재연결
+	RecoveryRolledBack = "rolled_back"
+	RecoveryRequeued   = "requeued"
+	RecoveryHeld       = "held"
+	RecoveryFailed     = "failed"
+)
+
+// ReconcileResult는 작업중 태스크 하나의 복구 결과입니다.
+type ReconcileResult struct {
+	WorkerID       string
+	TaskID         string
+	TaskName       string
+	JiraID         string
+	SlackThreadTS  string
+	OriginalStatus string // 저장된 원래 상태 (없으면 빈 문자열)
+	Action         string
+	Err            error
+}
+
+// SetStateStore는 상태 저장소를 설정합니다. 모든 Worker가 상태 변경 시 저장하고 Reconcile이 읽습니다.
+func (m *Manager) SetStateStore(store StateStore) {
+	m.stateStore = store
+	for _, w := range m.workers {
+		w.SetStateStore(store)
+	}
+}
+
+// Reconcile은 재시작 시 각 AI 리스트의 작업중 태스크를 정리합니다.
+// 저장된 상태와 일치하고 에이전트 세션이 살아있으면 재연결하고,
+// 그렇지 않으면 policy에 따라 롤백/재처리/보류합니다. Worker 시작 전에 호출해야 합니다.
+// requeue 결과의 재처리는 호출자가 Worker.ProcessTask로 수행합니다.
+func (m *Manager) Reconcile(ctx context.Context, policy RecoveryPolicy) ([]ReconcileResult, error) {
+	states := map[string]WorkerState{}
+	if m.stateStore != nil {
+		loaded, err := m.stateStore.Load()
+		if err != nil {
+			return nil, fmt.Errorf("저장된 상태 읽기 실패: %w", err)
+		}
+		states = loaded
+	}
+
+	var results []ReconcileResult
+	for _, w := range m.workers {
+		config := w.GetConfig()
+		pool := m.pools[config.ListID]
+		if pool[0] != w {
+			continue // 풀은 첫 Worker에서 한 번만 조회
+		}
+
+		tasks, err := w.clickupClient.GetTasks(ctx, config.ListID, &clickup.GetTasksOptions{Statuses: []string{w.statusWorking}})
+		if err != nil {
+			return results, fmt.Errorf("[%s] 작업중 태스크 조회 실패: %w", config.ID, err)
+		}
+
+		for _, task := range tasks {
+			if task.Status.Status != w.statusWorking {
+				continue
+			}
+			owner := poolOwner(pool, states, task)
+			if owner == nil {
+				continue // 다른 프로세스의 풀 Worker가 선점한 태스크
+			}
+			results = append(results, m.reconcileTask(ctx, owner, task, states[owner.GetConfig().ID], policy))
+		}
+
+		// 저장된 태스크가 더 이상 작업중이 아니면 (완료/삭제) 상태 정리
+		for _, pw := range pool {
+			if states[pw.GetConfig().ID].Processing && !pw.IsProcessing() {
+				pw.ClearProcessing()
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// reconcileTask는 작업중 태스크 하나를 복구합니다.
+func (m *Manager) reconcileTask(ctx context.Context, w *Worker, task *clickup.Task, saved WorkerState, policy RecoveryPolicy) ReconcileResult {
+	config := w.GetConfig()
+	result := ReconcileResult{
+		WorkerID: config.ID,
+		TaskID:   task.ID,
+		TaskName: task.Name,
+		JiraID:   extractJiraID(task.Description),
+	}
+
+	owned := saved.Processing && saved.TaskID == task.ID
+	if owned {
+		result.OriginalStatus = saved.OriginalStatus
+		result.SlackThreadTS = saved.SlackThreadTS
+	}
+
+	// 저장된 태스크이고 에이전트가 살아있으면 재연결 (Worker당 하나)
+	if owned && !w.IsProcessing() {
+		alive, err := w.IsAgentAlive()
+		if err != nil {
+			m.logf("[%s] 에이전트 세션 확인 실패: %v", config.ID, err)
+		}
+		if alive {
+			w.RestoreState(saved)
+			m.scheduler.Hold(config, w.RunOptions().Model)
+			m.claimLocal(task.ID, w)
+			result.Action = RecoveryReattached
+			return result
+		}
+	}
+
+	// 원래 상태를 모르면 롤백/재처리할 수 없으므로 보류
+	// (작업중 상태로 재처리하면 완료/실패 시 되돌릴 상태가 없음)
+	if policy != RecoveryHold && result.OriginalStatus == "" {
+		policy = RecoveryHold
+	}
+
+	switch policy {
+	case RecoveryRequeue:
+		// 재처리 시 ProcessTask가 원래 상태를 다시 저장하도록 먼저 되돌림
+		result.Action = RecoveryRequeued
+		if err := w.clickupClient.UpdateTaskStatus(ctx, task.ID, result.OriginalStatus); err != nil {
+			result.Action, result.Err = RecoveryFailed, fmt.Errorf("상태 롤백 실패: %w", err)
+		}
+	case RecoveryRollback:
+		result.Action = RecoveryRolledBack
+		if err := w.clickupClient.UpdateTaskStatus(ctx, task.ID, result.OriginalStatus); err != nil {
+			result.Action, result.Err = RecoveryFailed, fmt.Errorf("상태 롤백 실패: %w", err)
+		}
+	case RecoveryHold:
+		result.Action = RecoveryHeld
+		if err := w.clickupClient.UpdateTaskStatus(ctx, task.ID, StatusHold); err != nil {
+			result.Action, result.Err = RecoveryFailed, fmt.Errorf("보류 상태 변경 실패: %w", err)
+		}
+	}
+
+	return result
+}
+
+// poolOwner는 작업중 태스크를 복구할 풀 Worker를 찾습니다.
+// 저장된 상태가 태스크를 처리 중이던 Worker를 우선하고, 없으면 첫 Worker가 정책을 적용합니다.
+// 풀 Worker에 선점 담당자가 설정되어 있는데 태스크 담당자에 하나도 없으면 다른 프로세스의 태스크로 보고 nil을 반환합니다.
+func poolOwner(pool []*Worker, states map[string]WorkerState, task *clickup.Task) *Worker {
+	for _, w := range pool {
+		if saved := states[w.GetConfig().ID]; saved.Processing && saved.TaskID == task.ID {
+			return w
+		}
+	}
+
+	claimed := false
+	for _, w := range pool {
+		if id := w.GetConfig().AssigneeID; w.pooled && id != 0 {
+			if task.HasAssignee(id) {
+				return w
+			}
+			claimed = true
+		}
+	}
+	if claimed {
+		return nil
+	}
+	return pool[0]
+}
+
+// logf는 로거가 설정된 경우 로그를 남깁니다.
+func (m *Manager) logf(format string, args ...interface{}) {
+	if m.logger != nil {
+		m.logger.Printf(format, args...)
+	}
+}
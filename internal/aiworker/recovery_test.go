@@ -0,0 +1,113 @@
+package aiworker
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// sessionInvoker는 세션 생존 여부를 지정할 수 있는 테스트용 Invoker입니다.
+type sessionInvoker struct {
+	MockInvoker
+	alive bool
+}
+
+func (i *sessionInvoker) IsSessionAlive(workerID string) (bool, error) {
+	return i.alive, nil
+}
+
+// newRecoveryManager는 작업중 태스크 하나와 저장된 상태를 가진 Manager를 생성합니다.
+func newRecoveryManager(t *testing.T, saved *WorkerState, alive bool) (*Manager, *MockClickUpClient, StateStore) {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	manager := NewManager(config)
+
+	client := &MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "task1", Name: "테스트 태스크", Description: "ITSM-42", Status: clickup.TaskStatus{Status: config.StatusWorking}},
+	}}
+	manager.SetClickUpClient(client)
+	manager.SetInvoker(&sessionInvoker{alive: alive})
+
+	store, err := NewFileStateStore(filepath.Join(t.TempDir(), "state.json"))
+	if err != nil {
+		t.Fatalf("저장소 생성 실패: %v", err)
+	}
+	if saved != nil {
+		if err := store.Save(*saved); err != nil {
+			t.Fatalf("상태 저장 실패: %v", err)
+		}
+	}
+	manager.SetStateStore(store)
+	return manager, client, store
+}
+
+// TestManager_Reconcile은 정책별 작업중 태스크 복구를 테스트합니다.
+func TestManager_Reconcile(t *testing.T) {
+	saved := &WorkerState{WorkerID: "AI_01", ListID: "list1", Processing: true, TaskID: "task1",
+		OriginalStatus: "진행 전", SlackThreadTS: "123.456"}
+
+	tests := []struct {
+		name       string
+		saved      *WorkerState
+		alive      bool
+		policy     RecoveryPolicy
+		wantAction string
+		wantStatus string // 빈 문자열이면 상태 변경 없음
+	}{
+		{"세션 생존 시 재연결", saved, true, RecoveryRollback, RecoveryReattached, ""},
+		{"롤백", saved, false, RecoveryRollback, RecoveryRolledBack, "진행 전"},
+		{"재처리", saved, false, RecoveryRequeue, RecoveryRequeued, "진행 전"},
+		{"보류", saved, false, RecoveryHold, RecoveryHeld, StatusHold},
+		{"원래 상태 모르면 보류", nil, false, RecoveryRollback, RecoveryHeld, StatusHold},
+		{"원래 상태 모르면 재처리 대신 보류", nil, false, RecoveryRequeue, RecoveryHeld, StatusHold},
+	}
+
+	for _, tt := range tests {
+		manager, client, store := newRecoveryManager(t, tt.saved, tt.alive)
+
+		results, err := manager.Reconcile(context.Background(), tt.policy)
+		if err != nil {
+			t.Fatalf("%s: 복구 실패: %v", tt.name, err)
+		}
+		if len(results) != 1 || results[0].Action != tt.wantAction {
+			t.Fatalf("%s: 결과 불일치: %+v", tt.name, results)
+		}
+		if results[0].JiraID != "ITSM-42" {
+			t.Errorf("%s: Jira ID 불일치: %q", tt.name, results[0].JiraID)
+		}
+
+		var gotStatus string
+		if len(client.StatusUpdates) > 0 {
+			gotStatus = client.StatusUpdates[0].Status
+		}
+		if gotStatus != tt.wantStatus {
+			t.Errorf("%s: 상태 변경 불일치: got %q, want %q", tt.name, gotStatus, tt.wantStatus)
+		}
+
+		worker := manager.GetWorkers()[0]
+		states, _ := store.Load()
+		if tt.wantAction == RecoveryReattached {
+			if !worker.IsProcessing() || worker.GetSlackThreadTS() != "123.456" {
+				t.Errorf("%s: 재연결 시 상태가 복원되어야 함", tt.name)
+			}
+		} else if worker.IsProcessing() || states["AI_01"].Processing {
+			t.Errorf("%s: 복구 후 저장된 상태가 정리되어야 함: %+v", tt.name, states["AI_01"])
+		}
+	}
+}
+
+func TestParseRecoveryPolicy(t *testing.T) {
+	if p, err := ParseRecoveryPolicy(""); err != nil || p != RecoveryRollback {
+		t.Errorf("기본값은 rollback이어야 함: %q, %v", p, err)
+	}
+	if p, err := ParseRecoveryPolicy("requeue"); err != nil || p != RecoveryRequeue {
+		t.Errorf("requeue 파싱 실패: %q, %v", p, err)
+	}
+	if _, err := ParseRecoveryPolicy("unknown"); err == nil {
+		t.Error("알 수 없는 정책은 오류여야 함")
+	}
+}
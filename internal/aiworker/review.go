@@ -0,0 +1,227 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// 기본 리뷰 설정
+const (
+	DefaultReviewMaxRounds = 2          // 수정 요청 최대 횟수
+	DefaultReviewMaxDiff   = 200 * 1024 // 리뷰 프롬프트에 포함할 diff 최대 크기(바이트)
+)
+
+// ReviewerSuffix는 리뷰 에이전트 터미널 창 제목에 붙는 접미사입니다. (Worker ID + 접미사)
+const ReviewerSuffix = "_REVIEW"
+
+// ErrReviewInProgress는 리뷰 판정 전에 실행 단계 전이를 요청하면 반환됩니다.
+var ErrReviewInProgress = errors.New("리뷰 진행 중")
+
+// DefaultReviewPrompt는 기본 리뷰 프롬프트 템플릿입니다.
+// 템플릿 데이터: .Issue (원본 이슈), .Diff (시작 커밋 대비 변경), .Truncated (diff 잘림 여부), .Round (리뷰 회차)
+const DefaultReviewPrompt = `# 코드 리뷰 요청 ({{.Round}}차)
+
+다른 에이전트가 아래 이슈를 구현했습니다. 변경 사항이 이슈 요구사항을 충족하는지,
+버그/회귀/누락된 테스트가 없는지 검토하세요. 사소한 스타일 문제로는 수정을 요청하지 마세요.
+
+## 원본 이슈
+
+{{.Issue}}
+
+## 변경 사항 (diff)
+
+` + "```diff\n{{.Diff}}\n```" + `
+{{if .Truncated}}
+diff가 길어 일부만 포함했습니다. 전체 변경은 작업 디렉토리에서 git diff로 확인하세요.
+{{end}}`
+
+// ReviewConfig는 완료 전 리뷰 에이전트 검토 설정입니다.
+type ReviewConfig struct {
+	Model          aimodel.AIModelType // 리뷰 에이전트 AI 모델
+	MaxRounds      int                 // 수정 요청 최대 횟수 (초과하면 실패, 기본: 2)
+	PromptTemplate string              // 리뷰 프롬프트 템플릿 (text/template, 비어있으면 DefaultReviewPrompt)
+	MaxDiffBytes   int                 // 프롬프트에 포함할 diff 최대 크기 (기본: 200KB)
+}
+
+// ReviewVerdict는 리뷰 에이전트의 판정입니다.
+type ReviewVerdict struct {
+	Approved bool
+	Summary  string
+	Comments []string // 수정 요청 항목
+}
+
+// reviewPromptData는 리뷰 프롬프트 템플릿 데이터입니다.
+type reviewPromptData struct {
+	Issue     string
+	Diff      string
+	Truncated bool
+	Round     int
+}
+
+// ReviewerID는 Worker의 리뷰 에이전트 터미널 창 제목입니다.
+func (w *Worker) ReviewerID() string {
+	return w.config.ID + ReviewerSuffix
+}
+
+// startReview는 리뷰가 설정되어 있으면 리뷰 단계로 전이하고 리뷰 에이전트를 실행합니다.
+// 리뷰를 시작했으면 true를 반환하며, 실행에 실패하면 작업을 실패 처리합니다.
+func (w *Worker) startReview(ctx context.Context) (bool, error) {
+	if w.review == nil {
+		return false, nil
+	}
+	reviewer, ok := w.invoker.(ReviewInvoker)
+	if !ok {
+		fmt.Printf("[%s] 리뷰 에이전트를 지원하지 않는 실행 환경 (리뷰 생략)\n", w.config.ID)
+		return false, nil
+	}
+
+	prompt, err := w.buildReviewPrompt(ctx)
+	if err != nil {
+		w.Fail(ctx, err.Error())
+		return true, err
+	}
+
+	w.mu.Lock()
+	round := w.reviewRounds + 1
+	w.mu.Unlock()
+	if _, err := w.Transition(ctx, StateReviewing, fmt.Sprintf("리뷰 요청 (%s, %d차)", w.review.Model, round)); err != nil {
+		return true, err
+	}
+	if _, err := reviewer.InvokeReview(ctx, w.config.SrcPath, prompt, w.ReviewerID(), w.review.Model); err != nil {
+		err = fmt.Errorf("리뷰 에이전트 실행 실패: %w", err)
+		w.Fail(ctx, err.Error())
+		return true, err
+	}
+	return true, nil
+}
+
+// buildReviewPrompt는 원본 이슈와 시작 커밋 대비 diff로 리뷰 프롬프트를 생성합니다.
+func (w *Worker) buildReviewPrompt(ctx context.Context) (string, error) {
+	w.mu.Lock()
+	taskID, base, round := w.currentTaskID, w.baseCommit, w.reviewRounds+1
+	w.mu.Unlock()
+
+	task, err := w.clickupClient.GetTask(ctx, taskID)
+	if err != nil {
+		return "", fmt.Errorf("리뷰 대상 태스크 조회 실패: %w", err)
+	}
+	if task == nil {
+		return "", fmt.Errorf("리뷰 대상 태스크를 찾을 수 없음: %s", taskID)
+	}
+	data := reviewPromptData{Issue: w.buildPrompt(ctx, task), Round: round}
+
+	if base == "" {
+		data.Diff = "(시작 커밋을 확인하지 못했습니다. 작업 디렉토리에서 git diff로 변경을 확인하세요.)"
+	} else {
+		patch, err := w.patches.Build(ctx, w.config.SrcPath, base)
+		if err != nil {
+			return "", fmt.Errorf("리뷰 diff 생성 실패: %w", err)
+		}
+		if len(patch) > w.review.MaxDiffBytes {
+			patch, data.Truncated = patch[:w.review.MaxDiffBytes], true
+		}
+		data.Diff = string(patch)
+	}
+
+	text := w.review.PromptTemplate
+	if text == "" {
+		text = DefaultReviewPrompt
+	}
+	tmpl, err := template.New("review").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("리뷰 프롬프트 템플릿 파싱 실패: %w", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("리뷰 프롬프트 생성 실패: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// ReviewVerdict는 리뷰 에이전트의 판정을 처리합니다.
+// 승인이면 완료 처리하고, 수정 요청이면 구현 에이전트의 마지막 세션에 수정 요청을 전달해 실행 단계로 되돌립니다.
+// 수정 요청이 MaxRounds를 넘으면 실패 처리합니다.
+func (w *Worker) ReviewVerdict(ctx context.Context, verdict ReviewVerdict) error {
+	if w.TaskState() != StateReviewing {
+		return fmt.Errorf("리뷰 중이 아님: %s", w.TaskState())
+	}
+	if err := w.terminateAgent(w.ReviewerID()); err != nil {
+		fmt.Printf("[%s] ⚠️ 리뷰 에이전트 종료 실패: %v\n", w.config.ID, err)
+	}
+
+	if verdict.Approved {
+		if _, err := w.Transition(ctx, StateVerifying, "리뷰 승인: "+verdict.Summary); err != nil {
+			return err
+		}
+		return w.finishTask(ctx)
+	}
+
+	w.mu.Lock()
+	rounds := w.reviewRounds
+	if rounds < w.review.MaxRounds {
+		w.reviewRounds++
+	}
+	w.mu.Unlock()
+	if rounds >= w.review.MaxRounds {
+		return w.Fail(ctx, fmt.Sprintf("리뷰 수정 요청 반복 한도 초과 (%d회): %s", w.review.MaxRounds, verdict.Summary))
+	}
+
+	reason := fmt.Sprintf("리뷰 수정 요청 %d/%d: %s", rounds+1, w.review.MaxRounds, verdict.Summary)
+	if _, err := w.Transition(ctx, StateExecuting, reason); err != nil {
+		return err
+	}
+
+	return w.resumeAgent(ctx, buildReviewFeedback(verdict))
+}
+
+// resumeAgent는 구현 에이전트를 종료하고 마지막 세션을 이어서 prompt를 전달합니다.
+// 세션 이어가기를 지원하지 않는 Invoker는 새 세션으로 실행하며, 실행에 실패하면 작업을 실패 처리합니다.
+func (w *Worker) resumeAgent(ctx context.Context, prompt string) error {
+	if err := w.TerminateClaude(); err != nil {
+		fmt.Printf("[%s] ⚠️ 에이전트 종료 실패: %v\n", w.config.ID, err)
+	}
+	var err error
+	if invoker, ok := w.invoker.(OptionInvoker); ok {
+		opts := RunOptions{Model: w.RunOptions().Model, Mode: ModeDirect, Continue: true}
+		_, err = invoker.Invoke(ctx, w.config.SrcPath, prompt, w.config.ID, opts)
+	} else {
+		_, err = w.invoker.InvokePlan(ctx, w.config.SrcPath, prompt, w.config.ID)
+	}
+	if err != nil {
+		err = fmt.Errorf("수정 요청 전달 실패: %w", err)
+		w.Fail(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
+// buildReviewFeedback은 수정 요청 판정을 구현 에이전트에 보낼 프롬프트로 만듭니다.
+func buildReviewFeedback(verdict ReviewVerdict) string {
+	var b strings.Builder
+	b.WriteString("# 코드 리뷰 수정 요청\n\n리뷰어가 변경 사항에 대해 수정을 요청했습니다. 아래 항목을 반영하세요.\n")
+	if verdict.Summary != "" {
+		b.WriteString("\n## 요약\n\n" + verdict.Summary + "\n")
+	}
+	if len(verdict.Comments) > 0 {
+		b.WriteString("\n## 수정 항목\n\n")
+		for _, comment := range verdict.Comments {
+			b.WriteString("- " + comment + "\n")
+		}
+	}
+	return b.String()
+}
+
+// closeReviewer는 리뷰 중 실패/취소되면 리뷰 에이전트를 종료합니다. (OnEnter 훅)
+func (w *Worker) closeReviewer(ctx context.Context, t Transition) {
+	if t.From != StateReviewing {
+		return
+	}
+	if err := w.terminateAgent(w.ReviewerID()); err != nil {
+		fmt.Printf("[%s] ⚠️ 리뷰 에이전트 종료 실패: %v\n", w.config.ID, err)
+	}
+}
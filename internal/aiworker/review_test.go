@@ -0,0 +1,157 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// reviewMockInvoker는 리뷰 에이전트 실행과 에이전트 종료를 기록하는 테스트용 Invoker입니다.
+type reviewMockInvoker struct {
+	OptionMockInvoker
+	reviewPrompt   string
+	reviewWorkerID string
+	reviewModel    aimodel.AIModelType
+	reviews        int
+	terminated     []string
+}
+
+func (m *reviewMockInvoker) InvokeReview(ctx context.Context, workDir, prompt, workerID string, model aimodel.AIModelType) (*InvokeResult, error) {
+	m.reviewPrompt, m.reviewWorkerID, m.reviewModel = prompt, workerID, model
+	m.reviews++
+	return &InvokeResult{}, nil
+}
+
+func (m *reviewMockInvoker) Terminate(workerID string) error {
+	m.terminated = append(m.terminated, workerID)
+	return nil
+}
+
+// newReviewWorker는 리뷰가 설정된 Worker를 만들고 완료 보고까지 진행합니다.
+func newReviewWorker(t *testing.T, maxRounds int) (*Worker, *MockClickUpClient, *reviewMockInvoker, string) {
+	t.Helper()
+	dir := initTestRepo(t)
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "로그인 버그", Description: "PROJ-5 로그인 실패 수정"}}}
+	invoker := &reviewMockInvoker{}
+	config := WorkerConfig{
+		ID:      "AI_01",
+		ListID:  "list1",
+		SrcPath: dir,
+		Review:  &ReviewConfig{Model: aimodel.AIModelOpenCode, MaxRounds: maxRounds},
+	}
+	worker := NewWorker(config, mockClient, invoker, "작업중", "개발완료", "")
+
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "login.go"), "package login\n")
+	if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+		t.Fatalf("실행 전이 실패: %v", err)
+	}
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 보고 처리 실패: %v", err)
+	}
+	return worker, mockClient, invoker, dir
+}
+
+// TestWorker_Review_Approve는 완료 보고 시 리뷰 에이전트를 실행하고 승인되면 완료 처리하는지 테스트합니다.
+func TestWorker_Review_Approve(t *testing.T) {
+	worker, mockClient, invoker, _ := newReviewWorker(t, 2)
+	ctx := context.Background()
+
+	if worker.TaskState() != StateReviewing {
+		t.Fatalf("완료 보고 후 리뷰 단계여야 함: %s", worker.TaskState())
+	}
+	if invoker.reviewWorkerID != "AI_01_REVIEW" || invoker.reviewModel != aimodel.AIModelOpenCode {
+		t.Errorf("리뷰 에이전트 실행 정보 불일치: %s %s", invoker.reviewWorkerID, invoker.reviewModel)
+	}
+	for _, want := range []string{"로그인 실패 수정", "+package login", "1차"} {
+		if !strings.Contains(invoker.reviewPrompt, want) {
+			t.Errorf("리뷰 프롬프트에 %q가 포함되어야 함:\n%s", want, invoker.reviewPrompt)
+		}
+	}
+	if len(mockClient.StatusUpdates) != 1 {
+		t.Errorf("리뷰 중에는 완료 상태로 바꾸지 않아야 함: %v", mockClient.StatusUpdates)
+	}
+
+	// 리뷰 중 완료 신호는 무시
+	if err := worker.MarkExecuting(ctx, "acceptEdits 모드 Stop"); !errors.Is(err, ErrReviewInProgress) {
+		t.Errorf("리뷰 중 실행 전이는 거부되어야 함: %v", err)
+	}
+
+	if err := worker.ReviewVerdict(ctx, ReviewVerdict{Approved: true, Summary: "문제 없음"}); err != nil {
+		t.Fatalf("승인 처리 실패: %v", err)
+	}
+	if worker.IsProcessing() || worker.TaskState() != StateIdle {
+		t.Errorf("승인 후 완료 처리되어야 함: %s", worker.TaskState())
+	}
+	if last := mockClient.StatusUpdates[len(mockClient.StatusUpdates)-1]; last.Status != "개발완료" {
+		t.Errorf("완료 상태로 변경되어야 함: %v", last)
+	}
+	if len(invoker.terminated) == 0 || invoker.terminated[0] != "AI_01_REVIEW" {
+		t.Errorf("판정 후 리뷰 에이전트를 종료해야 함: %v", invoker.terminated)
+	}
+}
+
+// TestWorker_Review_RequestChanges는 수정 요청을 구현 세션에 전달하고 반복 한도를 넘으면 실패하는지 테스트합니다.
+func TestWorker_Review_RequestChanges(t *testing.T) {
+	worker, mockClient, invoker, _ := newReviewWorker(t, 1)
+	ctx := context.Background()
+
+	verdict := ReviewVerdict{Summary: "테스트 누락", Comments: []string{"로그인 실패 케이스 테스트 추가"}}
+	if err := worker.ReviewVerdict(ctx, verdict); err != nil {
+		t.Fatalf("수정 요청 처리 실패: %v", err)
+	}
+	if worker.TaskState() != StateExecuting {
+		t.Fatalf("수정 요청 후 실행 단계여야 함: %s", worker.TaskState())
+	}
+	opts := invoker.LastOptions
+	if !opts.Continue || opts.Mode != ModeDirect || invoker.LastWorkerID != "AI_01" {
+		t.Errorf("구현 세션을 이어서 실행해야 함: %+v, %s", opts, invoker.LastWorkerID)
+	}
+	if !strings.Contains(invoker.LastPrompt, "- 로그인 실패 케이스 테스트 추가") {
+		t.Errorf("수정 항목이 전달되어야 함:\n%s", invoker.LastPrompt)
+	}
+
+	// 다시 완료 보고 → 2차 리뷰
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("재완료 보고 처리 실패: %v", err)
+	}
+	if invoker.reviews != 2 || !strings.Contains(invoker.reviewPrompt, "2차") {
+		t.Errorf("재완료 보고 시 다시 리뷰해야 함: %d", invoker.reviews)
+	}
+
+	// 반복 한도 초과 → 실패 (보류)
+	if err := worker.ReviewVerdict(ctx, verdict); err != nil {
+		t.Fatalf("한도 초과 처리 실패: %v", err)
+	}
+	if worker.IsProcessing() {
+		t.Error("반복 한도를 넘으면 실패 처리되어야 함")
+	}
+	if last := mockClient.StatusUpdates[len(mockClient.StatusUpdates)-1]; last.Status != StatusHold {
+		t.Errorf("실패 시 보류 상태여야 함: %v", last)
+	}
+}
+
+// TestWorker_Review_Unsupported는 리뷰 에이전트를 지원하지 않는 Invoker면 리뷰 없이 완료하는지 테스트합니다.
+func TestWorker_Review_Unsupported(t *testing.T) {
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크"}}}
+	config := WorkerConfig{ID: "AI_01", ListID: "list1", SrcPath: "/repo", Review: &ReviewConfig{Model: aimodel.AIModelClaude}}
+	worker := NewWorker(config, mockClient, &MockInvoker{Result: &InvokeResult{}}, "작업중", "개발완료", "")
+	ctx := context.Background()
+
+	worker.SetProcessing("task1", "태스크", "", "")
+	advanceTo(t, worker, StatePreparing, StatePlanning, StateExecuting)
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 처리 실패: %v", err)
+	}
+	if worker.IsProcessing() {
+		t.Error("리뷰를 생략하고 완료 처리되어야 함")
+	}
+}
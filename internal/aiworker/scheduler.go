@@ -0,0 +1,274 @@
+package aiworker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// 스케줄러가 실행 슬롯을 거부한 사유입니다.
+var (
+	ErrOutsideWorkingHours = errors.New("업무 시간 외")
+	ErrConcurrencyLimit    = errors.New("전체 동시 실행 한도 도달")
+	ErrModelLimit          = errors.New("모델별 동시 실행 한도 도달")
+	ErrBudgetExhausted     = errors.New("일일 실행 한도 소진")
+	ErrAgentUnavailable    = errors.New("실행 가능한 에이전트 없음")
+)
+
+// IsSlotDenied는 err가 스케줄러의 슬롯 거부(또는 실행할 에이전트 없음)인지 확인합니다.
+func IsSlotDenied(err error) bool {
+	return errors.Is(err, ErrOutsideWorkingHours) || errors.Is(err, ErrConcurrencyLimit) ||
+		errors.Is(err, ErrModelLimit) || errors.Is(err, ErrBudgetExhausted) ||
+		errors.Is(err, ErrAgentUnavailable)
+}
+
+// WorkingHours는 Worker가 새 태스크를 시작할 수 있는 시간대입니다.
+type WorkingHours struct {
+	Days     map[time.Weekday]bool // 근무 요일 (비어있으면 매일)
+	Start    time.Duration         // 시작 시각 (자정 기준)
+	End      time.Duration         // 종료 시각 (자정 기준, Start 이하이면 다음 날까지)
+	Location *time.Location
+	Holidays map[string]bool // 휴일 (2006-01-02)
+}
+
+// ParseWorkingHours는 "mon-fri 09:00-18:00" 형식의 업무 시간을 파싱합니다.
+// 요일은 생략(매일)하거나 "mon,wed,fri"처럼 나열할 수 있고, holidays는 콤마 구분 날짜(2006-01-02)입니다.
+func ParseWorkingHours(spec, timezone, holidays string) (*WorkingHours, error) {
+	wh := &WorkingHours{Days: map[time.Weekday]bool{}, Location: time.Local, Holidays: map[string]bool{}}
+
+	fields := strings.Fields(spec)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("업무 시간 형식 오류: %q (예: mon-fri 09:00-18:00)", spec)
+	}
+	if len(fields) == 2 {
+		days, err := parseWeekdays(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		wh.Days = days
+	}
+
+	start, end, ok := strings.Cut(fields[len(fields)-1], "-")
+	if !ok {
+		return nil, fmt.Errorf("업무 시간 형식 오류: %q (예: 09:00-18:00)", spec)
+	}
+	var err error
+	if wh.Start, err = parseClock(start); err != nil {
+		return nil, err
+	}
+	if wh.End, err = parseClock(end); err != nil {
+		return nil, err
+	}
+
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("시간대 로드 실패: %w", err)
+		}
+		wh.Location = loc
+	}
+
+	for _, day := range strings.Split(holidays, ",") {
+		day = strings.TrimSpace(day)
+		if day == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", day); err != nil {
+			return nil, fmt.Errorf("휴일 형식 오류: %q (2006-01-02)", day)
+		}
+		wh.Holidays[day] = true
+	}
+
+	return wh, nil
+}
+
+// Contains는 t가 업무 시간인지 확인합니다.
+// 자정을 넘는 시간대(예: 22:00-06:00)는 시작한 날의 요일/휴일을 기준으로 판단합니다.
+func (wh *WorkingHours) Contains(t time.Time) bool {
+	local := t.In(wh.Location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, wh.Location)
+	offset := local.Sub(midnight)
+
+	day := midnight
+	if wh.End <= wh.Start {
+		if offset >= wh.End && offset < wh.Start {
+			return false
+		}
+		if offset < wh.End {
+			day = midnight.AddDate(0, 0, -1) // 전날 시작한 시간대
+		}
+	} else if offset < wh.Start || offset >= wh.End {
+		return false
+	}
+
+	if len(wh.Days) > 0 && !wh.Days[day.Weekday()] {
+		return false
+	}
+	return !wh.Holidays[day.Format("2006-01-02")]
+}
+
+// parseClock은 "HH:MM"을 자정 기준 시간으로 변환합니다.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("시각 형식 오류: %q (HH:MM)", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// weekdayNames는 요일 약어 → time.Weekday 맵입니다.
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// parseWeekdays는 "mon-fri" 또는 "mon,wed,fri" 형식의 요일 목록을 파싱합니다.
+func parseWeekdays(s string) (map[time.Weekday]bool, error) {
+	days := map[time.Weekday]bool{}
+	for _, part := range strings.Split(strings.ToLower(s), ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first, ok := weekdayNames[from]
+		if !ok {
+			return nil, fmt.Errorf("알 수 없는 요일: %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdayNames[to]; !ok {
+				return nil, fmt.Errorf("알 수 없는 요일: %q", to)
+			}
+		}
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// SchedulerConfig는 에이전트 실행 스케줄러 설정입니다. 0은 제한 없음입니다.
+type SchedulerConfig struct {
+	MaxConcurrent int                         // 전체 동시 실행 에이전트 수
+	ModelLimits   map[aimodel.AIModelType]int // 모델별 동시 실행 에이전트 수
+	DailyBudget   int                         // 하루 최대 실행 시작 수 (에이전트 실행 전에 반환된 슬롯은 제외)
+	Location      *time.Location              // 일일 한도 기준 시간대 (기본: 로컬)
+}
+
+// Scheduler는 Worker가 새 태스크를 시작하기 전에 실행 슬롯을 배정합니다.
+// 전체/모델별 동시 실행 수, Worker별 업무 시간, 일일 실행 한도를 확인합니다.
+type Scheduler struct {
+	config SchedulerConfig
+	now    func() time.Time
+
+	mu        sync.Mutex
+	active    map[string]aimodel.AIModelType // Worker ID → 실행 중인 모델
+	budgetDay string                         // 일일 한도 집계 날짜 (2006-01-02)
+	started   int                            // budgetDay에 시작한 실행 수
+	uncounted map[string]string              // Worker ID → 아직 에이전트를 실행하지 않은 슬롯의 집계 날짜 (반환 시 환불)
+}
+
+// NewScheduler는 새 Scheduler를 생성합니다.
+func NewScheduler(config SchedulerConfig) *Scheduler {
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &Scheduler{
+		config:    config,
+		now:       time.Now,
+		active:    make(map[string]aimodel.AIModelType),
+		uncounted: make(map[string]string),
+	}
+}
+
+// Acquire는 Worker에 model(비어있으면 Worker 기본 모델) 실행 슬롯을 배정합니다.
+// 배정할 수 없으면 거부 사유 에러를 반환하며, 이미 슬롯을 가진 Worker는 그대로 성공합니다.
+// 일일 한도는 배정 시 미리 차감하며, Commit 전에 Release하면 환불합니다.
+func (s *Scheduler) Acquire(config WorkerConfig, model aimodel.AIModelType) error {
+	if model == "" {
+		model = config.AIModelType
+	}
+	now := s.now()
+	if config.WorkingHours != nil && !config.WorkingHours.Contains(now) {
+		return ErrOutsideWorkingHours
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.active[config.ID]; ok {
+		return nil
+	}
+	if s.config.MaxConcurrent > 0 && len(s.active) >= s.config.MaxConcurrent {
+		return fmt.Errorf("%w (%d)", ErrConcurrencyLimit, s.config.MaxConcurrent)
+	}
+	if limit := s.config.ModelLimits[model]; limit > 0 && s.countModel(model) >= limit {
+		return fmt.Errorf("%w (%s: %d)", ErrModelLimit, model, limit)
+	}
+
+	day := now.In(s.config.Location).Format("2006-01-02")
+	if day != s.budgetDay {
+		s.budgetDay, s.started = day, 0
+	}
+	if s.config.DailyBudget > 0 && s.started >= s.config.DailyBudget {
+		return fmt.Errorf("%w (%d)", ErrBudgetExhausted, s.config.DailyBudget)
+	}
+
+	s.started++
+	s.active[config.ID] = model
+	s.uncounted[config.ID] = day
+	return nil
+}
+
+// Commit은 Worker가 에이전트를 실행했음을 기록해 일일 한도 차감을 확정합니다.
+func (s *Scheduler) Commit(workerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.uncounted, workerID)
+}
+
+// Hold는 한도 확인 없이 Worker의 model(비어있으면 Worker 기본 모델) 슬롯을 점유합니다. (재시작 후 재연결된 실행용)
+func (s *Scheduler) Hold(config WorkerConfig, model aimodel.AIModelType) {
+	if model == "" {
+		model = config.AIModelType
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.active[config.ID] = model
+}
+
+// Release는 Worker의 슬롯을 반환합니다. 슬롯이 없으면 아무 일도 하지 않습니다.
+// 에이전트 실행 전(Commit 전)에 반환된 슬롯은 같은 날이면 일일 한도를 환불합니다.
+func (s *Scheduler) Release(workerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if day, ok := s.uncounted[workerID]; ok {
+		delete(s.uncounted, workerID)
+		if day == s.budgetDay && s.started > 0 {
+			s.started--
+		}
+	}
+	delete(s.active, workerID)
+}
+
+// Running은 실행 중인 에이전트 수를 반환합니다.
+func (s *Scheduler) Running() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.active)
+}
+
+// countModel은 model로 실행 중인 에이전트 수를 반환합니다. 호출자가 mu를 잡고 있어야 합니다.
+func (s *Scheduler) countModel(model aimodel.AIModelType) int {
+	count := 0
+	for _, m := range s.active {
+		if m == model {
+			count++
+		}
+	}
+	return count
+}
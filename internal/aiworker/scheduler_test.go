@@ -0,0 +1,233 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TestWorkingHours_Contains는 요일/시간대/휴일/자정 넘는 업무 시간을 테스트합니다.
+func TestWorkingHours_Contains(t *testing.T) {
+	wh, err := ParseWorkingHours("mon-fri 09:00-18:00", "Asia/Seoul", "2026-01-01")
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+	kst := wh.Location
+	night, err := ParseWorkingHours("fri,sat 22:00-06:00", "Asia/Seoul", "")
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+
+	// 2026-01-07은 수요일, 2026-01-01은 목요일(휴일), 2026-01-10은 토요일
+	tests := []struct {
+		name string
+		wh   *WorkingHours
+		at   time.Time
+		want bool
+	}{
+		{"평일 업무 시간", wh, time.Date(2026, 1, 7, 10, 0, 0, 0, kst), true},
+		{"평일 시작 전", wh, time.Date(2026, 1, 7, 8, 59, 0, 0, kst), false},
+		{"평일 종료 시각", wh, time.Date(2026, 1, 7, 18, 0, 0, 0, kst), false},
+		{"주말", wh, time.Date(2026, 1, 10, 10, 0, 0, 0, kst), false},
+		{"휴일", wh, time.Date(2026, 1, 1, 10, 0, 0, 0, kst), false},
+		{"다른 시간대 입력", wh, time.Date(2026, 1, 7, 1, 0, 0, 0, time.UTC), true},
+		{"야간 시작일", night, time.Date(2026, 1, 9, 23, 0, 0, 0, kst), true},
+		{"야간 다음날 새벽", night, time.Date(2026, 1, 11, 5, 0, 0, 0, kst), true},
+		{"야간 시작일 아님", night, time.Date(2026, 1, 8, 23, 0, 0, 0, kst), false},
+		{"야간 종료 후", night, time.Date(2026, 1, 10, 7, 0, 0, 0, kst), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.wh.Contains(tt.at); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+
+	for _, spec := range []string{"", "mon-fri", "xyz 09:00-18:00", "09:00~18:00", "mon 9-18"} {
+		if _, err := ParseWorkingHours(spec, "", ""); err == nil {
+			t.Errorf("잘못된 업무 시간이 허용됨: %q", spec)
+		}
+	}
+	if _, err := ParseWorkingHours("09:00-18:00", "", "2026/01/01"); err == nil {
+		t.Error("잘못된 휴일이 허용됨")
+	}
+}
+
+// TestScheduler_Acquire는 전체/모델별 동시 실행 한도와 일일 한도를 테스트합니다.
+func TestScheduler_Acquire(t *testing.T) {
+	now := time.Date(2026, 1, 7, 10, 0, 0, 0, time.UTC)
+	s := NewScheduler(SchedulerConfig{
+		MaxConcurrent: 2,
+		ModelLimits:   map[aimodel.AIModelType]int{AIModelClaude: 1},
+		DailyBudget:   3,
+		Location:      time.UTC,
+	})
+	s.now = func() time.Time { return now }
+
+	claude1 := WorkerConfig{ID: "AI_01", AIModelType: AIModelClaude}
+	claude2 := WorkerConfig{ID: "AI_02", AIModelType: AIModelClaude}
+	opencode := WorkerConfig{ID: "AI_03", AIModelType: AIModelOpenCode}
+	amp := WorkerConfig{ID: "AI_04", AIModelType: AIModelAmpcode}
+
+	if err := s.Acquire(claude1, ""); err != nil {
+		t.Fatalf("첫 슬롯 배정 실패: %v", err)
+	}
+	s.Commit("AI_01")
+	if err := s.Acquire(claude1, ""); err != nil {
+		t.Errorf("이미 슬롯을 가진 Worker는 성공해야 함: %v", err)
+	}
+	if err := s.Acquire(claude2, ""); !errors.Is(err, ErrModelLimit) {
+		t.Errorf("모델 한도 에러 기대: %v", err)
+	}
+	if err := s.Acquire(opencode, ""); err != nil {
+		t.Fatalf("슬롯 배정 실패: %v", err)
+	}
+	s.Commit("AI_03")
+	if err := s.Acquire(amp, ""); !errors.Is(err, ErrConcurrencyLimit) {
+		t.Errorf("전체 한도 에러 기대: %v", err)
+	}
+
+	s.Release("AI_01")
+	s.Release("AI_01") // 중복 반환은 무시
+	if s.Running() != 1 {
+		t.Errorf("실행 중 수 불일치: %d", s.Running())
+	}
+	if err := s.Acquire(amp, ""); err != nil {
+		t.Fatalf("슬롯 배정 실패: %v", err)
+	}
+
+	// 에이전트 실행 전에 반환한 슬롯은 일일 한도에서 환불
+	s.Release("AI_04")
+	if err := s.Acquire(amp, ""); err != nil {
+		t.Fatalf("환불 후 슬롯 배정 실패: %v", err)
+	}
+	s.Commit("AI_04")
+
+	// 일일 한도 3회 소진 (AI_01, AI_03, AI_04)
+	s.Release("AI_03")
+	if err := s.Acquire(claude2, ""); !errors.Is(err, ErrBudgetExhausted) || !IsSlotDenied(err) {
+		t.Errorf("일일 한도 에러 기대: %v", err)
+	}
+
+	// 다음 날 초기화
+	now = now.Add(24 * time.Hour)
+	if err := s.Acquire(claude2, ""); err != nil {
+		t.Errorf("다음 날에는 한도가 초기화되어야 함: %v", err)
+	}
+}
+
+// TestManager_StartTask_Scheduler는 슬롯을 받지 못하면 태스크를 시작하지 않고, 종료 시 슬롯을 반환하는지 테스트합니다.
+func TestManager_StartTask_Scheduler(t *testing.T) {
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	config.AddWorker("AI_02", "list2", "/path2")
+	manager := NewManager(config)
+	manager.SetClickUpClient(&MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "task1", Name: "태스크1"},
+		{ID: "task2", Name: "태스크2"},
+	}})
+	manager.SetInvoker(&terminatingInvoker{})
+	scheduler := NewScheduler(SchedulerConfig{MaxConcurrent: 1})
+	manager.SetScheduler(scheduler)
+
+	ctx := context.Background()
+	w1, w2 := manager.GetWorkers()[0], manager.GetWorkers()[1]
+
+	if err := manager.StartTask(ctx, w1, "task1"); err != nil {
+		t.Fatalf("태스크 시작 실패: %v", err)
+	}
+	if err := manager.StartTask(ctx, w2, "task2"); !errors.Is(err, ErrConcurrencyLimit) {
+		t.Fatalf("동시 실행 한도 에러 기대: %v", err)
+	}
+	if w2.IsProcessing() {
+		t.Error("슬롯 없이 태스크가 시작됨")
+	}
+
+	if err := w1.Abort(ctx, "테스트 종료"); err != nil {
+		t.Fatalf("중단 실패: %v", err)
+	}
+	if scheduler.Running() != 0 {
+		t.Errorf("종료 후 슬롯이 반환되어야 함: %d", scheduler.Running())
+	}
+	if err := manager.StartTask(ctx, w2, "task2"); err != nil {
+		t.Errorf("슬롯 반환 후 시작 실패: %v", err)
+	}
+}
+
+// TestManager_StartTask_BudgetRefund는 에이전트 실행 전에 끝난 시작 시도는 일일 한도를 차감하지 않는지 테스트합니다.
+func TestManager_StartTask_BudgetRefund(t *testing.T) {
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	manager := NewManager(config)
+	manager.SetClickUpClient(&MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크1"}}})
+	manager.SetInvoker(&terminatingInvoker{})
+	scheduler := NewScheduler(SchedulerConfig{DailyBudget: 1})
+	manager.SetScheduler(scheduler)
+
+	ctx := context.Background()
+	w := manager.GetWorkers()[0]
+
+	// 태스크 조회 실패는 폴링마다 재시도되므로 한도를 소진하면 안 됨
+	for i := 0; i < 3; i++ {
+		if err := manager.StartTask(ctx, w, "missing"); err == nil || IsSlotDenied(err) {
+			t.Fatalf("태스크 조회 실패 기대: %v", err)
+		}
+	}
+	if err := manager.StartTask(ctx, w, "task1"); err != nil {
+		t.Fatalf("실패한 시도 후 한도가 남아있어야 함: %v", err)
+	}
+
+	// 에이전트를 실행한 태스크는 종료 후에도 한도에 포함
+	if err := w.Abort(ctx, "테스트 종료"); err != nil {
+		t.Fatalf("중단 실패: %v", err)
+	}
+	if err := manager.StartTask(ctx, w, "task1"); !errors.Is(err, ErrBudgetExhausted) {
+		t.Errorf("일일 한도 에러 기대: %v", err)
+	}
+}
+
+// availabilityInvoker는 에이전트 확인 요청 모델을 기록하는 테스트용 Invoker입니다.
+type availabilityInvoker struct {
+	terminatingInvoker
+	checked []aimodel.AIModelType
+}
+
+func (i *availabilityInvoker) CheckAvailable(config WorkerConfig, model aimodel.AIModelType) error {
+	i.checked = append(i.checked, model)
+	return nil
+}
+
+// TestManager_StartTask_ModelOverride는 에이전트 확인과 모델별 한도가 태스크 오버라이드 모델 기준인지 테스트합니다.
+func TestManager_StartTask_ModelOverride(t *testing.T) {
+	config := DefaultConfig()
+	config.AddWorkerWithConfig("AI_01", "list1", "/path1", TerminalTypeDefault, AIModelClaude)
+	config.AddWorkerWithConfig("AI_02", "list2", "/path2", TerminalTypeDefault, AIModelClaude)
+	config.Workers[0].Overrides = OverridePolicy{Models: []aimodel.AIModelType{AIModelOpenCode}}
+	manager := NewManager(config)
+	manager.SetClickUpClient(&MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "task1", Name: "태스크1", Tags: []clickup.Tag{{Name: "model:opencode"}}},
+		{ID: "task2", Name: "태스크2"},
+	}})
+	invoker := &availabilityInvoker{}
+	manager.SetInvoker(invoker)
+	manager.SetScheduler(NewScheduler(SchedulerConfig{ModelLimits: map[aimodel.AIModelType]int{AIModelClaude: 1}}))
+
+	ctx := context.Background()
+	w1, w2 := manager.GetWorkers()[0], manager.GetWorkers()[1]
+
+	if err := manager.StartTask(ctx, w1, "task1"); err != nil {
+		t.Fatalf("태스크 시작 실패: %v", err)
+	}
+	// AI_01은 OpenCode 슬롯을 쓰므로 Claude 한도(1)는 남아있어야 함
+	if err := manager.StartTask(ctx, w2, "task2"); err != nil {
+		t.Fatalf("Claude 슬롯 배정 실패: %v", err)
+	}
+	if len(invoker.checked) != 2 || invoker.checked[0] != AIModelOpenCode || invoker.checked[1] != AIModelClaude {
+		t.Errorf("에이전트 확인은 실행 모델 기준이어야 함: %v", invoker.checked)
+	}
+}
@@ -0,0 +1,204 @@
+package aiworker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// SLACheckInterval은 SLA 점검 간격입니다.
+const SLACheckInterval = time.Minute
+
+// SLA는 리스트별 서비스 수준 목표입니다. 0이면 해당 항목을 점검하지 않습니다.
+type SLA struct {
+	MaxWait time.Duration // AI 리스트 대기 상태 진입 후 처리 시작까지 최대 대기 시간
+	MaxRun  time.Duration // 처리 시작 후 종료까지 최대 실행 시간
+}
+
+// Enabled는 점검할 SLA 항목이 있는지 확인합니다.
+func (s SLA) Enabled() bool {
+	return s.MaxWait > 0 || s.MaxRun > 0
+}
+
+// SLAKind는 SLA 위반 종류입니다.
+type SLAKind string
+
+const (
+	SLAKindWait SLAKind = "wait" // 대기 시간 초과
+	SLAKindRun  SLAKind = "run"  // 실행 시간 초과
+)
+
+// SLAAlert는 SLA 위반(또는 해소) 알림입니다.
+type SLAAlert struct {
+	Kind     SLAKind
+	WorkerID string
+	ListID   string
+	TaskID   string
+	TaskName string
+	Elapsed  time.Duration // 위반 감지 시점의 경과 시간
+	Limit    time.Duration
+	Resolved bool // true면 위반 해소 알림
+	At       time.Time
+}
+
+// Scope는 위반을 점검하는 범위입니다. 대기 위반은 리스트(풀 공유), 실행 위반은 Worker 단위입니다.
+func (a SLAAlert) Scope() string {
+	if a.Kind == SLAKindWait {
+		return a.ListID
+	}
+	return a.WorkerID
+}
+
+// Key는 위반 중복 제거 키입니다.
+func (a SLAAlert) Key() string {
+	return fmt.Sprintf("%s/%s/%s", a.Scope(), a.Kind, a.TaskID)
+}
+
+// EvaluateWaitSLA는 리스트의 대기 태스크(Manager.WaitingTasks) 중 대기 SLA를 초과한 태스크를 찾습니다.
+// 대기 시간은 waitSince(WaitClock.Observe 결과)의 대기 시작 시각부터 계산하며,
+// 대기 시작 시각을 알 수 없는 태스크는 제외합니다.
+func EvaluateWaitSLA(config WorkerConfig, tasks []*clickup.Task, waitSince map[string]time.Time, now time.Time) []SLAAlert {
+	if config.SLA.MaxWait <= 0 {
+		return nil
+	}
+
+	var breaches []SLAAlert
+	for _, task := range tasks {
+		since := waitSince[task.ID]
+		if since.IsZero() {
+			continue
+		}
+		if elapsed := now.Sub(since); elapsed > config.SLA.MaxWait {
+			breaches = append(breaches, SLAAlert{
+				Kind:     SLAKindWait,
+				WorkerID: config.ID,
+				ListID:   config.ListID,
+				TaskID:   task.ID,
+				TaskName: task.Name,
+				Elapsed:  elapsed,
+				Limit:    config.SLA.MaxWait,
+				At:       now,
+			})
+		}
+	}
+	return breaches
+}
+
+// WaitClock은 태스크가 AI 리스트에서 대기 상태로 처음 관측된 시각을 기록해 대기 시간의 기준으로 씁니다.
+// 처음 관측할 때는 ClickUp date_updated(리스트/상태 진입 시각의 근사)를 사용하고,
+// 이후 태스크가 수정되어 date_updated가 바뀌어도 대기 상태에서 빠지기 전까지 유지합니다.
+type WaitClock struct {
+	mu    sync.Mutex
+	since map[string]map[string]time.Time // 리스트 ID → 태스크 ID → 대기 시작 시각
+}
+
+// NewWaitClock은 새 WaitClock을 생성합니다.
+func NewWaitClock() *WaitClock {
+	return &WaitClock{since: make(map[string]map[string]time.Time)}
+}
+
+// Observe는 리스트의 현재 대기 태스크별 대기 시작 시각을 반환합니다.
+// 목록에서 빠진 태스크(처리 시작/이동/완료)는 기록에서 지워 다시 대기하면 새로 계산합니다.
+func (c *WaitClock) Observe(listID string, tasks []*clickup.Task, now time.Time) map[string]time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev := c.since[listID]
+	current := make(map[string]time.Time, len(tasks))
+	for _, task := range tasks {
+		start, ok := prev[task.ID]
+		if !ok {
+			start = clickup.ParseMillis(task.DateUpdated)
+			if start.IsZero() || start.After(now) {
+				start = now
+			}
+		}
+		current[task.ID] = start
+	}
+	c.since[listID] = current
+	return current
+}
+
+// EvaluateRunSLA는 Worker의 현재 실행이 실행 SLA를 초과했는지 확인합니다.
+func EvaluateRunSLA(w *Worker, now time.Time) []SLAAlert {
+	config := w.GetConfig()
+	if config.SLA.MaxRun <= 0 || !w.IsProcessing() {
+		return nil
+	}
+	startedAt := w.GetStartedAt()
+	if startedAt.IsZero() {
+		return nil
+	}
+	elapsed := now.Sub(startedAt)
+	if elapsed <= config.SLA.MaxRun {
+		return nil
+	}
+	return []SLAAlert{{
+		Kind:     SLAKindRun,
+		WorkerID: config.ID,
+		ListID:   config.ListID,
+		TaskID:   w.GetCurrentTaskID(),
+		TaskName: w.GetCurrentTaskName(),
+		Elapsed:  elapsed,
+		Limit:    config.SLA.MaxRun,
+		At:       now,
+	}}
+}
+
+// SLATracker는 진행 중인 SLA 위반을 추적해 위반당 한 번만 알리고, 해소 시 해소 알림을 만듭니다.
+type SLATracker struct {
+	mu     sync.Mutex
+	active map[string]SLAAlert // Key → 최초 감지된 위반
+}
+
+// NewSLATracker는 새 SLATracker를 생성합니다.
+func NewSLATracker() *SLATracker {
+	return &SLATracker{active: make(map[string]SLAAlert)}
+}
+
+// Update는 scope(SLAAlert.Scope)/kind 범위의 현재 위반 목록을 반영합니다.
+// 새로 감지된 위반은 raised로, 목록에서 사라진 기존 위반은 Resolved가 설정되어 resolved로 반환됩니다.
+func (t *SLATracker) Update(scope string, kind SLAKind, breaches []SLAAlert, now time.Time) (raised, resolved []SLAAlert) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := make(map[string]bool, len(breaches))
+	for _, breach := range breaches {
+		key := breach.Key()
+		current[key] = true
+		if _, ok := t.active[key]; !ok {
+			t.active[key] = breach
+			raised = append(raised, breach)
+		}
+	}
+
+	for key, alert := range t.active {
+		if alert.Scope() != scope || alert.Kind != kind || current[key] {
+			continue
+		}
+		delete(t.active, key)
+		alert.Resolved = true
+		alert.Elapsed = now.Sub(alert.At) + alert.Elapsed
+		alert.At = now
+		resolved = append(resolved, alert)
+	}
+	sort.Slice(resolved, func(i, j int) bool { return resolved[i].TaskID < resolved[j].TaskID })
+
+	return raised, resolved
+}
+
+// Active는 진행 중인 위반 목록을 반환합니다.
+func (t *SLATracker) Active() []SLAAlert {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	alerts := make([]SLAAlert, 0, len(t.active))
+	for _, alert := range t.active {
+		alerts = append(alerts, alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Key() < alerts[j].Key() })
+	return alerts
+}
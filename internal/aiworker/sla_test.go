@@ -0,0 +1,163 @@
+package aiworker
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// millis는 시각을 ClickUp 밀리초 epoch 문자열로 변환합니다.
+func millis(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// TestManager_CheckSLA는 대기/실행 SLA 위반이 한 번만 알려지고 해소 시 해소 알림이 가는지 테스트합니다.
+func TestManager_CheckSLA(t *testing.T) {
+	now := time.Now()
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	config.Workers[0].SLA = SLA{MaxWait: time.Hour, MaxRun: 20 * time.Minute}
+	manager := NewManager(config)
+
+	client := &MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "old", Name: "오래된 태스크", DateUpdated: millis(now.Add(-3 * time.Hour))},
+		{ID: "new", Name: "새 태스크", DateUpdated: millis(now.Add(-10 * time.Minute))},
+		{ID: "moved", Name: "방금 AI 리스트로 옮긴 태스크", DateCreated: millis(now.Add(-48 * time.Hour)), DateUpdated: millis(now.Add(-5 * time.Minute))},
+		{ID: "unknown", Name: "수정 시각 없음"},
+	}}
+	manager.SetClickUpClient(client)
+
+	var alerts []SLAAlert
+	manager.SetSLAHandler(func(ctx context.Context, alert SLAAlert) {
+		alerts = append(alerts, alert)
+	})
+
+	ctx := context.Background()
+	worker := manager.GetWorkers()[0]
+
+	manager.CheckSLA(ctx, now)
+	if len(alerts) != 1 || alerts[0].Kind != SLAKindWait || alerts[0].TaskID != "old" || alerts[0].Resolved {
+		t.Fatalf("대기 SLA 위반 알림 불일치: %+v", alerts)
+	}
+
+	// 같은 위반은 다시 알리지 않으며, 대기 중 수정되어 date_updated가 바뀌어도 대기 시작 시각은 유지
+	client.Tasks[0].DateUpdated = millis(now)
+	manager.CheckSLA(ctx, now.Add(time.Minute))
+	if len(alerts) != 1 {
+		t.Fatalf("중복 알림 발생: %+v", alerts)
+	}
+
+	// 처리 시작 → 대기 위반 해소, 실행 시간 초과 시 실행 위반
+	worker.SetProcessing("old", "오래된 태스크", "", "AI요청")
+	manager.CheckSLA(ctx, now.Add(30*time.Minute))
+	if len(alerts) != 3 {
+		t.Fatalf("알림 수 불일치: %+v", alerts)
+	}
+	if !alerts[1].Resolved || alerts[1].TaskID != "old" || alerts[1].Kind != SLAKindWait {
+		t.Errorf("대기 위반 해소 알림 불일치: %+v", alerts[1])
+	}
+	if alerts[2].Kind != SLAKindRun || alerts[2].TaskID != "old" || alerts[2].Resolved {
+		t.Errorf("실행 SLA 위반 알림 불일치: %+v", alerts[2])
+	}
+	if active := manager.slaTracker.Active(); len(active) != 1 || active[0].Kind != SLAKindRun {
+		t.Errorf("진행 중 위반 불일치: %+v", active)
+	}
+
+	// 처리 종료 → 실행 위반 해소
+	worker.ClearProcessing()
+	client.Tasks = nil
+	alerts = nil
+	manager.CheckSLA(ctx, now.Add(40*time.Minute))
+	if len(alerts) != 1 || !alerts[0].Resolved || alerts[0].Kind != SLAKindRun {
+		t.Errorf("해소 알림 불일치: %+v", alerts)
+	}
+	if active := manager.slaTracker.Active(); len(active) != 0 {
+		t.Errorf("모든 위반이 해소되어야 함: %+v", active)
+	}
+}
+
+// TestSLATracker_ScopedResolve는 다른 범위(리스트/Worker)나 종류의 위반은 해소하지 않는지 테스트합니다.
+func TestSLATracker_ScopedResolve(t *testing.T) {
+	now := time.Now()
+	tracker := NewSLATracker()
+	tracker.Update("list1", SLAKindWait, []SLAAlert{{Kind: SLAKindWait, WorkerID: "AI_01", ListID: "list1", TaskID: "a", At: now}}, now)
+	tracker.Update("list2", SLAKindWait, []SLAAlert{{Kind: SLAKindWait, WorkerID: "AI_02", ListID: "list2", TaskID: "b", At: now}}, now)
+	tracker.Update("AI_01", SLAKindRun, []SLAAlert{{Kind: SLAKindRun, WorkerID: "AI_01", ListID: "list1", TaskID: "c", At: now}}, now)
+
+	raised, resolved := tracker.Update("AI_01", SLAKindWait, nil, now)
+	if len(raised) != 0 || len(resolved) != 0 {
+		t.Errorf("다른 범위의 위반이 해소됨: %+v %+v", raised, resolved)
+	}
+
+	_, resolved = tracker.Update("list1", SLAKindWait, nil, now.Add(time.Minute))
+	if len(resolved) != 1 || resolved[0].TaskID != "a" {
+		t.Errorf("list1 대기 위반만 해소되어야 함: %+v", resolved)
+	}
+	if active := tracker.Active(); len(active) != 2 || active[0].TaskID != "c" || active[1].ListID != "list2" {
+		t.Errorf("실행 위반과 list2 위반은 유지되어야 함: %+v", active)
+	}
+}
+
+// TestManager_CheckSLA_Pool은 풀 리스트의 대기 SLA를 Worker마다 중복 알리지 않고 리스트 단위로 한 번만 알리는지 테스트합니다.
+func TestManager_CheckSLA_Pool(t *testing.T) {
+	now := time.Now()
+	config := DefaultConfig()
+	config.AddWorker("AI_01", "list1", "/path1")
+	config.AddWorker("AI_02", "list1", "/path2")
+	for i := range config.Workers {
+		config.Workers[i].SLA = SLA{MaxWait: time.Hour}
+	}
+	manager := NewManager(config)
+	manager.SetClickUpClient(&MockClickUpClient{Tasks: []*clickup.Task{
+		{ID: "stale", Name: "오래 대기", DateUpdated: millis(now.Add(-3 * time.Hour))},
+		{ID: "busy", Name: "처리 중", DateUpdated: millis(now.Add(-3 * time.Hour))},
+	}})
+
+	var alerts []SLAAlert
+	manager.SetSLAHandler(func(ctx context.Context, alert SLAAlert) {
+		alerts = append(alerts, alert)
+	})
+
+	// 다른 풀 Worker가 처리 중인 태스크는 대기로 보지 않음
+	workers := manager.GetWorkers()
+	workers[1].SetProcessing("busy", "처리 중", "", "AI요청")
+
+	ctx := context.Background()
+	manager.CheckSLA(ctx, now)
+	if len(alerts) != 1 || alerts[0].TaskID != "stale" || alerts[0].Key() != "list1/wait/stale" {
+		t.Fatalf("풀 리스트 대기 위반은 한 번만 알려야 함: %+v", alerts)
+	}
+
+	workers[0].SetProcessing("stale", "오래 대기", "", "AI요청")
+	manager.CheckSLA(ctx, now.Add(time.Minute))
+	if len(alerts) != 2 || !alerts[1].Resolved || alerts[1].TaskID != "stale" {
+		t.Errorf("풀 Worker가 처리를 시작하면 해소되어야 함: %+v", alerts)
+	}
+}
+
+// TestWaitClock_Observe는 대기 시작 시각이 처음 관측 기준으로 유지되고 대기에서 빠지면 지워지는지 테스트합니다.
+func TestWaitClock_Observe(t *testing.T) {
+	now := time.Now()
+	clock := NewWaitClock()
+	task := &clickup.Task{ID: "task1"}
+
+	// 수정 시각을 모르면 처음 관측한 시각부터 계산
+	since := clock.Observe("list1", []*clickup.Task{task}, now)
+	if !since["task1"].Equal(now) {
+		t.Fatalf("처음 관측 시각이어야 함: %v", since["task1"])
+	}
+	task.DateUpdated = millis(now.Add(time.Hour))
+	if since = clock.Observe("list1", []*clickup.Task{task}, now.Add(2*time.Hour)); !since["task1"].Equal(now) {
+		t.Errorf("대기 중에는 대기 시작 시각을 유지해야 함: %v", since["task1"])
+	}
+
+	// 대기에서 빠졌다가 다시 대기하면 새로 계산
+	clock.Observe("list1", nil, now.Add(3*time.Hour))
+	since = clock.Observe("list1", []*clickup.Task{task}, now.Add(4*time.Hour))
+	if !since["task1"].Equal(time.UnixMilli(now.Add(time.Hour).UnixMilli())) {
+		t.Errorf("다시 대기하면 date_updated부터 계산해야 함: %v", since["task1"])
+	}
+}
@@ -0,0 +1,102 @@
+package aiworker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// WorkerState는 재시작 후 복구를 위해 저장하는 Worker 상태 스냅샷입니다.
+type WorkerState struct {
+	WorkerID       string    `json:"worker_id"`
+	ListID         string    `json:"list_id"`
+	Processing     bool      `json:"processing"`
+	State          TaskState `json:"state,omitempty"` // 태스크 수명 주기 상태
+	TaskID         string    `json:"task_id,omitempty"`
+	TaskName       string    `json:"task_name,omitempty"`
+	JiraID         string    `json:"jira_id,omitempty"`
+	OriginalStatus string    `json:"original_status,omitempty"` // 롤백용 원래 상태
+	SlackThreadTS  string    `json:"slack_thread_ts,omitempty"`
+	Model          string    `json:"model,omitempty"` // 태스크 실행 모델 (오버라이드 반영)
+	Mode           AgentMode `json:"mode,omitempty"`  // 태스크 실행 모드
+	StartedAt      time.Time `json:"started_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// StateStore는 Worker 상태 저장소 인터페이스입니다.
+type StateStore interface {
+	Save(state WorkerState) error
+	Load() (map[string]WorkerState, error) // Worker ID → 상태
+}
+
+// FileStateStore는 JSON 파일 기반 Worker 상태 저장소입니다.
+// 저장할 때마다 임시 파일에 쓰고 이름을 바꾸어 중간에 종료되어도 파일이 깨지지 않습니다.
+type FileStateStore struct {
+	path   string
+	mu     sync.Mutex
+	states map[string]WorkerState
+}
+
+// NewFileStateStore는 새 FileStateStore를 생성하고 기존 상태를 읽습니다.
+func NewFileStateStore(path string) (*FileStateStore, error) {
+	s := &FileStateStore{
+		path:   path,
+		states: make(map[string]WorkerState),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("상태 파일 읽기 실패: %w", err)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &s.states); err != nil {
+			return nil, fmt.Errorf("상태 파일 파싱 실패: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Save는 Worker 상태를 저장합니다.
+func (s *FileStateStore) Save(state WorkerState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state.WorkerID] = state
+
+	data, err := json.MarshalIndent(s.states, "", "  ")
+	if err != nil {
+		return fmt.Errorf("상태 직렬화 실패: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("상태 디렉토리 생성 실패: %w", err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("상태 파일 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("상태 파일 교체 실패: %w", err)
+	}
+	return nil
+}
+
+// Load는 저장된 모든 Worker 상태의 복사본을 반환합니다.
+func (s *FileStateStore) Load() (map[string]WorkerState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states := make(map[string]WorkerState, len(s.states))
+	for id, state := range s.states {
+		states[id] = state
+	}
+	return states, nil
+}
@@ -0,0 +1,40 @@
+package aiworker
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestFileStateStore_SaveAndReload는 상태 저장 후 재시작 시 다시 읽는지 테스트합니다.
+func TestFileStateStore_SaveAndReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "aiworker_state.json")
+
+	store, err := NewFileStateStore(path)
+	if err != nil {
+		t.Fatalf("저장소 생성 실패: %v", err)
+	}
+
+	worker := NewWorker(WorkerConfig{ID: "AI_01", ListID: "list1"}, nil, nil, "작업중", "개발완료", "")
+	worker.SetStateStore(store)
+	worker.SetProcessing("task1", "테스트 태스크", "ITSM-1", "진행 전")
+	worker.SetSlackThreadTS("1700000000.000100")
+
+	reloaded, err := NewFileStateStore(path)
+	if err != nil {
+		t.Fatalf("저장소 재생성 실패: %v", err)
+	}
+	states, _ := reloaded.Load()
+	state, ok := states["AI_01"]
+	if !ok {
+		t.Fatal("저장된 상태가 없음")
+	}
+	if !state.Processing || state.TaskID != "task1" || state.OriginalStatus != "진행 전" || state.SlackThreadTS != "1700000000.000100" {
+		t.Errorf("저장된 상태 불일치: %+v", state)
+	}
+
+	worker.ClearProcessing()
+	states, _ = store.Load()
+	if states["AI_01"].Processing || states["AI_01"].TaskID != "" {
+		t.Errorf("처리 완료 후 상태가 비어야 함: %+v", states["AI_01"])
+	}
+}
@@ -0,0 +1,255 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrVerifyInProgress는 완료 확인(TDD 점검 등) 중에 실행 단계 전이나 다시 완료 처리를 요청하면 반환됩니다.
+var ErrVerifyInProgress = errors.New("완료 확인 진행 중")
+
+// TDDAction은 TDD 규칙 위반 시 처리 방식입니다.
+type TDDAction string
+
+const (
+	TDDActionFlag  TDDAction = "flag"  // 완료 보고와 ClickUp 댓글에 위반을 표시하고 완료 처리 (기본값)
+	TDDActionRetry TDDAction = "retry" // 에이전트에 수정을 요청 (재시도 한도를 넘으면 flag)
+)
+
+// 기본 TDD 점검 설정
+const (
+	DefaultTDDMaxRetries     = 1
+	DefaultTDDCommandTimeout = 10 * time.Minute
+)
+
+// ParseTDDAction은 문자열을 TDD 위반 처리 방식으로 변환합니다. 빈 문자열이면 flag입니다.
+func ParseTDDAction(s string) (TDDAction, error) {
+	switch a := TDDAction(strings.ToLower(strings.TrimSpace(s))); a {
+	case "":
+		return TDDActionFlag, nil
+	case TDDActionFlag, TDDActionRetry:
+		return a, nil
+	default:
+		return "", fmt.Errorf("알 수 없는 TDD 위반 처리 방식: %q (flag, retry)", s)
+	}
+}
+
+// TDDConfig는 완료 보고된 변경의 TDD 규칙 점검 설정입니다.
+type TDDConfig struct {
+	TestPatterns []string  // 테스트 파일 패턴 (파일 이름 또는 저장소 기준 경로 glob, 예: *_test.go, tests/*)
+	TestCommand  string    // 테스트 실행 명령 (비어있으면 테스트 파일 포함 여부만 점검)
+	VerifyBase   bool      // 새 테스트가 시작 커밋에서 실패하는지 확인 (TestCommand 필요)
+	Action       TDDAction // 위반 시 처리 방식
+	MaxRetries   int       // retry 방식의 수정 요청 최대 횟수 (기본: 1)
+}
+
+// TDDReport는 TDD 점검 결과입니다.
+type TDDReport struct {
+	ChangedFiles []string // 시작 커밋 대비 추가/변경된 파일
+	TestFiles    []string // 그중 테스트 파일
+	Violations   []string // 위반 내용 (비어있으면 통과)
+}
+
+// OK는 위반이 없는지 확인합니다.
+func (r *TDDReport) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// TaskCommenter는 태스크 댓글 작성을 지원하는 ClickUp 클라이언트가 구현하는 인터페이스입니다.
+type TaskCommenter interface {
+	AddComment(ctx context.Context, taskID, text string) error
+}
+
+// TDDChecker는 작업 경로의 변경이 TDD 규칙을 지키는지 점검합니다.
+type TDDChecker struct {
+	config  TDDConfig
+	patches *PatchBuilder
+	git     func(ctx context.Context, dir string, args ...string) (string, error) // 테스트에서 교체
+	run     func(ctx context.Context, dir, command string) (string, error)        // 테스트에서 교체
+}
+
+// NewTDDChecker는 새 TDDChecker를 생성합니다.
+func NewTDDChecker(config TDDConfig) *TDDChecker {
+	if config.Action == "" {
+		config.Action = TDDActionFlag
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = DefaultTDDMaxRetries
+	}
+	return &TDDChecker{config: config, patches: NewPatchBuilder(), git: runGit, run: runShell}
+}
+
+// IsTestFile은 file(저장소 기준 경로)이 테스트 파일 패턴과 일치하는지 확인합니다.
+// 경로 구분자가 없는 패턴은 파일 이름과, 있는 패턴은 전체 경로와 비교합니다.
+func (c *TDDChecker) IsTestFile(file string) bool {
+	for _, pattern := range c.config.TestPatterns {
+		target := file
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(file)
+		}
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Check는 base 커밋 대비 dir의 변경을 점검합니다.
+// 변경에 테스트 파일이 있어야 하고, 테스트 명령이 있으면 결과에서 통과해야 하며,
+// VerifyBase면 새 테스트를 시작 커밋에 적용했을 때 실패해야 합니다. 변경이 없으면 점검하지 않습니다.
+func (c *TDDChecker) Check(ctx context.Context, dir, base string) (*TDDReport, error) {
+	files, err := c.patches.ChangedFiles(ctx, dir, base)
+	if err != nil {
+		return nil, err
+	}
+	report := &TDDReport{ChangedFiles: files}
+	if len(files) == 0 {
+		return report, nil
+	}
+	for _, file := range files {
+		if c.IsTestFile(file) {
+			report.TestFiles = append(report.TestFiles, file)
+		}
+	}
+	if len(report.TestFiles) == 0 {
+		report.Violations = append(report.Violations,
+			fmt.Sprintf("변경에 테스트 파일이 없음 (패턴: %s)", strings.Join(c.config.TestPatterns, ", ")))
+		return report, nil
+	}
+	if c.config.TestCommand == "" {
+		return report, nil
+	}
+
+	if out, err := c.run(ctx, dir, c.config.TestCommand); err != nil {
+		report.Violations = append(report.Violations,
+			fmt.Sprintf("결과에서 테스트 실패 (%s): %v\n%s", c.config.TestCommand, err, tailLines(out, 20)))
+	}
+	if c.config.VerifyBase {
+		failed, err := c.failsOnBase(ctx, dir, base, report.TestFiles)
+		if err != nil {
+			return nil, err
+		}
+		if !failed {
+			report.Violations = append(report.Violations, "새 테스트가 시작 커밋에서도 통과함 (구현 전에 실패하는 테스트여야 함)")
+		}
+	}
+	return report, nil
+}
+
+// failsOnBase는 base 커밋의 임시 worktree에 테스트 파일만 복사해 테스트 명령이 실패하는지 확인합니다.
+func (c *TDDChecker) failsOnBase(ctx context.Context, dir, base string, testFiles []string) (bool, error) {
+	tmp, err := os.MkdirTemp("", "ai-worker-tdd-*")
+	if err != nil {
+		return false, fmt.Errorf("임시 디렉토리 생성 실패: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+	if _, err := c.git(ctx, dir, "worktree", "add", "--quiet", "--detach", tmp, base); err != nil {
+		return false, fmt.Errorf("시작 커밋 worktree 생성 실패: %w", err)
+	}
+	defer c.git(context.Background(), dir, "worktree", "remove", "--force", tmp)
+
+	for _, file := range testFiles {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			return false, fmt.Errorf("테스트 파일 읽기 실패: %w", err)
+		}
+		target := filepath.Join(tmp, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return false, fmt.Errorf("테스트 파일 복사 실패: %w", err)
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			return false, fmt.Errorf("테스트 파일 복사 실패: %w", err)
+		}
+	}
+	_, err = c.run(ctx, tmp, c.config.TestCommand)
+	return err != nil, nil
+}
+
+// runShell은 dir에서 command를 셸로 실행하고 출력(stdout+stderr)을 반환합니다.
+func runShell(ctx context.Context, dir, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTDDCommandTimeout)
+	defer cancel()
+	cmd := shellCommand(ctx, command)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+// tailLines는 s의 마지막 n줄을 반환합니다.
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// checkTDD는 완료 보고된 변경이 TDD 규칙을 지키는지 점검합니다.
+// 위반이고 retry 방식이면 에이전트에 수정을 요청하고 true를 반환합니다.
+// 재시도하지 않으면 위반을 ClickUp 댓글로 남기고 완료 보고에 표시하도록 기록합니다. 점검 실패는 완료를 막지 않습니다.
+func (w *Worker) checkTDD(ctx context.Context) (bool, error) {
+	if w.tdd == nil {
+		return false, nil
+	}
+	w.mu.Lock()
+	base, taskID, retries := w.baseCommit, w.currentTaskID, w.tddRetries
+	w.tddViolations = nil
+	w.mu.Unlock()
+	if base == "" {
+		fmt.Printf("[%s] ⚠️ 시작 커밋을 몰라 TDD 점검 생략\n", w.config.ID)
+		return false, nil
+	}
+
+	report, err := w.tdd.Check(ctx, w.config.SrcPath, base)
+	if err != nil {
+		fmt.Printf("[%s] ⚠️ TDD 점검 실패 (점검 생략): %v\n", w.config.ID, err)
+		return false, nil
+	}
+	if report.OK() {
+		fmt.Printf("[%s] TDD 점검 통과 (테스트 파일 %d개)\n", w.config.ID, len(report.TestFiles))
+		return false, nil
+	}
+
+	if w.tdd.config.Action == TDDActionRetry && retries < w.tdd.config.MaxRetries {
+		w.mu.Lock()
+		w.tddRetries++
+		w.mu.Unlock()
+		reason := fmt.Sprintf("TDD 규칙 위반 %d/%d: %s", retries+1, w.tdd.config.MaxRetries, report.Violations[0])
+		if _, err := w.Transition(ctx, StateExecuting, reason); err != nil {
+			return true, err
+		}
+		return true, w.resumeAgent(ctx, buildTDDFeedback(report))
+	}
+
+	fmt.Printf("[%s] ⚠️ TDD 규칙 위반: %s\n", w.config.ID, strings.Join(report.Violations, "; "))
+	w.mu.Lock()
+	w.tddViolations = report.Violations
+	w.mu.Unlock()
+	if commenter, ok := w.clickupClient.(TaskCommenter); ok {
+		if err := commenter.AddComment(ctx, taskID, formatTDDViolations(report.Violations)); err != nil {
+			fmt.Printf("[%s] ⚠️ TDD 위반 댓글 작성 실패: %v\n", w.config.ID, err)
+		}
+	}
+	return false, nil
+}
+
+// buildTDDFeedback은 TDD 위반을 구현 에이전트에 보낼 수정 요청 프롬프트로 만듭니다.
+func buildTDDFeedback(report *TDDReport) string {
+	var b strings.Builder
+	b.WriteString("# TDD 규칙 위반\n\n완료 보고한 변경이 TDD 규칙을 지키지 않았습니다. 아래 항목을 해결한 뒤 다시 완료를 보고하세요.\n\n")
+	for _, violation := range report.Violations {
+		b.WriteString("- " + violation + "\n")
+	}
+	return b.String()
+}
+
+// formatTDDViolations는 TDD 위반을 완료 보고/댓글용 문구로 만듭니다.
+func formatTDDViolations(violations []string) string {
+	return "⚠️ TDD 규칙 위반\n- " + strings.Join(violations, "\n- ")
+}
@@ -0,0 +1,198 @@
+package aiworker
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TestParseTDDAction은 TDD 위반 처리 방식 파싱을 테스트합니다.
+func TestParseTDDAction(t *testing.T) {
+	if a, err := ParseTDDAction(""); err != nil || a != TDDActionFlag {
+		t.Errorf("빈 값은 flag여야 함: %s, %v", a, err)
+	}
+	if a, err := ParseTDDAction(" Retry "); err != nil || a != TDDActionRetry {
+		t.Errorf("retry 파싱 실패: %s, %v", a, err)
+	}
+	if _, err := ParseTDDAction("block"); err == nil {
+		t.Error("알 수 없는 방식은 에러여야 함")
+	}
+}
+
+// TestTDDChecker_IsTestFile은 파일 이름/경로 패턴 매칭을 테스트합니다.
+func TestTDDChecker_IsTestFile(t *testing.T) {
+	c := NewTDDChecker(TDDConfig{TestPatterns: []string{"*_test.go", "tests/*"}})
+	tests := map[string]bool{
+		"internal/a/worker_test.go": true,
+		"worker.go":                 false,
+		"tests/login.py":            true,
+		"src/tests/login.py":        false,
+	}
+	for file, want := range tests {
+		if got := c.IsTestFile(file); got != want {
+			t.Errorf("%s: got %v, want %v", file, got, want)
+		}
+	}
+}
+
+// TestTDDChecker_Check는 테스트 파일 포함, 결과 통과, 시작 커밋 실패 점검을 테스트합니다.
+func TestTDDChecker_Check(t *testing.T) {
+	dir := initTestRepo(t)
+	ctx := context.Background()
+	base, _ := runGit(ctx, dir, "rev-parse", "HEAD")
+	c := NewTDDChecker(TDDConfig{TestPatterns: []string{"*_test.sh"}, TestCommand: "sh check_test.sh", VerifyBase: true})
+
+	if report, err := c.Check(ctx, dir, base); err != nil || !report.OK() {
+		t.Fatalf("변경이 없으면 통과여야 함: %+v, %v", report, err)
+	}
+
+	// 테스트 파일 없이 구현만 변경
+	writeFile(t, filepath.Join(dir, "a.txt"), "hello\nworld\n")
+	report, err := c.Check(ctx, dir, base)
+	if err != nil || len(report.Violations) != 1 || !strings.Contains(report.Violations[0], "테스트 파일이 없음") {
+		t.Fatalf("테스트 파일 누락 위반이어야 함: %+v, %v", report, err)
+	}
+
+	// 시작 커밋에서 실패하고 결과에서 통과하는 테스트
+	writeFile(t, filepath.Join(dir, "check_test.sh"), "grep -q world a.txt\n")
+	report, err = c.Check(ctx, dir, base)
+	if err != nil || !report.OK() || len(report.TestFiles) != 1 {
+		t.Fatalf("TDD 규칙을 지키면 통과여야 함: %+v, %v", report, err)
+	}
+
+	// 시작 커밋에서도 통과하는 테스트
+	writeFile(t, filepath.Join(dir, "check_test.sh"), "grep -q hello a.txt\n")
+	report, err = c.Check(ctx, dir, base)
+	if err != nil || len(report.Violations) != 1 || !strings.Contains(report.Violations[0], "시작 커밋에서도 통과") {
+		t.Fatalf("시작 커밋 통과 위반이어야 함: %+v, %v", report, err)
+	}
+
+	// 결과에서 실패하는 테스트
+	writeFile(t, filepath.Join(dir, "check_test.sh"), "grep -q missing a.txt\n")
+	report, err = c.Check(ctx, dir, base)
+	if err != nil || len(report.Violations) != 1 || !strings.Contains(report.Violations[0], "결과에서 테스트 실패") {
+		t.Fatalf("결과 테스트 실패 위반이어야 함: %+v, %v", report, err)
+	}
+
+	if list, _ := runGit(ctx, dir, "worktree", "list"); strings.Count(list, "\n") != 0 {
+		t.Errorf("임시 worktree는 제거되어야 함:\n%s", list)
+	}
+}
+
+// TestWorker_CompleteTask_TDD는 TDD 위반 시 수정 요청 후 한도를 넘으면 완료 보고와 댓글로 표시하는지 테스트합니다.
+func TestWorker_CompleteTask_TDD(t *testing.T) {
+	dir := initTestRepo(t)
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크"}}}
+	invoker := &OptionMockInvoker{}
+	config := WorkerConfig{
+		ID:      "AI_01",
+		ListID:  "list1",
+		SrcPath: dir,
+		TDD:     &TDDConfig{TestPatterns: []string{"*_test.go"}, Action: TDDActionRetry},
+	}
+	worker := NewWorker(config, mockClient, invoker, "작업중", "개발완료", "")
+	var completed string
+	worker.OnEnter(StateCompleted, func(ctx context.Context, t Transition) { completed = t.Reason })
+
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "login.go"), "package login\n")
+	if err := worker.MarkExecuting(ctx, "계획 승인"); err != nil {
+		t.Fatalf("실행 전이 실패: %v", err)
+	}
+
+	// 1차 위반 → 구현 세션에 수정 요청
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("완료 보고 처리 실패: %v", err)
+	}
+	if worker.TaskState() != StateExecuting || !invoker.LastOptions.Continue {
+		t.Fatalf("위반 시 수정을 요청해야 함: %s, %+v", worker.TaskState(), invoker.LastOptions)
+	}
+	if !strings.Contains(invoker.LastPrompt, "테스트 파일이 없음") {
+		t.Errorf("위반 내용이 전달되어야 함:\n%s", invoker.LastPrompt)
+	}
+
+	// 재시도 한도 초과 → 완료하되 위반 표시
+	if err := worker.CompleteTask(ctx); err != nil {
+		t.Fatalf("재완료 보고 처리 실패: %v", err)
+	}
+	if worker.IsProcessing() {
+		t.Fatal("한도를 넘으면 완료 처리되어야 함")
+	}
+	if !strings.Contains(completed, "TDD 규칙 위반") {
+		t.Errorf("완료 보고에 위반이 표시되어야 함: %q", completed)
+	}
+	if len(mockClient.Comments) != 1 || mockClient.Comments[0].TaskID != "task1" || !strings.Contains(mockClient.Comments[0].Text, "테스트 파일이 없음") {
+		t.Errorf("ClickUp 댓글로 위반을 남겨야 함: %+v", mockClient.Comments)
+	}
+}
+
+// TestWorker_SignalCompletion_Verifying은 완료 확인(테스트 실행) 중 들어온 완료 신호가 중복 점검/완료를 일으키지 않는지 테스트합니다.
+func TestWorker_SignalCompletion_Verifying(t *testing.T) {
+	dir := initTestRepo(t)
+	mockClient := &MockClickUpClient{Tasks: []*clickup.Task{{ID: "task1", Name: "태스크"}}}
+	config := WorkerConfig{
+		ID:      "AI_01",
+		ListID:  "list1",
+		SrcPath: dir,
+		TDD:     &TDDConfig{TestPatterns: []string{"*_test.go"}, TestCommand: "go test ./..."},
+	}
+	worker := NewWorker(config, mockClient, &OptionMockInvoker{}, "작업중", "개발완료", "")
+
+	started, release := make(chan struct{}, 2), make(chan struct{})
+	runs := 0
+	worker.tdd.run = func(ctx context.Context, dir, command string) (string, error) {
+		runs++
+		started <- struct{}{}
+		<-release
+		return "", nil
+	}
+
+	ctx := context.Background()
+	if err := worker.ProcessTask(ctx, "task1"); err != nil {
+		t.Fatalf("태스크 처리 실패: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "login_test.go"), "package login\n")
+
+	done := make(chan error, 2)
+	if err := worker.SignalCompletion(ctx, "Claude 명시적 완료", func(err error) { done <- err }); err != nil {
+		t.Fatalf("완료 신호 처리 실패: %v", err)
+	}
+	<-started
+
+	// 테스트 실행 중 두 번째 완료 신호 (acceptEdits Stop)
+	if err := worker.SignalCompletion(ctx, "acceptEdits 모드 Stop", func(err error) { done <- err }); !errors.Is(err, ErrVerifyInProgress) {
+		t.Errorf("확인 중 완료 신호는 ErrVerifyInProgress여야 함: %v", err)
+	}
+	if worker.TaskState() != StateVerifying {
+		t.Errorf("확인 단계를 유지해야 함: %s", worker.TaskState())
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("완료 처리 실패: %v", err)
+	}
+	if runs != 1 {
+		t.Errorf("테스트는 한 번만 실행되어야 함: %d", runs)
+	}
+	completed := 0
+	for _, update := range mockClient.StatusUpdates {
+		if update.Status == "개발완료" {
+			completed++
+		}
+	}
+	if completed != 1 || worker.IsProcessing() {
+		t.Errorf("완료 처리는 한 번만 되어야 함: %+v", mockClient.StatusUpdates)
+	}
+	select {
+	case err := <-done:
+		t.Errorf("거부된 신호의 완료 처리가 실행됨: %v", err)
+	default:
+	}
+}
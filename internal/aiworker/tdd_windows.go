@@ -0,0 +1,13 @@
+//go:build windows
+
+package aiworker
+
+import (
+	"context"
+	"os/exec"
+)
+
+// shellCommand는 command를 셸(cmd /C)로 실행하는 명령을 만듭니다.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "cmd", "/C", command)
+}
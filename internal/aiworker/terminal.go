@@ -3,6 +3,7 @@ package aiworker
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 // TerminalHandler는 터미널 종류별 작업을 처리하는 인터페이스입니다.
@@ -22,6 +23,12 @@ type TerminalHandler interface {
 	Terminate(workerID string) error
 }
 
+// SessionChecker는 Worker ID로 터미널 세션이 살아있는지 확인할 수 있는 핸들러가 구현합니다.
+// Warp처럼 AppleScript로 창을 식별할 수 없는 터미널은 구현하지 않습니다.
+type SessionChecker interface {
+	IsSessionAlive(workerID string) (bool, error)
+}
+
 // GetTerminalHandler는 터미널 타입에 맞는 핸들러를 반환합니다.
 func GetTerminalHandler(terminalType TerminalType) TerminalHandler {
 	switch terminalType {
@@ -83,6 +90,25 @@ func (h *DefaultTerminalHandler) Terminate(workerID string) error {
 	return nil
 }
 
+// BuildSessionCheckScript는 custom title이 workerID인 창이 있으면 "true"를 반환하는 AppleScript를 생성합니다.
+func (h *DefaultTerminalHandler) BuildSessionCheckScript(workerID string) string {
+	return fmt.Sprintf(`
+tell application "Terminal"
+	repeat with w in every window
+		try
+			if custom title of selected tab of w is "%s" then return "true"
+		end try
+	end repeat
+end tell
+return "false"
+`, workerID)
+}
+
+// IsSessionAlive는 Worker ID 창이 열려 있는지 확인합니다.
+func (h *DefaultTerminalHandler) IsSessionAlive(workerID string) (bool, error) {
+	return runSessionCheck(h.BuildSessionCheckScript(workerID))
+}
+
 // WarpTerminalHandler는 Warp 터미널 핸들러입니다.
 type WarpTerminalHandler struct{}
 
@@ -169,3 +195,33 @@ func (h *ITermTerminalHandler) Terminate(workerID string) error {
 	}
 	return nil
 }
+
+// BuildSessionCheckScript는 이름이 workerID인 세션이 있으면 "true"를 반환하는 AppleScript를 생성합니다.
+func (h *ITermTerminalHandler) BuildSessionCheckScript(workerID string) string {
+	return fmt.Sprintf(`
+tell application "iTerm"
+	repeat with w in windows
+		repeat with t in tabs of w
+			repeat with s in sessions of t
+				if name of s is "%s" then return "true"
+			end repeat
+		end repeat
+	end repeat
+end tell
+return "false"
+`, workerID)
+}
+
+// IsSessionAlive는 Worker ID 세션이 열려 있는지 확인합니다.
+func (h *ITermTerminalHandler) IsSessionAlive(workerID string) (bool, error) {
+	return runSessionCheck(h.BuildSessionCheckScript(workerID))
+}
+
+// runSessionCheck는 세션 확인 AppleScript를 실행하고 결과를 반환합니다.
+func runSessionCheck(script string) (bool, error) {
+	out, err := exec.Command("osascript", "-e", script).Output()
+	if err != nil {
+		return false, fmt.Errorf("세션 확인 실패: %w", err)
+	}
+	return strings.TrimSpace(string(out)) == "true", nil
+}
@@ -2,11 +2,14 @@ package aiworker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
 	"github.com/zime/slickwebhook/internal/clickup"
 	"github.com/zime/slickwebhook/internal/issueformatter"
 )
@@ -20,6 +23,10 @@ type ClickUpClientInterface interface {
 	MoveTaskToList(ctx context.Context, taskID, listID string) error
 }
 
+// ErrRunCancelled는 태스크 준비 중 취소/중단되어 처리를 그만둔 경우의 에러입니다.
+// 취소 처리에서 상태 롤백과 슬롯 반환을 이미 마쳤으므로 호출자는 정리하지 않습니다.
+var ErrRunCancelled = errors.New("태스크 처리가 취소됨")
+
 // Worker는 단일 AI 리스트를 담당하는 워커입니다.
 type Worker struct {
 	config          WorkerConfig
@@ -28,17 +35,42 @@ type Worker struct {
 	formatter       issueformatter.Formatter
 	statusWorking   string
 	statusCompleted string
-	completedListID string       // 완료된 태스크 이동 목표 리스트 ID
-	terminalType    TerminalType // 사용할 터미널 종류
+	completedListID string          // 완료된 태스크 이동 목표 리스트 ID
+	terminalType    TerminalType    // 사용할 터미널 종류
+	stateStore      StateStore      // 상태 저장소 (nil이면 저장 안함)
+	lifecycle       *StateMachine   // 태스크 수명 주기 상태 머신
+	pooled          bool            // 리스트를 다른 Worker와 공유하는 풀 Worker 여부
+	claimSettle     time.Duration   // ClickUp 선점 확인 전 대기 시간
+	preflight       *Preflight      // 태스크 시작 전 사전 점검 (nil이면 점검 안함)
+	gitPrep         *GitPrep        // 태스크 시작 전 git 준비 / 종료 후 정리 (nil이면 안함)
+	artifacts       *ArtifactConfig // 완료 시 패치 저장/첨부 설정 (nil이면 안함)
+	review          *ReviewConfig   // 완료 전 리뷰 설정 (nil이면 안함)
+	tdd             *TDDChecker     // 완료 시 TDD 규칙 점검 (nil이면 안함)
+	patches         *PatchBuilder
 
 	// 상태 관리
 	mu              sync.Mutex
 	processing      bool
+	runID           uint64 // 처리 시작/종료마다 증가 (준비 중 취소 감지용)
 	currentTaskID   string
 	currentTaskName string // Slack 알림용 태스크 이름
 	currentJiraID   string // Slack 알림용 Jira 이슈 ID
 	originalStatus  string // 취소 시 롤백을 위한 원래 상태
 	srcPath         string // 현재 작업 디렉토리 (터미널 종료용)
+	startedAt       time.Time
+	runOptions      RunOptions // 현재 태스크의 실행 모델/모드 (태스크 오버라이드 반영)
+	taskBranch      string     // git 준비로 만든 태스크 브랜치
+	gitPrepared     bool       // git 준비 완료 여부 (종료 시 정리 대상)
+	baseCommit      string     // 에이전트 실행 전 커밋 (패치 기준)
+	reviewRounds    int        // 리뷰 수정 요청 횟수
+	tddRetries      int        // TDD 위반 수정 요청 횟수
+	tddViolations   []string   // 완료 보고에 표시할 TDD 위반
+
+	// 진행 상황 (heartbeat) 관리
+	progress          []ProgressEntry // 에이전트가 보고한 진행 단계 목록
+	lastHeartbeat     time.Time       // 마지막 heartbeat 시각 (정체 감지용)
+	progressMessageTS string          // 진행 상황 Slack 메시지 타임스탬프 (chat.update용)
+	slackThreadTS     string          // 태스크 Slack 스레드 부모 메시지 타임스탬프
 }
 
 // NewWorker는 새 Worker를 생성합니다.
@@ -48,7 +80,7 @@ func NewWorker(
 	invoker ClaudeInvoker,
 	statusWorking, statusCompleted, completedListID string,
 ) *Worker {
-	return &Worker{
+	w := &Worker{
 		config:          config,
 		clickupClient:   clickupClient,
 		invoker:         invoker,
@@ -56,7 +88,48 @@ func NewWorker(
 		statusCompleted: statusCompleted,
 		completedListID: completedListID,
 		terminalType:    TerminalTypeDefault,
+		lifecycle:       NewStateMachine(StateIdle),
+		claimSettle:     ClaimSettleDelay,
+	}
+	w.lifecycle.SetLogf(func(format string, args ...interface{}) {
+		fmt.Printf("[%s] "+format+"\n", append([]interface{}{config.ID}, args...)...)
+	})
+	if config.Preflight != nil {
+		w.preflight = NewPreflight(*config.Preflight)
+	}
+	if config.GitPrep != nil {
+		w.gitPrep = NewGitPrep(*config.GitPrep)
+		for _, state := range []TaskState{StateCompleted, StateFailed, StateCancelled} {
+			w.OnEnter(state, w.cleanupGit)
+		}
+	}
+	if config.Artifacts != nil {
+		artifacts := *config.Artifacts
+		if artifacts.MaxUploadBytes <= 0 {
+			artifacts.MaxUploadBytes = DefaultArtifactMaxUpload
+		}
+		w.artifacts = &artifacts
+		w.patches = NewPatchBuilder()
+	}
+	if config.Review != nil {
+		review := *config.Review
+		if review.MaxRounds <= 0 {
+			review.MaxRounds = DefaultReviewMaxRounds
+		}
+		if review.MaxDiffBytes <= 0 {
+			review.MaxDiffBytes = DefaultReviewMaxDiff
+		}
+		w.review = &review
+		w.patches = NewPatchBuilder()
+		for _, state := range []TaskState{StateFailed, StateCancelled} {
+			w.OnEnter(state, w.closeReviewer)
+		}
+	}
+	if config.TDD != nil {
+		w.tdd = NewTDDChecker(*config.TDD)
+		w.patches = NewPatchBuilder()
 	}
+	return w
 }
 
 // SetTerminalType은 터미널 타입을 설정합니다.
@@ -69,6 +142,12 @@ func (w *Worker) SetInvoker(invoker ClaudeInvoker) {
 	w.invoker = invoker
 }
 
+// SetStateStore는 상태 저장소를 설정합니다.
+// 설정되면 처리 시작/종료, 스레드 생성 등 상태가 바뀔 때마다 저장합니다.
+func (w *Worker) SetStateStore(store StateStore) {
+	w.stateStore = store
+}
+
 // SetFormatter는 이슈 포맷터를 설정합니다.
 func (w *Worker) SetFormatter(formatter issueformatter.Formatter) {
 	w.formatter = formatter
@@ -76,14 +155,37 @@ func (w *Worker) SetFormatter(formatter issueformatter.Formatter) {
 
 // ProcessTask는 단일 태스크를 처리합니다.
 func (w *Worker) ProcessTask(ctx context.Context, taskID string) error {
-	// 태스크 조회
+	task, err := w.fetchTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return w.processTask(ctx, task, w.resolveRunOptions(task))
+}
+
+// fetchTask는 처리할 태스크를 조회합니다.
+func (w *Worker) fetchTask(ctx context.Context, taskID string) (*clickup.Task, error) {
 	task, err := w.clickupClient.GetTask(ctx, taskID)
 	if err != nil {
-		return fmt.Errorf("태스크 조회 실패: %w", err)
+		return nil, fmt.Errorf("태스크 조회 실패: %w", err)
 	}
 	if task == nil {
-		return fmt.Errorf("태스크를 찾을 수 없음: %s", taskID)
+		return nil, fmt.Errorf("태스크를 찾을 수 없음: %s", taskID)
 	}
+	return task, nil
+}
+
+// resolveRunOptions는 태스크 태그/커스텀 필드의 모델/모드 오버라이드를 리스트 정책에 따라 적용합니다.
+func (w *Worker) resolveRunOptions(task *clickup.Task) RunOptions {
+	opts, rejected := ResolveRunOptions(w.config, task)
+	for _, reason := range rejected {
+		fmt.Printf("[%s] ⚠️ 태스크 오버라이드 무시: %s\n", w.config.ID, reason)
+	}
+	return opts
+}
+
+// processTask는 조회한 태스크를 opts의 모델/모드로 처리합니다.
+func (w *Worker) processTask(ctx context.Context, task *clickup.Task, opts RunOptions) error {
+	taskID := task.ID
 
 	// 원래 상태 저장 (롤백용)
 	originalStatus := task.Status.Status
@@ -91,16 +193,61 @@ func (w *Worker) ProcessTask(ctx context.Context, taskID string) error {
 	// Description에서 Jira 이슈 ID 추출
 	jiraID := extractJiraID(task.Description)
 
-	// 처리 상태 설정 (태스크 ID, 이름, Jira ID, 원래 상태)
-	w.SetProcessing(taskID, task.Name, jiraID, originalStatus)
+	// 사전 점검 (실패하면 태스크를 변경하지 않음)
+	if err := w.runPreflight(ctx, task, opts.Model); err != nil {
+		return err
+	}
+
+	// 풀 Worker는 ClickUp에서 태스크를 선점 (작업중 상태 + 담당자)
+	claimed, err := w.claimTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
 
-	// 상태를 "작업중"으로 변경
-	if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, w.statusWorking); err != nil {
+	// 처리 상태 설정 (태스크 ID, 이름, Jira ID, 원래 상태)
+	// 이후 ClickUp 변경 전마다 run으로 준비 중 취소(Cancel/Abort) 여부를 확인
+	run := w.SetProcessing(taskID, task.Name, jiraID, originalStatus)
+	w.setRunOptions(opts)
+	if _, err := w.Transition(ctx, StatePreparing, "태스크 준비"); err != nil {
+		if claimed {
+			w.releaseTaskClaim(ctx, taskID, originalStatus)
+		}
 		w.ClearProcessing()
-		return fmt.Errorf("상태 변경 실패: %w", err)
+		return err
+	}
+
+	// 작업 경로 git 준비 (기준 브랜치 최신화, 태스크 브랜치 생성)
+	// 선점한 태스크는 담당자와 원래 상태를 되돌려 다른 Worker가 다시 가져갈 수 있게 함
+	if err := w.prepareGit(ctx, jiraID, taskID); err != nil {
+		if !w.isRunActive(run) {
+			return ErrRunCancelled
+		}
+		if claimed {
+			w.releaseTaskClaim(ctx, taskID, originalStatus)
+		}
+		w.Fail(ctx, err.Error())
+		return err
+	}
+	if !w.isRunActive(run) {
+		// 준비 중 취소되어 종료 훅의 정리 대상에서 빠진 작업 트리 정리
+		w.cleanupGit(ctx, Transition{To: StateCancelled})
+		return ErrRunCancelled
+	}
+	w.recordBaseCommit(ctx)
+
+	// 상태를 "작업중"으로 변경 (선점했으면 이미 변경됨)
+	if !claimed {
+		if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, w.statusWorking); err != nil {
+			err = fmt.Errorf("상태 변경 실패: %w", err)
+			w.Fail(ctx, err.Error())
+			return err
+		}
 	}
 
 	// 시작 날짜 설정
+	if !w.isRunActive(run) {
+		return ErrRunCancelled
+	}
 	now := time.Now()
 	if err := w.clickupClient.UpdateTaskDates(ctx, taskID, &now, nil); err != nil {
 		fmt.Printf("[%s] ⚠️ 시작 날짜 설정 실패: %v\n", w.config.ID, err)
@@ -109,10 +256,33 @@ func (w *Worker) ProcessTask(ctx context.Context, taskID string) error {
 	// 프롬프트 생성
 	prompt := w.buildPrompt(ctx, task)
 
-	// Claude Code 실행 (Worker ID 전달)
-	_, err = w.invoker.InvokePlan(ctx, w.config.SrcPath, prompt, w.config.ID)
+	// Claude Code 실행 (Worker ID 전달, plan 모드로 시작)
+	if _, err := w.Transition(ctx, StatePlanning, "에이전트 실행"); err != nil {
+		if !w.isRunActive(run) {
+			return ErrRunCancelled
+		}
+		// 작업중으로 바꾼 상태와 선점을 되돌린 뒤 실패 처리
+		if claimed {
+			w.releaseTaskClaim(ctx, taskID, originalStatus)
+		} else if rbErr := w.clickupClient.UpdateTaskStatus(ctx, taskID, originalStatus); rbErr != nil {
+			fmt.Printf("[%s] ⚠️ 상태 롤백 실패: %v\n", w.config.ID, rbErr)
+		}
+		w.Fail(ctx, err.Error())
+		return err
+	}
+	prompt += opts.Mode.PromptSuffix()
+	if !w.isRunActive(run) {
+		return ErrRunCancelled
+	}
+	if invoker, ok := w.invoker.(OptionInvoker); ok {
+		_, err = invoker.Invoke(ctx, w.config.SrcPath, prompt, w.config.ID, opts)
+	} else {
+		_, err = w.invoker.InvokePlan(ctx, w.config.SrcPath, prompt, w.config.ID)
+	}
 	if err != nil {
-		return fmt.Errorf("Claude Code 실행 실패: %w", err)
+		err = fmt.Errorf("Claude Code 실행 실패: %w", err)
+		w.Fail(ctx, err.Error())
+		return err
 	}
 
 	return nil
@@ -133,7 +303,35 @@ func (w *Worker) buildPrompt(ctx context.Context, task *clickup.Task) string {
 }
 
 // CompleteTask는 태스크 완료 처리를 수행합니다.
+// 실행 단계에서 확인(Verifying)을 거쳐 완료 상태로 전이하며, ClickUp 처리에 실패하면 실패 상태로 전이합니다.
 func (w *Worker) CompleteTask(ctx context.Context) error {
+	if err := w.startVerifying(ctx); err != nil {
+		return err
+	}
+	return w.verifyTask(ctx)
+}
+
+// SignalCompletion은 에이전트의 완료 신호(Stop Hook, 완료 보고)로 확인 단계에 들어간 뒤
+// 나머지 완료 처리(TDD 점검, 리뷰, ClickUp 처리)를 백그라운드에서 실행하고 done으로 결과를 알립니다.
+// 테스트 실행은 오래 걸릴 수 있어 Hook 요청을 붙잡지 않으며, 확인/리뷰 중에 들어온 신호는 에러로 거부합니다.
+func (w *Worker) SignalCompletion(ctx context.Context, signal string, done func(err error)) error {
+	if err := w.MarkExecuting(ctx, signal); err != nil {
+		return err
+	}
+	if err := w.startVerifying(ctx); err != nil {
+		return err
+	}
+	go func() {
+		err := w.verifyTask(ctx)
+		if done != nil {
+			done(err)
+		}
+	}()
+	return nil
+}
+
+// startVerifying은 완료 보고로 확인 단계에 들어갑니다. 이미 확인 중이면 ErrVerifyInProgress를 반환합니다.
+func (w *Worker) startVerifying(ctx context.Context) error {
 	w.mu.Lock()
 	taskID := w.currentTaskID
 	w.mu.Unlock()
@@ -142,9 +340,43 @@ func (w *Worker) CompleteTask(ctx context.Context) error {
 		return fmt.Errorf("처리 중인 태스크가 없음")
 	}
 
+	if _, err := w.Transition(ctx, StateVerifying, "완료 보고"); err != nil {
+		if w.TaskState() == StateVerifying {
+			return ErrVerifyInProgress
+		}
+		return err
+	}
+	return nil
+}
+
+// verifyTask는 확인 단계의 태스크를 점검한 뒤 완료 처리합니다.
+func (w *Worker) verifyTask(ctx context.Context) error {
+	// TDD 규칙 위반이면 에이전트에 수정 요청 (retry) 또는 완료 보고에 표시 (flag)
+	if resumed, err := w.checkTDD(ctx); resumed || err != nil {
+		return err
+	}
+
+	// 리뷰가 설정되어 있으면 리뷰 판정 후 완료 처리 (ReviewVerdict)
+	if started, err := w.startReview(ctx); started || err != nil {
+		return err
+	}
+	return w.finishTask(ctx)
+}
+
+// finishTask는 확인 단계의 태스크를 완료 처리합니다. (패치 첨부, 상태 변경, 리스트 이동)
+func (w *Worker) finishTask(ctx context.Context) error {
+	w.mu.Lock()
+	taskID := w.currentTaskID
+	w.mu.Unlock()
+
+	// 시작 커밋 대비 패치를 결과물로 저장하고 태스크에 첨부 (실패해도 계속)
+	w.savePatch(ctx)
+
 	// 상태를 "개발완료"로 변경
 	if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, w.statusCompleted); err != nil {
-		return fmt.Errorf("완료 상태 변경 실패: %w", err)
+		err = fmt.Errorf("완료 상태 변경 실패: %w", err)
+		w.Fail(ctx, "완료 처리 실패: "+err.Error())
+		return err
 	}
 
 	// 종료 날짜 설정
@@ -157,13 +389,25 @@ func (w *Worker) CompleteTask(ctx context.Context) error {
 	if w.completedListID != "" {
 		fmt.Printf("[%s] 리스트 이동 시도: 태스크=%s, 목표리스트=%s\n", w.config.ID, taskID, w.completedListID)
 		if err := w.clickupClient.MoveTaskToList(ctx, taskID, w.completedListID); err != nil {
-			return fmt.Errorf("완료 리스트 이동 실패: %w", err)
+			err = fmt.Errorf("완료 리스트 이동 실패: %w", err)
+			w.Fail(ctx, "완료 처리 실패: "+err.Error())
+			return err
 		}
 		fmt.Printf("[%s] 리스트 이동 성공\n", w.config.ID)
 	} else {
 		fmt.Printf("[%s] completedListID가 비어있어 리스트 이동 생략\n", w.config.ID)
 	}
 
+	reason := "AI 작업이 완료되었습니다."
+	w.mu.Lock()
+	if len(w.tddViolations) > 0 {
+		reason += "\n" + formatTDDViolations(w.tddViolations)
+	}
+	w.mu.Unlock()
+	if _, err := w.Transition(ctx, StateCompleted, reason); err != nil {
+		return err
+	}
+
 	// 처리 상태 클리어
 	w.ClearProcessing()
 
@@ -177,30 +421,63 @@ func (w *Worker) IsProcessing() bool {
 	return w.processing
 }
 
-// SetProcessing은 처리 상태를 설정합니다.
-func (w *Worker) SetProcessing(taskID, taskName, jiraID, originalStatus string) {
+// SetProcessing은 처리 상태를 설정하고 이번 실행의 식별자를 반환합니다.
+func (w *Worker) SetProcessing(taskID, taskName, jiraID, originalStatus string) uint64 {
 	w.mu.Lock()
+	defer w.persist()
 	defer w.mu.Unlock()
 	w.processing = true
+	w.runID++
+	w.startedAt = time.Now()
 	w.currentTaskID = taskID
 	w.currentTaskName = taskName
 	w.currentJiraID = jiraID
 	w.originalStatus = originalStatus
+	w.progress = nil
+	w.lastHeartbeat = time.Now() // 시작 시점을 첫 heartbeat로 간주
+	w.progressMessageTS = ""
+	w.slackThreadTS = ""
+	w.reviewRounds = 0
+	w.tddRetries = 0
+	w.tddViolations = nil
+	w.lifecycle.Reset(StateQueued)
+	return w.runID
+}
+
+// isRunActive는 run이 취소/종료되지 않은 현재 실행인지 확인합니다.
+func (w *Worker) isRunActive(run uint64) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.processing && w.runID == run
 }
 
 // ClearProcessing은 처리 상태를 클리어합니다.
 func (w *Worker) ClearProcessing() {
 	w.mu.Lock()
+	defer w.persist()
 	defer w.mu.Unlock()
 	w.processing = false
+	w.runID++
+	w.startedAt = time.Time{}
+	w.runOptions = RunOptions{}
 	w.currentTaskID = ""
 	w.currentTaskName = ""
 	w.currentJiraID = ""
 	w.originalStatus = ""
+	w.baseCommit = ""
+	w.reviewRounds = 0
+	w.tddRetries = 0
+	w.tddViolations = nil
+	w.progress = nil
+	w.lastHeartbeat = time.Time{}
+	w.progressMessageTS = ""
+	w.slackThreadTS = ""
+	w.lifecycle.Reset(StateIdle)
 }
 
-// RollbackStatus는 취소 시 태스크 상태를 원래 상태로 되돌립니다.
-func (w *Worker) RollbackStatus(ctx context.Context) error {
+// Cancel은 사용자 취소로 작업을 중단하고 태스크 상태를 원래 상태로 되돌립니다.
+// 롤백 실패는 취소 사유에 덧붙여 알립니다.
+func (w *Worker) Cancel(ctx context.Context, reason string) error {
 	w.mu.Lock()
 	taskID := w.currentTaskID
 	originalStatus := w.originalStatus
@@ -210,21 +487,117 @@ func (w *Worker) RollbackStatus(ctx context.Context) error {
 		return nil // 처리 중인 태스크 없음
 	}
 
-	if originalStatus == "" {
-		w.ClearProcessing()
-		return nil // 원래 상태 미저장
+	if originalStatus != "" {
+		if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, originalStatus); err != nil {
+			reason += " (상태 롤백 실패: " + err.Error() + ")"
+		} else {
+			reason += " (원래 상태로 롤백: " + originalStatus + ")"
+		}
 	}
 
-	// 원래 상태로 변경
-	if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, originalStatus); err != nil {
-		w.ClearProcessing()
-		return fmt.Errorf("상태 롤백 실패: %w", err)
+	if _, err := w.Transition(ctx, StateCancelled, reason); err != nil {
+		return err
+	}
+	w.ClearProcessing()
+	return nil
+}
+
+// Abort는 진행 중인 작업을 중단합니다.
+// 태스크가 삭제/이동/취소되어 더 이상 이 Worker의 것이 아닐 때 사용하며,
+// 에이전트를 종료하고 처리 상태를 클리어합니다. ClickUp 태스크는 변경하지 않습니다.
+func (w *Worker) Abort(ctx context.Context, reason string) error {
+	if !w.IsProcessing() {
+		return nil
+	}
+
+	termErr := w.TerminateClaude()
+	if termErr != nil {
+		termErr = fmt.Errorf("에이전트 종료 실패: %w", termErr)
+		reason += " (" + termErr.Error() + ")"
+	}
+
+	if _, err := w.Transition(ctx, StateCancelled, reason); err != nil {
+		return err
+	}
+	w.ClearProcessing()
+	return termErr
+}
+
+// Fail은 작업을 실패 상태로 전이하고 처리 상태를 클리어합니다.
+// 에이전트 실행 이후의 실패는 에이전트를 종료하고 태스크를 보류 상태로 바꾸어 폴링이 다시 가져가지 않도록 합니다.
+func (w *Worker) Fail(ctx context.Context, reason string) error {
+	w.mu.Lock()
+	taskID := w.currentTaskID
+	w.mu.Unlock()
+
+	t, err := w.Transition(ctx, StateFailed, reason)
+	if err != nil {
+		return err
+	}
+
+	if t.From != StateQueued && t.From != StatePreparing {
+		if err := w.TerminateClaude(); err != nil {
+			fmt.Printf("[%s] ⚠️ 에이전트 종료 실패: %v\n", w.config.ID, err)
+		}
+		if err := w.clickupClient.UpdateTaskStatus(ctx, taskID, StatusHold); err != nil {
+			fmt.Printf("[%s] ⚠️ 보류 상태 변경 실패: %v\n", w.config.ID, err)
+		}
 	}
 
 	w.ClearProcessing()
 	return nil
 }
 
+// Transition은 태스크 수명 주기 상태를 전이하고 상태를 저장합니다.
+// 허용되지 않는 전이는 로그를 남기고 거부합니다.
+func (w *Worker) Transition(ctx context.Context, to TaskState, reason string) (Transition, error) {
+	t, err := w.lifecycle.Transition(ctx, to, reason)
+	if err != nil {
+		return t, err
+	}
+	w.persist()
+	return t, nil
+}
+
+// TaskState는 현재 태스크 수명 주기 상태를 반환합니다.
+func (w *Worker) TaskState() TaskState {
+	return w.lifecycle.State()
+}
+
+// OnEnter는 state 진입 시 실행할 부수 효과를 등록합니다.
+// 훅은 처리 상태가 클리어되기 전에 실행되므로 현재 태스크 정보를 조회할 수 있습니다.
+func (w *Worker) OnEnter(state TaskState, hook TransitionHook) {
+	w.lifecycle.OnEnter(state, hook)
+}
+
+// PlanReady는 계획 수립 완료로 승인 대기 상태로 전이합니다.
+// 승인 대기나 Rate Limit 중에 다시 계획이 보고되면 계획 수정으로 보고 계획 단계를 거칩니다.
+func (w *Worker) PlanReady(ctx context.Context, title string) error {
+	switch w.TaskState() {
+	case StateAwaitingApproval, StateRateLimited:
+		if _, err := w.Transition(ctx, StatePlanning, "계획 수정"); err != nil {
+			return err
+		}
+	}
+	_, err := w.Transition(ctx, StateAwaitingApproval, title)
+	return err
+}
+
+// MarkExecuting은 계획 승인(또는 승인을 의미하는 신호) 후 실행 단계로 전이합니다.
+// 이미 실행 중이면 무시하고, 완료 확인 중이면 ErrVerifyInProgress, 리뷰 중이면 리뷰 판정 전까지 ErrReviewInProgress를 반환합니다.
+func (w *Worker) MarkExecuting(ctx context.Context, reason string) error {
+	switch w.TaskState() {
+	case StateExecuting:
+		return nil
+	case StateVerifying:
+		return ErrVerifyInProgress
+	case StateReviewing:
+		return ErrReviewInProgress
+	}
+	_, err := w.Transition(ctx, StateExecuting, reason)
+	return err
+}
+
 // GetOriginalStatus는 원래 상태를 반환합니다.
 func (w *Worker) GetOriginalStatus() string {
 	w.mu.Lock()
@@ -246,6 +619,28 @@ func (w *Worker) GetCurrentTaskName() string {
 	return w.currentTaskName
 }
 
+// setRunOptions는 현재 태스크의 실행 옵션을 설정합니다.
+func (w *Worker) setRunOptions(opts RunOptions) {
+	w.mu.Lock()
+	defer w.persist()
+	defer w.mu.Unlock()
+	w.runOptions = opts
+}
+
+// RunOptions는 현재 태스크의 실행 모델/모드를 반환합니다. 처리 중이 아니면 빈 값입니다.
+func (w *Worker) RunOptions() RunOptions {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.runOptions
+}
+
+// GetStartedAt은 현재 태스크 처리 시작 시각을 반환합니다. 처리 중이 아니면 zero 값입니다.
+func (w *Worker) GetStartedAt() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.startedAt
+}
+
 // GetConfig는 Worker 설정을 반환합니다.
 func (w *Worker) GetConfig() WorkerConfig {
 	return w.config
@@ -253,6 +648,7 @@ func (w *Worker) GetConfig() WorkerConfig {
 
 // GetPendingTasks는 리스트에서 대기 중인 태스크 목록을 조회합니다.
 // 완료 상태("개발완료", "배포(QA)", "취소", "완료됨(스토어)")의 태스크는 제외됩니다.
+// 풀 Worker는 다른 Worker가 선점한 작업중 태스크도 제외합니다.
 func (w *Worker) GetPendingTasks(ctx context.Context) ([]*clickup.Task, error) {
 	opts := &clickup.GetTasksOptions{
 		OrderBy: "created",
@@ -276,6 +672,9 @@ func (w *Worker) GetPendingTasks(ctx context.Context) ([]*clickup.Task, error) {
 	var pendingTasks []*clickup.Task
 	for _, task := range tasks {
 		status := task.Status.Status
+		if w.pooled && strings.EqualFold(status, w.statusWorking) {
+			continue
+		}
 		if !completedStatuses[status] {
 			pendingTasks = append(pendingTasks, task)
 		}
@@ -291,6 +690,84 @@ func (w *Worker) GetCurrentJiraID() string {
 	return w.currentJiraID
 }
 
+// SetSlackThreadTS는 현재 태스크의 Slack 스레드 타임스탬프를 저장합니다.
+// 처리 중이 아니면 무시합니다.
+func (w *Worker) SetSlackThreadTS(ts string) {
+	w.mu.Lock()
+	defer w.persist()
+	defer w.mu.Unlock()
+	if w.processing {
+		w.slackThreadTS = ts
+	}
+}
+
+// RestoreState는 저장된 상태로 처리 상태를 복원합니다. (재시작 후 실행 중인 에이전트에 재연결)
+func (w *Worker) RestoreState(state WorkerState) {
+	w.mu.Lock()
+	defer w.persist()
+	defer w.mu.Unlock()
+	w.processing = true
+	w.runID++
+	w.currentTaskID = state.TaskID
+	w.currentTaskName = state.TaskName
+	w.currentJiraID = state.JiraID
+	w.originalStatus = state.OriginalStatus
+	w.slackThreadTS = state.SlackThreadTS
+	w.startedAt = state.StartedAt
+	w.runOptions = RunOptions{Model: aimodel.AIModelType(state.Model), Mode: state.Mode}
+	if state.State == "" || state.State.IsTerminal() || state.State == StateIdle {
+		w.lifecycle.Reset(StatePlanning) // 상태가 기록되지 않은 경우 에이전트 실행 중으로 간주
+	} else {
+		w.lifecycle.Reset(state.State)
+	}
+	w.progress = nil
+	w.lastHeartbeat = time.Now()
+	w.progressMessageTS = ""
+}
+
+// State는 현재 상태 스냅샷을 반환합니다.
+func (w *Worker) State() WorkerState {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.stateLocked()
+}
+
+// stateLocked는 상태 스냅샷을 만듭니다. 호출자가 mu를 잡고 있어야 합니다.
+func (w *Worker) stateLocked() WorkerState {
+	return WorkerState{
+		WorkerID:       w.config.ID,
+		ListID:         w.config.ListID,
+		Processing:     w.processing,
+		TaskID:         w.currentTaskID,
+		TaskName:       w.currentTaskName,
+		JiraID:         w.currentJiraID,
+		OriginalStatus: w.originalStatus,
+		SlackThreadTS:  w.slackThreadTS,
+		Model:          string(w.runOptions.Model),
+		Mode:           w.runOptions.Mode,
+		State:          w.lifecycle.State(),
+		StartedAt:      w.startedAt,
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// persist는 현재 상태를 저장소에 저장합니다. 저장 실패는 로그만 남깁니다.
+func (w *Worker) persist() {
+	if w.stateStore == nil {
+		return
+	}
+	if err := w.stateStore.Save(w.State()); err != nil {
+		fmt.Printf("[%s] ⚠️ 상태 저장 실패: %v\n", w.config.ID, err)
+	}
+}
+
+// GetSlackThreadTS는 현재 태스크의 Slack 스레드 타임스탬프를 반환합니다.
+func (w *Worker) GetSlackThreadTS() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.slackThreadTS
+}
+
 // extractJiraID는 description에서 Jira 이슈 ID를 추출합니다.
 // 예: "[ITSM-5168](https://...)" 또는 "ITSM-5168" 패턴 인식
 func extractJiraID(description string) string {
@@ -316,18 +793,46 @@ func (w *Worker) GetSrcPath() string {
 	return w.srcPath
 }
 
+// IsAgentAlive는 Worker의 에이전트 세션(터미널 창)이 아직 살아있는지 확인합니다.
+// 확인할 수 없는 터미널(Warp 등)은 false와 에러를 반환합니다.
+func (w *Worker) IsAgentAlive() (bool, error) {
+	w.mu.Lock()
+	terminalType := w.terminalType
+	workerID := w.config.ID
+	w.mu.Unlock()
+
+	if checker, ok := w.invoker.(AgentSessionChecker); ok {
+		return checker.IsSessionAlive(workerID)
+	}
+
+	checker, ok := GetTerminalHandler(terminalType).(SessionChecker)
+	if !ok {
+		return false, fmt.Errorf("%s 터미널은 세션 확인을 지원하지 않음", terminalType)
+	}
+	return checker.IsSessionAlive(workerID)
+}
+
 // TerminateClaude는 현재 실행 중인 Claude 터미널 창을 종료합니다.
 // Worker ID로 터미널 창을 식별하여 종료합니다.
 func (w *Worker) TerminateClaude() error {
+	return w.terminateAgent(w.config.ID)
+}
+
+// terminateAgent는 workerID 제목의 에이전트 터미널 창을 종료합니다.
+func (w *Worker) terminateAgent(workerID string) error {
 	w.mu.Lock()
 	terminalType := w.terminalType
-	workerID := w.config.ID
 	w.mu.Unlock()
 
 	if workerID == "" {
 		return fmt.Errorf("Worker ID가 설정되지 않음")
 	}
 
+	// Invoker가 종료를 직접 처리하면 위임 (예: dry-run)
+	if terminator, ok := w.invoker.(AgentTerminator); ok {
+		return terminator.Terminate(workerID)
+	}
+
 	// TerminalHandler를 통해 Worker ID로 창 찾아 종료
 	handler := GetTerminalHandler(terminalType)
 	return handler.Terminate(workerID)
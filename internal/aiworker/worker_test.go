@@ -19,9 +19,22 @@ type MockClickUpClient struct {
 	UpdateCalled       bool
 	UpdateDatesCalled  bool
 	MoveTaskCalled     bool
+	Uploads            []Upload
+	Comments           []Comment
 	mu                 sync.Mutex
 }
 
+type Upload struct {
+	TaskID   string
+	Filename string
+	Data     []byte
+}
+
+type Comment struct {
+	TaskID string
+	Text   string
+}
+
 type StatusUpdate struct {
 	TaskID string
 	Status string
@@ -85,6 +98,16 @@ func (m *MockClickUpClient) CreateTask(ctx context.Context, msg interface{}) (*c
 }
 
 func (m *MockClickUpClient) UploadAttachment(ctx context.Context, taskID, filename string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Uploads = append(m.Uploads, Upload{TaskID: taskID, Filename: filename, Data: data})
+	return nil
+}
+
+func (m *MockClickUpClient) AddComment(ctx context.Context, taskID, text string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Comments = append(m.Comments, Comment{TaskID: taskID, Text: text})
 	return nil
 }
 
@@ -161,11 +184,26 @@ func TestWorker_State(t *testing.T) {
 		t.Error("현재 태스크 ID가 task1이어야 함")
 	}
 
+	// Slack 스레드 타임스탬프는 태스크 실행 동안 유지
+	worker.SetSlackThreadTS("1700000000.000100")
+	if worker.GetSlackThreadTS() != "1700000000.000100" {
+		t.Error("Slack 스레드 타임스탬프가 저장되어야 함")
+	}
+
 	// 처리 완료
 	worker.ClearProcessing()
 	if worker.IsProcessing() {
 		t.Error("ClearProcessing 후 processing이 아니어야 함")
 	}
+	if worker.GetSlackThreadTS() != "" {
+		t.Error("ClearProcessing 후 Slack 스레드 타임스탬프가 비어야 함")
+	}
+
+	// 처리 중이 아니면 스레드 타임스탬프를 저장하지 않음
+	worker.SetSlackThreadTS("1700000000.000200")
+	if worker.GetSlackThreadTS() != "" {
+		t.Error("처리 중이 아니면 스레드 타임스탬프가 저장되지 않아야 함")
+	}
 }
 
 // TestWorker_GetConfig는 Worker 설정 조회를 테스트합니다.
@@ -196,8 +234,9 @@ func TestWorker_CompleteTask(t *testing.T) {
 	config := WorkerConfig{ID: "AI_01", ListID: "list1", SrcPath: "/test"}
 	worker := NewWorker(config, mockClient, nil, "작업중", "개발완료", "901413896178")
 
-	// 태스크 처리 중 상태 설정
+	// 태스크 처리 중 상태 설정 (에이전트 실행 단계까지 진행)
 	worker.SetProcessing("task1", "Test Task", "ITSM-1234", "open")
+	advanceTo(t, worker, StatePreparing, StatePlanning, StateExecuting)
 
 	// 완료 처리
 	ctx := context.Background()
@@ -246,8 +285,9 @@ func TestWorker_CompleteTask_NoListMove(t *testing.T) {
 	config := WorkerConfig{ID: "AI_01", ListID: "list1", SrcPath: "/test"}
 	worker := NewWorker(config, mockClient, nil, "작업중", "개발완료", "") // 완료 리스트 ID 없음
 
-	// 태스크 처리 중 상태 설정
+	// 태스크 처리 중 상태 설정 (에이전트 실행 단계까지 진행)
 	worker.SetProcessing("task1", "Test Task", "ITSM-1234", "open")
+	advanceTo(t, worker, StatePreparing, StatePlanning, StateExecuting)
 
 	// 완료 처리
 	ctx := context.Background()
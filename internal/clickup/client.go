@@ -9,6 +9,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -48,14 +49,96 @@ type TaskResponse struct {
 
 // Task는 ClickUp 태스크 조회 응답입니다.
 type Task struct {
-	ID          string       `json:"id"`
-	Name        string       `json:"name"`
-	Description string       `json:"description"`
-	Status      TaskStatus   `json:"status"`
-	URL         string       `json:"url"`
-	DateCreated string       `json:"date_created"`
-	DateUpdated string       `json:"date_updated"`
-	Attachments []Attachment `json:"attachments"`
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Description  string        `json:"description"`
+	Status       TaskStatus    `json:"status"`
+	URL          string        `json:"url"`
+	DateCreated  string        `json:"date_created"`
+	DateUpdated  string        `json:"date_updated"`
+	Attachments  []Attachment  `json:"attachments"`
+	Tags         []Tag         `json:"tags"`
+	CustomFields []CustomField `json:"custom_fields"`
+	Assignees    []User        `json:"assignees"`
+}
+
+// ParseMillis는 ClickUp의 밀리초 epoch 문자열(date_created 등)을 시각으로 변환합니다.
+// 비어있거나 파싱할 수 없으면 zero 값을 반환합니다.
+func ParseMillis(ms string) time.Time {
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(n)
+}
+
+// User는 태스크 담당자 정보입니다.
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// HasAssignee는 태스크 담당자에 userID가 포함되어 있는지 확인합니다.
+func (t *Task) HasAssignee(userID int) bool {
+	for _, user := range t.Assignees {
+		if user.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Tag는 태스크 태그입니다.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// CustomField는 태스크 커스텀 필드 값입니다.
+// Value는 필드 타입에 따라 문자열, 숫자(drop_down은 옵션 orderindex), 배열 등이며 값이 없으면 nil입니다.
+type CustomField struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Type       string                `json:"type"`
+	TypeConfig CustomFieldTypeConfig `json:"type_config"`
+	Value      interface{}           `json:"value"`
+}
+
+// CustomFieldTypeConfig는 커스텀 필드 타입 설정입니다. (drop_down 옵션 목록)
+type CustomFieldTypeConfig struct {
+	Options []CustomFieldOption `json:"options"`
+}
+
+// CustomFieldOption은 drop_down 커스텀 필드의 옵션입니다.
+type CustomFieldOption struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	OrderIndex int    `json:"orderindex"`
+}
+
+// Text는 커스텀 필드 값을 문자열로 반환합니다.
+// drop_down은 선택된 옵션 이름을, 텍스트 필드는 값을 반환하며 그 외에는 빈 문자열입니다.
+func (f CustomField) Text() string {
+	switch v := f.Value.(type) {
+	case string:
+		if f.Type == "drop_down" {
+			// 옵션 ID로 전달되는 경우
+			for _, option := range f.TypeConfig.Options {
+				if option.ID == v {
+					return option.Name
+				}
+			}
+		}
+		return v
+	case float64:
+		if f.Type == "drop_down" {
+			for _, option := range f.TypeConfig.Options {
+				if option.OrderIndex == int(v) {
+					return option.Name
+				}
+			}
+		}
+	}
+	return ""
 }
 
 // TaskStatus는 태스크 상태 정보입니다.
@@ -552,3 +635,82 @@ func (c *ClickUpClient) MoveTaskToList(ctx context.Context, taskID string, listI
 
 	return nil
 }
+
+// ClaimTask는 태스크 상태와 담당자 추가를 한 번의 요청으로 변경합니다. (Worker 풀 선점용)
+// API: PUT /api/v2/task/{task_id}
+func (c *ClickUpClient) ClaimTask(ctx context.Context, taskID string, status string, assigneeID int) error {
+	return c.updateTask(ctx, taskID, map[string]interface{}{
+		"status":    status,
+		"assignees": map[string][]int{"add": {assigneeID}},
+	})
+}
+
+// UnassignTask는 태스크 담당자에서 assigneeID를 제거합니다.
+// API: PUT /api/v2/task/{task_id}
+func (c *ClickUpClient) UnassignTask(ctx context.Context, taskID string, assigneeID int) error {
+	return c.updateTask(ctx, taskID, map[string]interface{}{
+		"assignees": map[string][]int{"rem": {assigneeID}},
+	})
+}
+
+// AddComment는 태스크에 댓글을 추가합니다. (담당자 외 알림 없음)
+// API: POST /api/v2/task/{task_id}/comment
+func (c *ClickUpClient) AddComment(ctx context.Context, taskID, text string) error {
+	reqURL := fmt.Sprintf("%s/task/%s/comment", c.baseURL, taskID)
+	return c.doJSON(ctx, http.MethodPost, reqURL, map[string]interface{}{
+		"comment_text": text,
+		"notify_all":   false,
+	}, nil)
+}
+
+// Comment는 태스크 댓글입니다.
+type Comment struct {
+	ID          string `json:"id"`
+	CommentText string `json:"comment_text"`
+	User        User   `json:"user"`
+	Date        string `json:"date"` // Unix 밀리초 문자열
+}
+
+// GetComments는 태스크 댓글 목록을 최신순으로 조회합니다.
+// API: GET /api/v2/task/{task_id}/comment
+func (c *ClickUpClient) GetComments(ctx context.Context, taskID string) ([]Comment, error) {
+	reqURL := fmt.Sprintf("%s/task/%s/comment", c.baseURL, taskID)
+	var resp struct {
+		Comments []Comment `json:"comments"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, reqURL, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Comments, nil
+}
+
+// updateTask는 태스크 필드를 변경하는 PUT 요청을 보냅니다.
+func (c *ClickUpClient) updateTask(ctx context.Context, taskID string, payload map[string]interface{}) error {
+	reqURL := fmt.Sprintf("%s/task/%s", c.baseURL, taskID)
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("페이로드 직렬화 실패: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", reqURL, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	req.Header.Set("Authorization", c.config.APIToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("API 호출 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API 에러 (상태코드: %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
@@ -228,6 +228,28 @@ func TestTruncateText(t *testing.T) {
 	}
 }
 
+// TestParseMillis는 ClickUp 밀리초 epoch 문자열 변환을 테스트합니다.
+func TestParseMillis(t *testing.T) {
+	tests := []struct {
+		name string
+		ms   string
+		want time.Time
+	}{
+		{"정상 값", "1736260200000", time.UnixMilli(1736260200000)},
+		{"빈 문자열", "", time.Time{}},
+		{"숫자 아님", "abc", time.Time{}},
+		{"0", "0", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMillis(tt.ms); !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestClickUpClient_GetTasks는 리스트의 태스크 목록 조회를 테스트합니다.
 func TestClickUpClient_GetTasks(t *testing.T) {
 	// Mock 서버 설정
@@ -597,3 +619,122 @@ func TestClickUpClient_UpdateTaskDates_Error(t *testing.T) {
 		t.Error("에러가 발생해야 합니다")
 	}
 }
+
+// TestTask_TagsAndCustomFields는 태그와 커스텀 필드 파싱을 테스트합니다.
+func TestTask_TagsAndCustomFields(t *testing.T) {
+	data := `{
+		"id": "task1",
+		"tags": [{"name": "model:opencode"}],
+		"custom_fields": [
+			{"id": "f1", "name": "AI Mode", "type": "drop_down", "value": 1,
+			 "type_config": {"options": [{"id": "o1", "name": "plan", "orderindex": 0}, {"id": "o2", "name": "review", "orderindex": 1}]}},
+			{"id": "f2", "name": "AI Model", "type": "drop_down", "value": "o2",
+			 "type_config": {"options": [{"id": "o1", "name": "claude", "orderindex": 0}, {"id": "o2", "name": "opencode", "orderindex": 1}]}},
+			{"id": "f3", "name": "메모", "type": "short_text", "value": "direct"},
+			{"id": "f4", "name": "빈 필드", "type": "drop_down"}
+		]
+	}`
+
+	var task Task
+	if err := json.Unmarshal([]byte(data), &task); err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+	if len(task.Tags) != 1 || task.Tags[0].Name != "model:opencode" {
+		t.Errorf("태그 불일치: %+v", task.Tags)
+	}
+
+	want := []string{"review", "opencode", "direct", ""}
+	if len(task.CustomFields) != len(want) {
+		t.Fatalf("커스텀 필드 개수 불일치: %d", len(task.CustomFields))
+	}
+	for i, field := range task.CustomFields {
+		if got := field.Text(); got != want[i] {
+			t.Errorf("%s 값 불일치: got %q, want %q", field.Name, got, want[i])
+		}
+	}
+}
+
+// TestClickUpClient_ClaimTask는 상태와 담당자를 한 번에 변경하는 선점 요청을 테스트합니다.
+func TestClickUpClient_ClaimTask(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/task/task123" {
+			t.Errorf("잘못된 요청: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClickUpClient(Config{APIToken: "test-token"})
+	client.baseURL = server.URL
+
+	if err := client.ClaimTask(context.Background(), "task123", "작업중", 42); err != nil {
+		t.Fatalf("선점 실패: %v", err)
+	}
+	if err := client.UnassignTask(context.Background(), "task123", 42); err != nil {
+		t.Fatalf("담당자 제거 실패: %v", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("요청 수 불일치: %d", len(bodies))
+	}
+	if bodies[0]["status"] != "작업중" {
+		t.Errorf("선점 상태 불일치: %v", bodies[0]["status"])
+	}
+	if add := bodies[0]["assignees"].(map[string]interface{})["add"].([]interface{}); len(add) != 1 || add[0].(float64) != 42 {
+		t.Errorf("추가 담당자 불일치: %v", add)
+	}
+	if bodies[1]["status"] != nil {
+		t.Error("담당자 제거 요청에는 status가 없어야 함")
+	}
+	if rem := bodies[1]["assignees"].(map[string]interface{})["rem"].([]interface{}); len(rem) != 1 || rem[0].(float64) != 42 {
+		t.Errorf("제거 담당자 불일치: %v", rem)
+	}
+}
+
+func TestClickUpClient_AddComment(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/task/task123/comment" {
+			t.Errorf("잘못된 요청: %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	client := NewClickUpClient(Config{APIToken: "test-token"})
+	client.baseURL = server.URL
+
+	if err := client.AddComment(context.Background(), "task123", "TDD 규칙 위반"); err != nil {
+		t.Fatalf("댓글 추가 실패: %v", err)
+	}
+	if body["comment_text"] != "TDD 규칙 위반" || body["notify_all"] != false {
+		t.Errorf("댓글 요청 본문 불일치: %v", body)
+	}
+}
+
+func TestClickUpClient_GetComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/task/task123/comment" {
+			t.Errorf("잘못된 요청: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"comments": [{"id": "c1", "comment_text": "재현 환경은 iOS입니다", "user": {"id": 7, "username": "reporter"}, "date": "1700000000000"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClickUpClient(Config{APIToken: "test-token"})
+	client.baseURL = server.URL
+
+	comments, err := client.GetComments(context.Background(), "task123")
+	if err != nil {
+		t.Fatalf("댓글 조회 실패: %v", err)
+	}
+	if len(comments) != 1 || comments[0].CommentText != "재현 환경은 iOS입니다" || comments[0].User.Username != "reporter" {
+		t.Errorf("댓글 파싱 불일치: %+v", comments)
+	}
+}
@@ -7,6 +7,7 @@ const (
 	EventTaskStatusUpdated = "taskStatusUpdated"
 	EventTaskMoved         = "taskMoved"
 	EventTaskDeleted       = "taskDeleted"
+	EventTaskTagUpdated    = "taskTagUpdated"
 )
 
 // WebhookEvent는 ClickUp 웹훅 이벤트입니다.
@@ -19,11 +20,13 @@ type WebhookEvent struct {
 
 // HistoryItem은 변경 이력 항목입니다.
 type HistoryItem struct {
-	Date   int64       `json:"date"` // Unix 밀리초
-	Field  string      `json:"field"`
-	User   WebhookUser `json:"user"`
-	Before interface{} `json:"before"`
-	After  interface{} `json:"after"`
+	ID       string      `json:"id"`        // 이력 항목 ID (재전송 시 동일)
+	Date     int64       `json:"date"`      // Unix 밀리초
+	Field    string      `json:"field"`     // 변경 필드 (status, tag, tag_removed, parent_id 등)
+	ParentID string      `json:"parent_id"` // 태스크가 속한 리스트 ID
+	User     WebhookUser `json:"user"`
+	Before   interface{} `json:"before"`
+	After    interface{} `json:"after"`
 }
 
 // WebhookUser는 웹훅 이벤트를 발생시킨 사용자입니다.
@@ -44,28 +47,38 @@ type WebhookRegistration struct {
 
 // WebhookRegistrationResponse는 웹훅 등록 응답입니다.
 type WebhookRegistrationResponse struct {
-	ID       string `json:"id"`
-	Webhook  Webhook `json:"webhook"`
+	ID      string  `json:"id"`
+	Webhook Webhook `json:"webhook"`
 }
 
 // Webhook은 등록된 웹훅 정보입니다.
 type Webhook struct {
-	ID       string   `json:"id"`
-	TeamID   string   `json:"team_id"`
-	Endpoint string   `json:"endpoint"`
-	Events   []string `json:"events"`
+	ID       string        `json:"id"`
+	TeamID   ResourceID    `json:"team_id"`
+	Endpoint string        `json:"endpoint"`
+	Events   []string      `json:"events"`
+	ListID   ResourceID    `json:"list_id"`   // 리스트 단위 웹훅이면 리스트 ID
+	FolderID ResourceID    `json:"folder_id"` // 폴더 단위 웹훅이면 폴더 ID
+	SpaceID  ResourceID    `json:"space_id"`  // 스페이스 단위 웹훅이면 스페이스 ID
 	Health   WebhookHealth `json:"health"`
-	Secret   string   `json:"secret"`
+	Secret   string        `json:"secret"`
 }
 
 // WebhookHealth는 웹훅 상태 정보입니다.
+// 전송 실패가 누적되면 FailCount가 증가하고 Status가 active에서 failing/suspended로 바뀝니다.
 type WebhookHealth struct {
 	Status    string `json:"status"`
 	FailCount int    `json:"fail_count"`
 }
 
+// IsHealthy는 웹훅이 정상 동작 중인지 확인합니다.
+func (w *Webhook) IsHealthy() bool {
+	return (w.Health.Status == "" || w.Health.Status == WebhookStatusActive) && w.Health.FailCount == 0
+}
+
 // GetListIDFromEvent는 웹훅 이벤트에서 리스트 ID를 추출합니다.
-// HistoryItems의 parent_id 필드에서 리스트 ID를 찾습니다.
+// HistoryItems의 parent_id 필드 변경(생성/이동)을 우선 사용하고,
+// 없으면 이력 항목의 parent_id 속성(상태 변경 등)을 사용합니다.
 func (e *WebhookEvent) GetListIDFromEvent() string {
 	for _, item := range e.HistoryItems {
 		if item.Field == "parent_id" {
@@ -74,5 +87,10 @@ func (e *WebhookEvent) GetListIDFromEvent() string {
 			}
 		}
 	}
+	for _, item := range e.HistoryItems {
+		if item.ParentID != "" {
+			return item.ParentID
+		}
+	}
 	return ""
 }
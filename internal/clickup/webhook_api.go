@@ -0,0 +1,152 @@
+package clickup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WebhookStatusActive는 정상 동작 중인 웹훅 상태입니다.
+// 연속 실패로 비활성화된 웹훅은 "failing" 또는 "suspended" 상태가 됩니다.
+const WebhookStatusActive = "active"
+
+// WebhookUpdate는 웹훅 수정 요청입니다.
+// Status를 "active"로 보내면 비활성화된 웹훅이 다시 활성화됩니다.
+type WebhookUpdate struct {
+	Endpoint string   `json:"endpoint"`
+	Events   []string `json:"events"`
+	Status   string   `json:"status,omitempty"`
+}
+
+// webhooksResponse는 웹훅 목록 조회 응답입니다.
+type webhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// ResourceID는 숫자 또는 문자열로 내려오는 ClickUp 리소스 ID입니다. null이면 빈 문자열입니다.
+type ResourceID string
+
+// UnmarshalJSON은 숫자/문자열/null ID를 문자열로 변환합니다.
+func (id *ResourceID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ""
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = ResourceID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("리소스 ID 파싱 실패: %s", string(data))
+	}
+	*id = ResourceID(n.String())
+	return nil
+}
+
+// CreateWebhook은 팀(Workspace)에 웹훅을 등록합니다.
+// API: POST /api/v2/team/{team_id}/webhook
+func (c *ClickUpClient) CreateWebhook(ctx context.Context, registration *WebhookRegistration) (*Webhook, error) {
+	if c.config.TeamID == "" {
+		return nil, fmt.Errorf("웹훅 등록 실패: TeamID가 설정되지 않음")
+	}
+
+	var resp WebhookRegistrationResponse
+	reqURL := fmt.Sprintf("%s/team/%s/webhook", c.baseURL, c.config.TeamID)
+	if err := c.doJSON(ctx, http.MethodPost, reqURL, registration, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Webhook.ID == "" {
+		resp.Webhook.ID = resp.ID
+	}
+	return &resp.Webhook, nil
+}
+
+// GetWebhooks는 팀(Workspace)에 등록된 웹훅 목록을 조회합니다.
+// 현재 API 토큰 사용자가 생성한 웹훅만 조회됩니다.
+// API: GET /api/v2/team/{team_id}/webhook
+func (c *ClickUpClient) GetWebhooks(ctx context.Context) ([]Webhook, error) {
+	if c.config.TeamID == "" {
+		return nil, fmt.Errorf("웹훅 조회 실패: TeamID가 설정되지 않음")
+	}
+
+	var resp webhooksResponse
+	reqURL := fmt.Sprintf("%s/team/%s/webhook", c.baseURL, c.config.TeamID)
+	if err := c.doJSON(ctx, http.MethodGet, reqURL, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Webhooks, nil
+}
+
+// UpdateWebhook은 웹훅의 엔드포인트/이벤트/상태를 수정합니다.
+// API: PUT /api/v2/webhook/{webhook_id}
+func (c *ClickUpClient) UpdateWebhook(ctx context.Context, webhookID string, update *WebhookUpdate) (*Webhook, error) {
+	var resp WebhookRegistrationResponse
+	reqURL := fmt.Sprintf("%s/webhook/%s", c.baseURL, webhookID)
+	if err := c.doJSON(ctx, http.MethodPut, reqURL, update, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Webhook.ID == "" {
+		resp.Webhook.ID = webhookID
+	}
+	return &resp.Webhook, nil
+}
+
+// DeleteWebhook은 웹훅을 삭제합니다.
+// API: DELETE /api/v2/webhook/{webhook_id}
+func (c *ClickUpClient) DeleteWebhook(ctx context.Context, webhookID string) error {
+	reqURL := fmt.Sprintf("%s/webhook/%s", c.baseURL, webhookID)
+	return c.doJSON(ctx, http.MethodDelete, reqURL, nil, nil)
+}
+
+// doJSON은 JSON 요청을 보내고 응답을 out에 파싱합니다. payload/out이 nil이면 생략합니다.
+func (c *ClickUpClient) doJSON(ctx context.Context, method, reqURL string, payload, out interface{}) error {
+	var reqBody io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("페이로드 직렬화 실패: %w", err)
+		}
+		reqBody = bytes.NewReader(payloadBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	req.Header.Set("Authorization", c.config.APIToken)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("API 호출 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API 에러 (상태코드: %d): %s", resp.StatusCode, string(body))
+	}
+
+	if out != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("응답 파싱 실패: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package clickup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestClickUpClient_WebhookCRUD는 웹훅 등록/조회/수정/삭제 API 호출을 테스트합니다.
+func TestClickUpClient_WebhookCRUD(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Header.Get("Authorization") != "test-token" {
+			t.Errorf("Authorization 헤더 불일치: %s", r.Header.Get("Authorization"))
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/team/team1/webhook":
+			var reg WebhookRegistration
+			json.NewDecoder(r.Body).Decode(&reg)
+			if reg.ListID == nil || *reg.ListID != "901" {
+				t.Errorf("리스트 ID 불일치: %+v", reg)
+			}
+			w.Write([]byte(`{"id": "wh1", "webhook": {"id": "wh1", "endpoint": "https://e.com", "list_id": 901, "secret": "s1", "health": {"status": "active", "fail_count": 0}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/team/team1/webhook":
+			w.Write([]byte(`{"webhooks": [{"id": "wh1", "team_id": 1, "list_id": "901", "folder_id": null, "events": ["taskCreated"], "secret": "s1", "health": {"status": "failing", "fail_count": 3}}]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/webhook/wh1":
+			var update WebhookUpdate
+			json.NewDecoder(r.Body).Decode(&update)
+			if update.Status != WebhookStatusActive {
+				t.Errorf("상태 불일치: %+v", update)
+			}
+			w.Write([]byte(`{"id": "wh1", "webhook": {"endpoint": "https://e.com", "health": {"status": "active"}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/webhook/wh1":
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClickUpClient(Config{APIToken: "test-token", TeamID: "team1"})
+	client.baseURL = server.URL
+	ctx := context.Background()
+
+	listID := "901"
+	created, err := client.CreateWebhook(ctx, &WebhookRegistration{Endpoint: "https://e.com", Events: []string{"taskCreated"}, ListID: &listID})
+	if err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+	if created.ID != "wh1" || created.ListID != "901" || created.Secret != "s1" {
+		t.Errorf("등록 응답 불일치: %+v", created)
+	}
+
+	hooks, err := client.GetWebhooks(ctx)
+	if err != nil {
+		t.Fatalf("조회 실패: %v", err)
+	}
+	if len(hooks) != 1 || hooks[0].TeamID != "1" || hooks[0].FolderID != "" || hooks[0].IsHealthy() {
+		t.Errorf("조회 응답 불일치: %+v", hooks)
+	}
+
+	updated, err := client.UpdateWebhook(ctx, "wh1", &WebhookUpdate{Endpoint: "https://e.com", Status: WebhookStatusActive})
+	if err != nil {
+		t.Fatalf("수정 실패: %v", err)
+	}
+	if updated.ID != "wh1" || !updated.IsHealthy() {
+		t.Errorf("수정 응답 불일치: %+v", updated)
+	}
+
+	if err := client.DeleteWebhook(ctx, "wh1"); err != nil {
+		t.Fatalf("삭제 실패: %v", err)
+	}
+	if err := client.DeleteWebhook(ctx, "missing"); err == nil {
+		t.Error("404는 에러여야 함")
+	}
+
+	if len(requests) != 5 {
+		t.Errorf("요청 수 불일치: %v", requests)
+	}
+}
+
+// TestClickUpClient_Webhook_NoTeamID는 TeamID 없이 웹훅 API 호출을 테스트합니다.
+func TestClickUpClient_Webhook_NoTeamID(t *testing.T) {
+	client := NewClickUpClient(Config{APIToken: "test-token"})
+	if _, err := client.GetWebhooks(context.Background()); err == nil {
+		t.Error("TeamID가 없으면 에러여야 함")
+	}
+}
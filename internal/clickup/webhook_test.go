@@ -108,3 +108,27 @@ func TestWebhookRegistration_Marshal(t *testing.T) {
 		t.Error("events 불일치")
 	}
 }
+
+// TestWebhookEvent_GetListIDFromEvent_ParentIDAttr는 이력 항목의 parent_id 속성 폴백을 테스트합니다.
+func TestWebhookEvent_GetListIDFromEvent_ParentIDAttr(t *testing.T) {
+	jsonData := `{
+		"event": "taskStatusUpdated",
+		"task_id": "task123",
+		"history_items": [
+			{"id": "2800763136717140857", "field": "status", "parent_id": "list789",
+			 "before": {"status": "to do"}, "after": {"status": "AI요청"}}
+		]
+	}`
+
+	var event WebhookEvent
+	if err := json.Unmarshal([]byte(jsonData), &event); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+
+	if event.HistoryItems[0].ID != "2800763136717140857" {
+		t.Errorf("이력 항목 ID 불일치: %s", event.HistoryItems[0].ID)
+	}
+	if listID := event.GetListIDFromEvent(); listID != "list789" {
+		t.Errorf("리스트 ID 불일치: got %s, want list789", listID)
+	}
+}
@@ -65,3 +65,44 @@ func LoadEnvFile(filePath string) error {
 
 	return scanner.Err()
 }
+
+// SetEnvFileValue는 env 파일의 KEY=VALUE 줄을 갱신합니다.
+// 키가 있으면 첫 번째 줄(주석 처리된 "# KEY=" 포함)을 교체하고, 없으면 파일 끝에 추가합니다.
+// 파일이 없으면 새로 생성합니다.
+func SetEnvFileValue(filePath, key, value string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	newLine := key + "=" + value
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(data) == 0 {
+		lines = nil
+	}
+
+	replaced := -1
+	commented := -1
+	for i, line := range lines {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(line), "export ")
+		if strings.HasPrefix(trimmed, key+"=") {
+			replaced = i
+			break
+		}
+		uncommented := strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+		if commented < 0 && strings.HasPrefix(trimmed, "#") && strings.HasPrefix(uncommented, key+"=") {
+			commented = i
+		}
+	}
+
+	switch {
+	case replaced >= 0:
+		lines[replaced] = newLine
+	case commented >= 0:
+		lines[commented] = newLine
+	default:
+		lines = append(lines, newLine)
+	}
+
+	return os.WriteFile(filePath, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+}
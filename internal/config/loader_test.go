@@ -87,3 +87,41 @@ func TestLoadEnvFile_NoOverwrite(t *testing.T) {
 		t.Errorf("기존 환경변수가 덮어써짐: %s", got)
 	}
 }
+
+// TestSetEnvFileValue는 env 파일 값 갱신을 테스트합니다.
+func TestSetEnvFileValue(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), "config.ini")
+
+	content := "# 설정\nWEBHOOK_PORT=8080\n# WEBHOOK_SECRET=your-secret\nHOOK_SERVER_PORT=8081\n"
+	if err := os.WriteFile(envPath, []byte(content), 0644); err != nil {
+		t.Fatalf("파일 생성 실패: %v", err)
+	}
+
+	// 주석 처리된 키는 그 자리에서 활성화
+	if err := SetEnvFileValue(envPath, "WEBHOOK_SECRET", "abc,def"); err != nil {
+		t.Fatalf("갱신 실패: %v", err)
+	}
+	// 기존 키는 교체
+	if err := SetEnvFileValue(envPath, "WEBHOOK_PORT", "9090"); err != nil {
+		t.Fatalf("갱신 실패: %v", err)
+	}
+	// 없는 키는 추가
+	if err := SetEnvFileValue(envPath, "NEW_KEY", "1"); err != nil {
+		t.Fatalf("갱신 실패: %v", err)
+	}
+
+	data, _ := os.ReadFile(envPath)
+	want := "# 설정\nWEBHOOK_PORT=9090\nWEBHOOK_SECRET=abc,def\nHOOK_SERVER_PORT=8081\nNEW_KEY=1\n"
+	if string(data) != want {
+		t.Errorf("파일 내용 불일치:\ngot:\n%s\nwant:\n%s", data, want)
+	}
+
+	// 파일이 없으면 생성
+	newPath := filepath.Join(t.TempDir(), "new.ini")
+	if err := SetEnvFileValue(newPath, "KEY", "value"); err != nil {
+		t.Fatalf("생성 실패: %v", err)
+	}
+	if data, _ := os.ReadFile(newPath); string(data) != "KEY=value\n" {
+		t.Errorf("새 파일 내용 불일치: %q", data)
+	}
+}
@@ -0,0 +1,70 @@
+package digest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/store"
+)
+
+// WaitingTask는 AI 리스트에서 처리를 기다리는 태스크입니다.
+type WaitingTask struct {
+	WorkerID  string // 담당 Worker ID (풀 리스트는 "AI_01/AI_02"처럼 풀 Worker ID를 묶은 값)
+	TaskID    string
+	Name      string
+	URL       string
+	CreatedAt time.Time
+}
+
+// MaxWaitingPerList는 리스트별로 표시할 대기 태스크 수입니다.
+const MaxWaitingPerList = 3
+
+// AIWorkerSections는 실행 기록과 대기 태스크로 AI Worker 리포트 단락을 만듭니다.
+// waiting은 리스트 담당 Worker ID(풀은 묶은 값)별 대기 태스크 목록이며 오래된 순으로 표시합니다.
+func AIWorkerSections(runs []store.TaskRun, waiting map[string][]WaitingTask, now time.Time) []Section {
+	summaries := store.SummarizeTaskRuns(runs, func(r store.TaskRun) string { return r.WorkerID })
+
+	results := Section{Title: "🤖 Worker별 처리 결과"}
+	usage := Section{Title: "⏱️ 평균 소요 시간 / 사용량"}
+	for _, s := range summaries {
+		results.Lines = append(results.Lines, fmt.Sprintf("• *%s*: 완료 %d · 실패 %d · 취소 %d (성공률 %.0f%%)",
+			s.Key, s.Completed, s.Failed, s.Cancelled, s.SuccessRate*100))
+		usage.Lines = append(usage.Lines, fmt.Sprintf("• *%s*: 평균 %s · 토큰 입력 %d / 출력 %d",
+			s.Key, formatDuration(s.MeanTimeToComplete), s.InputTokens, s.OutputTokens))
+	}
+	if len(summaries) == 0 {
+		results.Lines = []string{"실행 기록 없음"}
+		usage.Lines = []string{"-"}
+	}
+
+	wait := Section{Title: "⏳ 가장 오래 대기 중인 태스크"}
+	workerIDs := make([]string, 0, len(waiting))
+	for id := range waiting {
+		workerIDs = append(workerIDs, id)
+	}
+	sort.Strings(workerIDs)
+	for _, id := range workerIDs {
+		tasks := append([]WaitingTask(nil), waiting[id]...)
+		if len(tasks) == 0 {
+			continue
+		}
+		sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].CreatedAt.Before(tasks[j].CreatedAt) })
+		if len(tasks) > MaxWaitingPerList {
+			tasks = tasks[:MaxWaitingPerList]
+		}
+		wait.Lines = append(wait.Lines, fmt.Sprintf("*%s* (%d건 대기)", id, len(waiting[id])))
+		for _, task := range tasks {
+			name := task.Name
+			if task.URL != "" {
+				name = "<" + task.URL + "|" + task.Name + ">"
+			}
+			wait.Lines = append(wait.Lines, fmt.Sprintf("  • %s - %s 대기", name, formatDuration(now.Sub(task.CreatedAt))))
+		}
+	}
+	if len(wait.Lines) == 0 {
+		wait.Lines = []string{"대기 중인 태스크 없음"}
+	}
+
+	return []Section{results, usage, wait}
+}
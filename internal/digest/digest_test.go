@@ -0,0 +1,168 @@
+package digest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/zime/slickwebhook/internal/history"
+	"github.com/zime/slickwebhook/internal/store"
+)
+
+// TestParseSchedule는 일정 설정 파싱을 테스트합니다.
+func TestParseSchedule(t *testing.T) {
+	s, err := ParseSchedule("weekly", "18:30", "Friday", "Asia/Seoul")
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+	if s.Period != PeriodWeekly || s.Hour != 18 || s.Minute != 30 || s.Weekday != time.Friday || s.Location.String() != "Asia/Seoul" {
+		t.Errorf("일정 불일치: %+v", s)
+	}
+
+	for _, tc := range [][4]string{
+		{"monthly", "", "", ""},
+		{"daily", "25:00", "", ""},
+		{"weekly", "", "someday", ""},
+		{"daily", "", "", "Nowhere/City"},
+	} {
+		if _, err := ParseSchedule(tc[0], tc[1], tc[2], tc[3]); err == nil {
+			t.Errorf("잘못된 설정이 허용됨: %v", tc)
+		}
+	}
+}
+
+// TestSchedule_Next는 다음 게시 시각 계산을 테스트합니다.
+func TestSchedule_Next(t *testing.T) {
+	loc := time.FixedZone("KST", 9*3600)
+	daily := Schedule{Period: PeriodDaily, Hour: 9, Location: loc}
+	weekly := Schedule{Period: PeriodWeekly, Hour: 9, Weekday: time.Monday, Location: loc}
+
+	// 2026-01-07은 수요일
+	tests := []struct {
+		name     string
+		schedule Schedule
+		now      time.Time
+		want     time.Time
+	}{
+		{"daily 당일", daily, time.Date(2026, 1, 7, 8, 0, 0, 0, loc), time.Date(2026, 1, 7, 9, 0, 0, 0, loc)},
+		{"daily 다음날", daily, time.Date(2026, 1, 7, 9, 0, 0, 0, loc), time.Date(2026, 1, 8, 9, 0, 0, 0, loc)},
+		{"weekly 다음 월요일", weekly, time.Date(2026, 1, 7, 8, 0, 0, 0, loc), time.Date(2026, 1, 12, 9, 0, 0, 0, loc)},
+		{"weekly 월요일 게시 전", weekly, time.Date(2026, 1, 12, 8, 0, 0, 0, loc), time.Date(2026, 1, 12, 9, 0, 0, 0, loc)},
+		{"weekly 월요일 게시 후", weekly, time.Date(2026, 1, 12, 10, 0, 0, 0, loc), time.Date(2026, 1, 19, 9, 0, 0, 0, loc)},
+		{"다른 시간대 기준", daily, time.Date(2026, 1, 7, 1, 0, 0, 0, time.UTC), time.Date(2026, 1, 8, 9, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Next(tt.now); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	until := time.Date(2026, 1, 12, 9, 0, 0, 0, loc)
+	if got := weekly.Window(until); !got.Equal(until.AddDate(0, 0, -7)) {
+		t.Errorf("weekly Window() = %v", got)
+	}
+}
+
+// TestAIWorkerSections는 Worker별 결과/대기 태스크 단락을 테스트합니다.
+func TestAIWorkerSections(t *testing.T) {
+	now := time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC)
+	runs := []store.TaskRun{
+		{WorkerID: "AI_01", FinalState: "completed", StartedAt: now.Add(-3 * time.Hour), EndedAt: now.Add(-2 * time.Hour), InputTokens: 100},
+		{WorkerID: "AI_01", FinalState: "failed", StartedAt: now.Add(-time.Hour), EndedAt: now},
+		{WorkerID: "AI_02", FinalState: "cancelled", StartedAt: now.Add(-time.Hour), EndedAt: now},
+	}
+	waiting := map[string][]WaitingTask{
+		"AI_01": {
+			{Name: "새 태스크", CreatedAt: now.Add(-time.Hour)},
+			{Name: "오래된 태스크", URL: "https://app.clickup.com/t/1", CreatedAt: now.Add(-48 * time.Hour)},
+		},
+	}
+
+	sections := AIWorkerSections(runs, waiting, now)
+	if len(sections) != 3 {
+		t.Fatalf("단락 수 불일치: %d", len(sections))
+	}
+
+	results := strings.Join(sections[0].Lines, "\n")
+	if !strings.Contains(results, "*AI_01*: 완료 1 · 실패 1 · 취소 0 (성공률 50%)") ||
+		!strings.Contains(results, "*AI_02*: 완료 0 · 실패 0 · 취소 1") {
+		t.Errorf("처리 결과 불일치:\n%s", results)
+	}
+	if !strings.Contains(sections[1].Lines[0], "평균 1h0m") || !strings.Contains(sections[1].Lines[0], "입력 100") {
+		t.Errorf("사용량 불일치: %v", sections[1].Lines)
+	}
+
+	wait := sections[2].Lines
+	if len(wait) != 3 || wait[0] != "*AI_01* (2건 대기)" ||
+		!strings.Contains(wait[1], "<https://app.clickup.com/t/1|오래된 태스크> - 48h0m 대기") {
+		t.Errorf("대기 태스크 불일치: %v", wait)
+	}
+
+	empty := AIWorkerSections(nil, nil, now)
+	if empty[0].Lines[0] != "실행 기록 없음" || empty[2].Lines[0] != "대기 중인 태스크 없음" {
+		t.Errorf("빈 리포트 불일치: %+v", empty)
+	}
+}
+
+// TestNewEmailStats는 구간 내 전송/실패/필터링 집계를 테스트합니다.
+func TestNewEmailStats(t *testing.T) {
+	until := time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC)
+	since := until.AddDate(0, 0, -1)
+	records := []*history.Record{
+		{Success: true, CreatedAt: since.Add(time.Hour)},
+		{Success: false, ErrorMessage: "timeout", MessageText: "버그", CreatedAt: since.Add(2 * time.Hour)},
+		{Success: true, CreatedAt: since.Add(-time.Hour)}, // 구간 이전
+		{Success: true, CreatedAt: until},                 // 구간 이후
+	}
+
+	stats := NewEmailStats(5, records, since, until)
+	if stats.Received != 5 || stats.Forwarded != 1 || stats.CreateFailed != 1 || stats.Filtered != 3 || len(stats.Failures) != 1 {
+		t.Errorf("통계 불일치: %+v", stats)
+	}
+
+	sections := EmailSections(stats)
+	if len(sections) != 2 || !strings.Contains(sections[1].Lines[0], "버그 - timeout") {
+		t.Errorf("단락 불일치: %+v", sections)
+	}
+	if got := EmailSections(EmailStats{}); len(got) != 1 {
+		t.Errorf("실패가 없으면 실패 단락을 생략해야 함: %+v", got)
+	}
+}
+
+// mockPoster는 테스트용 Poster입니다.
+type mockPoster struct {
+	channel string
+	blocks  []slack.Block
+	text    string
+}
+
+func (m *mockPoster) PostMessage(ctx context.Context, channelID string, blocks []slack.Block, text string) error {
+	m.channel, m.blocks, m.text = channelID, blocks, text
+	return nil
+}
+
+// TestReport_Post는 리포트 렌더링/게시를 테스트합니다.
+func TestReport_Post(t *testing.T) {
+	report := Report{
+		Title:    "📊 리포트",
+		Since:    time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC),
+		Until:    time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC),
+		Sections: []Section{{Title: "A", Lines: []string{"1"}}, {Title: "B", Lines: []string{"2"}}},
+	}
+
+	poster := &mockPoster{}
+	if err := report.Post(context.Background(), poster, "C123"); err != nil {
+		t.Fatalf("게시 실패: %v", err)
+	}
+	if poster.channel != "C123" || len(poster.blocks) != 4 || poster.text != "📊 리포트 (01/06 09:00 ~ 01/07 09:00)" {
+		t.Errorf("게시 내용 불일치: %s %d %q", poster.channel, len(poster.blocks), poster.text)
+	}
+
+	if text := report.Text(); !strings.Contains(text, "[A]\n1\n") || !strings.Contains(text, "[B]\n2\n") {
+		t.Errorf("텍스트 불일치:\n%s", text)
+	}
+}
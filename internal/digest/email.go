@@ -0,0 +1,62 @@
+package digest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/history"
+)
+
+// MaxFailureLines는 표시할 ClickUp 생성 실패 건수입니다.
+const MaxFailureLines = 5
+
+// EmailStats는 Email Monitor 처리 통계입니다.
+type EmailStats struct {
+	Received     int // 처리한 새 이메일 수
+	Forwarded    int // ClickUp 태스크 생성 성공
+	CreateFailed int // ClickUp 태스크 생성 실패
+	Filtered     int // 필터/중복/재현 스텝 없음 등으로 전송하지 않은 이메일
+	Failures     []*history.Record
+}
+
+// NewEmailStats는 구간 내 처리 이메일 수와 전송 히스토리로 통계를 계산합니다.
+// 히스토리에 없는 처리 이메일은 필터링된 것으로 집계합니다.
+func NewEmailStats(received int, records []*history.Record, since, until time.Time) EmailStats {
+	stats := EmailStats{Received: received}
+	for _, record := range records {
+		if record.CreatedAt.Before(since) || !record.CreatedAt.Before(until) {
+			continue
+		}
+		if record.Success {
+			stats.Forwarded++
+		} else {
+			stats.CreateFailed++
+			stats.Failures = append(stats.Failures, record)
+		}
+	}
+	stats.Filtered = max(0, received-stats.Forwarded-stats.CreateFailed)
+	return stats
+}
+
+// EmailSections는 Email Monitor 리포트 단락을 만듭니다.
+func EmailSections(stats EmailStats) []Section {
+	summary := Section{Title: "📧 이메일 처리", Lines: []string{
+		fmt.Sprintf("• 수신 %d · 전송 %d · 필터링 %d", stats.Received, stats.Forwarded, stats.Filtered),
+		fmt.Sprintf("• ClickUp 생성 실패 %d", stats.CreateFailed),
+	}}
+
+	sections := []Section{summary}
+	if len(stats.Failures) > 0 {
+		failures := Section{Title: "❌ ClickUp 생성 실패"}
+		for i, record := range stats.Failures {
+			if i == MaxFailureLines {
+				failures.Lines = append(failures.Lines, fmt.Sprintf("외 %d건", len(stats.Failures)-MaxFailureLines))
+				break
+			}
+			failures.Lines = append(failures.Lines, fmt.Sprintf("• %s %s - %s",
+				record.CreatedAt.Format("01/02 15:04"), record.MessageText, record.ErrorMessage))
+		}
+		sections = append(sections, failures)
+	}
+	return sections
+}
@@ -0,0 +1,79 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// Poster는 리포트를 게시할 Slack 클라이언트 인터페이스입니다.
+type Poster interface {
+	PostMessage(ctx context.Context, channelID string, blocks []slack.Block, text string) error
+}
+
+// Section은 리포트의 한 단락입니다.
+type Section struct {
+	Title string
+	Lines []string
+}
+
+// Report는 일간/주간 요약 리포트입니다.
+type Report struct {
+	Title    string
+	Since    time.Time
+	Until    time.Time
+	Sections []Section
+}
+
+// Render는 리포트를 Slack Block Kit 메시지로 변환합니다. (블록, 폴백 텍스트)
+func (r Report) Render() ([]slack.Block, string) {
+	period := r.Since.Format("01/02 15:04") + " ~ " + r.Until.Format("01/02 15:04")
+
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, r.Title, true, false)),
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, period, false, false)),
+	}
+	for _, section := range r.Sections {
+		text := "*" + section.Title + "*\n" + strings.Join(section.Lines, "\n")
+		blocks = append(blocks, slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil))
+	}
+
+	return blocks, fmt.Sprintf("%s (%s)", r.Title, period)
+}
+
+// Text는 리포트를 일반 텍스트로 변환합니다. (터미널 출력용)
+func (r Report) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s ~ %s)\n", r.Title, r.Since.Format("2006-01-02 15:04"), r.Until.Format("2006-01-02 15:04"))
+	for _, section := range r.Sections {
+		fmt.Fprintf(&b, "\n[%s]\n", section.Title)
+		for _, line := range section.Lines {
+			fmt.Fprintln(&b, line)
+		}
+	}
+	return b.String()
+}
+
+// Post는 리포트를 channelID에 게시합니다.
+func (r Report) Post(ctx context.Context, poster Poster, channelID string) error {
+	blocks, text := r.Render()
+	if err := poster.PostMessage(ctx, channelID, blocks, text); err != nil {
+		return fmt.Errorf("리포트 게시 실패: %w", err)
+	}
+	return nil
+}
+
+// formatDuration은 소요 시간을 "1h23m" 형태로 표시합니다.
+func formatDuration(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "<1m"
+	}
+	return strings.TrimSuffix(d.String(), "0s")
+}
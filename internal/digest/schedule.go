@@ -0,0 +1,115 @@
+// Package digest는 AI Worker/Email Monitor의 일간·주간 요약 리포트를 만들고 정해진 시각에 Slack으로 게시합니다.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Period는 리포트 주기입니다.
+type Period string
+
+const (
+	PeriodDaily  Period = "daily"
+	PeriodWeekly Period = "weekly"
+)
+
+// Schedule은 리포트 게시 일정입니다.
+type Schedule struct {
+	Period   Period
+	Hour     int
+	Minute   int
+	Weekday  time.Weekday   // 주간 리포트 게시 요일
+	Location *time.Location // 게시 시각 기준 시간대
+}
+
+// weekdays는 요일 약어 → time.Weekday 맵입니다.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseSchedule은 설정 문자열로 일정을 생성합니다.
+// period는 daily/weekly, at은 "HH:MM"(기본 09:00), weekday는 mon~sun(기본 mon), timezone은 IANA 이름(기본 로컬)입니다.
+func ParseSchedule(period, at, weekday, timezone string) (Schedule, error) {
+	s := Schedule{Period: Period(period), Hour: 9, Weekday: time.Monday, Location: time.Local}
+
+	if s.Period != PeriodDaily && s.Period != PeriodWeekly {
+		return Schedule{}, fmt.Errorf("알 수 없는 리포트 주기: %q (daily, weekly)", period)
+	}
+
+	if at != "" {
+		t, err := time.Parse("15:04", at)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("리포트 시각 파싱 실패: %q (HH:MM)", at)
+		}
+		s.Hour, s.Minute = t.Hour(), t.Minute()
+	}
+
+	if weekday != "" {
+		wd, ok := weekdays[strings.ToLower(weekday)[:min(3, len(weekday))]]
+		if !ok {
+			return Schedule{}, fmt.Errorf("알 수 없는 요일: %q", weekday)
+		}
+		s.Weekday = wd
+	}
+
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("시간대 로드 실패: %w", err)
+		}
+		s.Location = loc
+	}
+
+	return s, nil
+}
+
+// Next는 now 이후 다음 게시 시각을 반환합니다.
+func (s Schedule) Next(now time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	local := now.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.Hour, s.Minute, 0, 0, loc)
+
+	if s.Period == PeriodWeekly {
+		next = next.AddDate(0, 0, (int(s.Weekday)-int(next.Weekday())+7)%7)
+		if !next.After(local) {
+			next = next.AddDate(0, 0, 7)
+		}
+		return next
+	}
+
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Window는 until 시각에 게시하는 리포트의 집계 시작 시각을 반환합니다.
+func (s Schedule) Window(until time.Time) time.Time {
+	if s.Period == PeriodWeekly {
+		return until.AddDate(0, 0, -7)
+	}
+	return until.AddDate(0, 0, -1)
+}
+
+// Run은 ctx가 취소될 때까지 게시 시각마다 fn을 호출합니다.
+// fn은 집계 구간 [since, until)을 받습니다.
+func Run(ctx context.Context, s Schedule, fn func(ctx context.Context, since, until time.Time)) {
+	for {
+		next := s.Next(time.Now())
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			fn(ctx, s.Window(next), next)
+		}
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/zime/slickwebhook/internal/digest"
 	"github.com/zime/slickwebhook/internal/domain"
 	"github.com/zime/slickwebhook/internal/gmail"
 	"github.com/zime/slickwebhook/internal/handler"
+	"github.com/zime/slickwebhook/internal/history"
 	"github.com/zime/slickwebhook/internal/store"
 )
 
@@ -34,6 +36,15 @@ type Service struct {
 	mu             sync.Mutex
 	stopChan       chan struct{}
 	running        bool
+	digest         *DigestConfig
+}
+
+// DigestConfig는 정기 요약 리포트 설정입니다.
+type DigestConfig struct {
+	Schedule     digest.Schedule
+	Poster       digest.Poster
+	ChannelID    string
+	HistoryStore history.Store // ClickUp 전송 히스토리 (전송/실패 집계용)
 }
 
 // NewService는 새로운 Email 모니터 서비스를 생성합니다.
@@ -55,6 +66,45 @@ func NewService(config Config, client gmail.Client, eventHandler handler.EventHa
 	}
 }
 
+// SetDigest는 정기 요약 리포트를 설정합니다. Start 전에 호출해야 합니다.
+func (s *Service) SetDigest(config DigestConfig) {
+	s.digest = &config
+}
+
+// BuildDigest는 [since, until) 구간의 이메일 처리 요약 리포트를 생성합니다.
+func (s *Service) BuildDigest(title string, since, until time.Time) (digest.Report, error) {
+	received, err := s.processedStore.CountSince(since)
+	if err != nil {
+		return digest.Report{}, err
+	}
+
+	var records []*history.Record
+	if s.digest != nil && s.digest.HistoryStore != nil {
+		records = s.digest.HistoryStore.GetAll()
+	}
+
+	return digest.Report{
+		Title:    title,
+		Since:    since,
+		Until:    until,
+		Sections: digest.EmailSections(digest.NewEmailStats(received, records, since, until)),
+	}, nil
+}
+
+// postDigest는 요약 리포트를 생성해 Slack에 게시합니다.
+func (s *Service) postDigest(ctx context.Context, since, until time.Time) {
+	report, err := s.BuildDigest("📊 Email Monitor 리포트", since, until)
+	if err != nil {
+		s.logger.Printf("[WARN] ⚠️ 리포트 생성 실패: %v\n", err)
+		return
+	}
+	if err := report.Post(ctx, s.digest.Poster, s.digest.ChannelID); err != nil {
+		s.logger.Printf("[WARN] ⚠️ %v\n", err)
+		return
+	}
+	s.logger.Println("[INFO] 📊 요약 리포트 게시 완료")
+}
+
 // Start는 모니터링을 시작합니다.
 func (s *Service) Start(ctx context.Context) error {
 	s.mu.Lock()
@@ -66,6 +116,15 @@ func (s *Service) Start(ctx context.Context) error {
 	s.stopChan = make(chan struct{})
 	s.mu.Unlock()
 
+	// Stop/컨텍스트 취소 시 리포트 스케줄러도 함께 종료
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.digest != nil && s.digest.Poster != nil && s.digest.ChannelID != "" {
+		go digest.Run(ctx, s.digest.Schedule, s.postDigest)
+		s.logger.Printf("[INFO] 📊 요약 리포트 예약 (%s, 다음: %s)\n", s.digest.Schedule.Period, s.digest.Schedule.Next(time.Now()).Format("2006-01-02 15:04"))
+	}
+
 	s.logger.Printf("[INFO] 📧 서비스 시작 (폴링 간격: %v)\n", s.config.PollInterval)
 
 	if s.config.LookbackDuration > 0 {
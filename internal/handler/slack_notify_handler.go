@@ -10,6 +10,8 @@ import (
 
 	"github.com/slack-go/slack"
 	"github.com/zime/slickwebhook/internal/domain"
+	"github.com/zime/slickwebhook/internal/notifier"
+	"github.com/zime/slickwebhook/internal/notifyformatter"
 	"github.com/zime/slickwebhook/internal/store"
 )
 
@@ -19,6 +21,15 @@ type SlackNotifier interface {
 	PostMessage(ctx context.Context, channelID string, blocks []slack.Block, text string) error
 }
 
+// NotificationDispatcher는 이벤트 종류별로 알림 싱크에 라우팅하는 인터페이스입니다.
+// notifier.Registry가 구현합니다.
+type NotificationDispatcher interface {
+	Notify(ctx context.Context, msg *notifier.Message, exclude ...string) error
+}
+
+// EmailEventType은 새 이메일 알림의 라우팅 키입니다.
+const EmailEventType = string(notifyformatter.EventEmailNew)
+
 // JiraClient는 Jira API 클라이언트 인터페이스입니다.
 type JiraClient interface {
 	GetIssueSummary(ctx context.Context, issueKey string) (string, error)
@@ -30,9 +41,10 @@ type SlackNotifyHandler struct {
 	channelID      string
 	logger         *log.Logger
 	enabled        bool
-	jiraBaseURL    string               // Jira 이슈 링크용 (예: https://example.atlassian.net)
+	formatter      *notifyformatter.Formatter
 	jiraClient     JiraClient           // Jira API 클라이언트 (이슈 타이틀 조회용)
 	jiraIssueStore store.JiraIssueStore // Jira 이슈 중복 체크 저장소 (ClickUp과 공유)
+	dispatcher     NotificationDispatcher
 }
 
 // SlackNotifyHandlerConfig는 SlackNotifyHandler 설정입니다.
@@ -44,18 +56,31 @@ type SlackNotifyHandlerConfig struct {
 	JiraBaseURL    string               // Jira 이슈 링크용 (예: https://example.atlassian.net)
 	JiraClient     JiraClient           // Jira API 클라이언트 (이슈 타이틀 조회용)
 	JiraIssueStore store.JiraIssueStore // Jira 이슈 중복 체크 저장소 (ClickUp과 공유)
+	// Dispatcher가 설정되면 Client 대신 라우팅 설정에 따라 전송합니다 (Slack 포함 시 Slack 싱크 등록 필요)
+	Dispatcher NotificationDispatcher
+	// Formatter가 없으면 JiraBaseURL과 기본 템플릿으로 생성합니다 (템플릿 오버라이드 시 직접 주입)
+	Formatter *notifyformatter.Formatter
 }
 
 // NewSlackNotifyHandler는 새로운 SlackNotifyHandler를 생성합니다.
 func NewSlackNotifyHandler(config SlackNotifyHandlerConfig) *SlackNotifyHandler {
+	formatter := config.Formatter
+	if formatter == nil {
+		formatterConfig := notifyformatter.DefaultConfig()
+		formatterConfig.JiraBaseURL = config.JiraBaseURL
+		formatterConfig.Footer = "Email Monitor 자동 알림"
+		formatter, _ = notifyformatter.NewFormatter(formatterConfig) // 기본 템플릿은 파싱 오류 없음
+	}
+
 	return &SlackNotifyHandler{
 		client:         config.Client,
 		channelID:      config.ChannelID,
 		logger:         config.Logger,
 		enabled:        config.Enabled,
-		jiraBaseURL:    config.JiraBaseURL,
+		formatter:      formatter,
 		jiraClient:     config.JiraClient,
 		jiraIssueStore: config.JiraIssueStore,
+		dispatcher:     config.Dispatcher,
 	}
 }
 
@@ -99,13 +124,19 @@ func (h *SlackNotifyHandler) Handle(event *domain.Event) {
 
 	h.logger.Printf("[SLACK_NOTIFY] 📤 Slack 알림 전송 중...\n")
 
-	blocks := h.buildEmailBlocks(msg)
-	fallbackText := fmt.Sprintf("새 이메일: %s", msg.Subject)
+	email := h.buildEmail(msg)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if err := h.client.PostMessage(ctx, h.channelID, blocks, fallbackText); err != nil {
+	var err error
+	if h.dispatcher != nil {
+		err = h.dispatcher.Notify(ctx, h.formatter.EmailMessage(email))
+	} else {
+		blocks, fallbackText := h.formatter.RenderEmail(email)
+		err = h.client.PostMessage(ctx, h.channelID, blocks, fallbackText)
+	}
+	if err != nil {
 		h.logger.Printf("[SLACK_NOTIFY] ❌ 전송 실패: %v\n", err)
 		return
 	}
@@ -114,109 +145,37 @@ func (h *SlackNotifyHandler) Handle(event *domain.Event) {
 	// Note: DB 저장은 ClickUp 핸들러에서 성공 시 수행
 }
 
-// buildEmailBlocks는 이메일용 Slack Block을 생성합니다.
-func (h *SlackNotifyHandler) buildEmailBlocks(msg *domain.Message) []slack.Block {
-	blocks := make([]slack.Block, 0, 6)
-
-	// 1. Header Block
-	headerText := slack.NewTextBlockObject(slack.PlainTextType, "📧 새 이메일 알림", true, false)
-	blocks = append(blocks, slack.NewHeaderBlock(headerText))
-
-	// 2. 메타 정보 Section Block
-	// Jira 이메일인 경우 제목을 이슈키 + 이슈타이틀 형식으로 변환
+// buildEmail은 이메일 메시지를 알림 포맷터 입력으로 변환합니다.
+// Jira 이메일인 경우 제목을 이슈키 + 이슈타이틀 형식으로 변환합니다.
+func (h *SlackNotifyHandler) buildEmail(msg *domain.Message) notifyformatter.Email {
 	displaySubject := msg.Subject
 	if strings.Contains(msg.Subject, "[Jira]") {
 		displaySubject = h.formatJiraSubjectWithAPI(msg.Subject)
 	}
 
-	metaText := fmt.Sprintf(
-		"*발신자:* %s\n*제목:* %s\n*시간:* %s",
-		escapeSlackText(msg.From),
-		escapeSlackText(displaySubject),
-		msg.CreatedAt.Format("2006-01-02 15:04:05"),
-	)
-
-	// Jira 링크가 있으면 추가
-	jiraLinks := h.extractJiraLinks(msg.Subject, msg.Text)
-	if jiraLinks != "" {
-		metaText += fmt.Sprintf("\n*🔗 Jira 이슈:* %s", jiraLinks)
-	}
-
-	metaBlock := slack.NewTextBlockObject(slack.MarkdownType, metaText, false, false)
-	blocks = append(blocks, slack.NewSectionBlock(metaBlock, nil, nil))
-
-	// 3. 본문 미리보기 Section Block (최대 300자)
-	preview := truncateTextForSlack(msg.Text, 300)
-	if preview != "" {
-		// 줄바꿈을 적절히 처리하고 인용 형식으로 표시
-		preview = strings.ReplaceAll(preview, "\n", "\n> ")
-		bodyText := fmt.Sprintf("> %s", escapeSlackText(preview))
-		bodyBlock := slack.NewTextBlockObject(slack.MarkdownType, bodyText, false, false)
-		blocks = append(blocks, slack.NewSectionBlock(bodyBlock, nil, nil))
+	return notifyformatter.Email{
+		From:     msg.From,
+		Subject:  displaySubject,
+		Text:     msg.Text,
+		JiraKeys: extractJiraIssueKeys(msg.Subject + " " + msg.Text),
+		Time:     msg.CreatedAt,
 	}
-
-	// 4. Divider
-	blocks = append(blocks, slack.NewDividerBlock())
-
-	// 5. Context Block (푸터)
-	contextText := slack.NewTextBlockObject(slack.PlainTextType, "Email Monitor 자동 알림", true, false)
-	blocks = append(blocks, slack.NewContextBlock("", contextText))
-
-	return blocks
 }
 
-// extractJiraLinks는 텍스트에서 Jira 이슈 키를 추출하고 링크를 생성합니다.
-func (h *SlackNotifyHandler) extractJiraLinks(subject, body string) string {
-	if h.jiraBaseURL == "" {
-		return ""
-	}
-
+// extractJiraIssueKeys는 텍스트에서 Jira 이슈 키를 중복 없이 추출합니다.
+func extractJiraIssueKeys(text string) []string {
 	// Jira 이슈 키 패턴 (예: ITSM-1234, PROJ-123)
 	issuePattern := regexp.MustCompile(`[A-Z][A-Z0-9]+-\d+`)
 
-	// 제목과 본문에서 이슈 키 추출
-	combinedText := subject + " " + body
-	matches := issuePattern.FindAllString(combinedText, -1)
-
-	if len(matches) == 0 {
-		return ""
-	}
-
-	// 중복 제거
 	seen := make(map[string]bool)
-	var uniqueKeys []string
-	for _, key := range matches {
+	var keys []string
+	for _, key := range issuePattern.FindAllString(text, -1) {
 		if !seen[key] {
 			seen[key] = true
-			uniqueKeys = append(uniqueKeys, key)
+			keys = append(keys, key)
 		}
 	}
-
-	// Slack 링크 생성 (<URL|텍스트> 형식)
-	baseURL := strings.TrimSuffix(h.jiraBaseURL, "/")
-	var links []string
-	for _, key := range uniqueKeys {
-		links = append(links, fmt.Sprintf("<%s/browse/%s|%s>", baseURL, key, key))
-	}
-
-	return strings.Join(links, ", ")
-}
-
-// escapeSlackText는 Slack 특수문자를 이스케이프합니다.
-func escapeSlackText(text string) string {
-	text = strings.ReplaceAll(text, "&", "&amp;")
-	text = strings.ReplaceAll(text, "<", "&lt;")
-	text = strings.ReplaceAll(text, ">", "&gt;")
-	return text
-}
-
-// truncateTextForSlack는 텍스트를 지정된 길이로 자릅니다.
-func truncateTextForSlack(text string, maxLen int) string {
-	text = strings.TrimSpace(text)
-	if len(text) <= maxLen {
-		return text
-	}
-	return text[:maxLen] + "..."
+	return keys
 }
 
 // extractJiraIssueTitle은 이메일 본문에서 Jira 이슈 타이틀을 추출합니다.
@@ -3,14 +3,19 @@ package handler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/slack-go/slack"
 	"github.com/zime/slickwebhook/internal/domain"
+	"github.com/zime/slickwebhook/internal/notifier"
 )
 
 // MockSlackNotifier는 테스트용 Slack 클라이언트입니다.
@@ -79,6 +84,61 @@ func TestSlackNotifyHandler_Handle(t *testing.T) {
 	}
 }
 
+// TestSlackNotifyHandler_Dispatcher는 라우팅 설정에 따라 Slack과 Webhook으로 전송되는지 테스트합니다.
+func TestSlackNotifyHandler_Dispatcher(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.New(&buf, "", 0)
+	mockClient := &MockSlackNotifier{}
+
+	var webhookBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookBody, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	registry := notifier.NewRegistry()
+	registry.Register(notifier.NewSlackNotifier(mockClient, "C123456"))
+	registry.Register(notifier.NewWebhookNotifier(server.URL, nil, nil))
+	registry.Route(EmailEventType, notifier.SinkSlack, notifier.SinkWebhook)
+
+	handler := NewSlackNotifyHandler(SlackNotifyHandlerConfig{
+		Client:      mockClient,
+		ChannelID:   "C123456",
+		Logger:      logger,
+		Enabled:     true,
+		JiraBaseURL: "https://example.atlassian.net",
+		Dispatcher:  registry,
+	})
+
+	handler.Handle(domain.NewMessageEvent(&domain.Message{
+		Source:    "email",
+		Subject:   "[Jira] ITSM-1234 로그인 오류",
+		From:      "jira@example.com",
+		Text:      "본문",
+		CreatedAt: time.Now(),
+	}))
+
+	if mockClient.callCount != 1 || len(mockClient.lastBlocks) == 0 {
+		t.Errorf("Slack은 Block과 함께 1회 전송되어야 함: %d", mockClient.callCount)
+	}
+
+	var payload struct {
+		EventType string `json:"event_type"`
+		Links     []struct {
+			URL string
+		} `json:"links"`
+	}
+	if err := json.Unmarshal(webhookBody, &payload); err != nil {
+		t.Fatalf("Webhook 페이로드 파싱 실패: %v", err)
+	}
+	if payload.EventType != EmailEventType {
+		t.Errorf("event_type 불일치: %s", payload.EventType)
+	}
+	if len(payload.Links) != 1 || payload.Links[0].URL != "https://example.atlassian.net/browse/ITSM-1234" {
+		t.Errorf("Jira 링크 불일치: %+v", payload.Links)
+	}
+}
+
 // TestSlackNotifyHandler_Disabled는 비활성화 시 처리를 테스트합니다.
 func TestSlackNotifyHandler_Disabled(t *testing.T) {
 	mockClient := &MockSlackNotifier{}
@@ -171,7 +231,7 @@ func TestBuildEmailBlocks(t *testing.T) {
 		CreatedAt: time.Date(2025, 1, 7, 14, 30, 0, 0, time.UTC),
 	}
 
-	blocks := handler.buildEmailBlocks(msg)
+	blocks, _ := handler.formatter.RenderEmail(handler.buildEmail(msg))
 
 	// Block 수 확인 (Header + Meta + Body + Divider + Context = 5)
 	if len(blocks) != 5 {
@@ -179,47 +239,6 @@ func TestBuildEmailBlocks(t *testing.T) {
 	}
 }
 
-// TestEscapeSlackText는 특수문자 이스케이프를 테스트합니다.
-func TestEscapeSlackText(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{"hello", "hello"},
-		{"<script>", "&lt;script&gt;"},
-		{"a & b", "a &amp; b"},
-		{"<test>&value</test>", "&lt;test&gt;&amp;value&lt;/test&gt;"},
-	}
-
-	for _, tt := range tests {
-		result := escapeSlackText(tt.input)
-		if result != tt.expected {
-			t.Errorf("escapeSlackText(%q) = %q, want %q", tt.input, result, tt.expected)
-		}
-	}
-}
-
-// TestTruncateTextForSlack는 텍스트 자르기를 테스트합니다.
-func TestTruncateTextForSlack(t *testing.T) {
-	tests := []struct {
-		input    string
-		maxLen   int
-		expected string
-	}{
-		{"hello", 10, "hello"},
-		{"hello world", 5, "hello..."},
-		{"  trimmed  ", 20, "trimmed"},
-		{"", 10, ""},
-	}
-
-	for _, tt := range tests {
-		result := truncateTextForSlack(tt.input, tt.maxLen)
-		if result != tt.expected {
-			t.Errorf("truncateTextForSlack(%q, %d) = %q, want %q", tt.input, tt.maxLen, result, tt.expected)
-		}
-	}
-}
-
 // TestExtractJiraLinks는 Jira 링크 추출을 테스트합니다.
 func TestExtractJiraLinks(t *testing.T) {
 	handler := NewSlackNotifyHandler(SlackNotifyHandlerConfig{
@@ -268,9 +287,10 @@ func TestExtractJiraLinks(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := handler.extractJiraLinks(tt.subject, tt.body)
+			email := handler.buildEmail(&domain.Message{Subject: tt.subject, Text: tt.body})
+			result := handler.formatter.JiraLinks(email.JiraKeys)
 			if result != tt.expected {
-				t.Errorf("extractJiraLinks() = %q, want %q", result, tt.expected)
+				t.Errorf("JiraLinks() = %q, want %q", result, tt.expected)
 			}
 		})
 	}
@@ -284,7 +304,8 @@ func TestExtractJiraLinks_NoBaseURL(t *testing.T) {
 		JiraBaseURL: "", // 빈 URL
 	})
 
-	result := handler.extractJiraLinks("[Jira] ITSM-100", "본문")
+	email := handler.buildEmail(&domain.Message{Subject: "[Jira] ITSM-100", Text: "본문"})
+	result := handler.formatter.JiraLinks(email.JiraKeys)
 	if result != "" {
 		t.Errorf("JiraBaseURL이 없을 때는 빈 문자열 반환해야 함, got %q", result)
 	}
@@ -3,6 +3,7 @@ package hookserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -10,15 +11,24 @@ import (
 	"time"
 )
 
+// 페이로드 오류 (HTTP 400 응답 본문으로 사용)
+var (
+	errFailedToParse  = errors.New("Failed to parse payload")
+	errMissingStage   = errors.New("Missing stage")
+	errInvalidVerdict = errors.New("Invalid verdict")
+)
+
 // Server는 Claude Code Hook을 수신하는 HTTP 서버입니다.
 type Server struct {
-	port                 int
-	callback             HookCallback
-	sessionEndCallback   SessionEndCallback
-	planReadyCallback    PlanReadyCallback
-	taskCompleteCallback TaskCompleteCallback
-	httpServer           *http.Server
-	logger               *log.Logger
+	port                  int
+	callback              HookCallback
+	sessionEndCallback    SessionEndCallback
+	planReadyCallback     PlanReadyCallback
+	taskCompleteCallback  TaskCompleteCallback
+	progressCallback      ProgressCallback
+	reviewVerdictCallback ReviewVerdictCallback
+	httpServer            *http.Server
+	logger                *log.Logger
 }
 
 // NewServer는 새 Hook 서버를 생성합니다.
@@ -49,13 +59,25 @@ func (s *Server) SetTaskCompleteCallback(callback TaskCompleteCallback) {
 	s.taskCompleteCallback = callback
 }
 
+// SetProgressCallback은 Progress 콜백을 설정합니다.
+func (s *Server) SetProgressCallback(callback ProgressCallback) {
+	s.progressCallback = callback
+}
+
+// SetReviewVerdictCallback은 리뷰 판정 콜백을 설정합니다.
+func (s *Server) SetReviewVerdictCallback(callback ReviewVerdictCallback) {
+	s.reviewVerdictCallback = callback
+}
+
 // Start는 서버를 시작합니다.
 func (s *Server) Start(ctx context.Context) error {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/hook/stop", s.handleHook)
-	mux.HandleFunc("/hook/session-end", s.handleSessionEnd)
-	mux.HandleFunc("/hook/plan-ready", s.handlePlanReady)
-	mux.HandleFunc("/hook/task-complete", s.handleTaskComplete)
+	mux.HandleFunc("/hook/"+EventStop, s.handleHook)
+	mux.HandleFunc("/hook/"+EventSessionEnd, s.handleSessionEnd)
+	mux.HandleFunc("/hook/"+EventPlanReady, s.handlePlanReady)
+	mux.HandleFunc("/hook/"+EventTaskComplete, s.handleTaskComplete)
+	mux.HandleFunc("/hook/"+EventProgress, s.handleProgress)
+	mux.HandleFunc("/hook/"+EventReviewVerdict, s.handleReviewVerdict)
 	mux.HandleFunc("/health", s.healthHandler)
 
 	addr := fmt.Sprintf(":%d", s.port)
@@ -99,8 +121,29 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	return s.httpServer.Shutdown(ctx)
 }
 
-// handleHook은 Hook 요청을 처리합니다.
-func (s *Server) handleHook(w http.ResponseWriter, r *http.Request) {
+// Dispatch는 Hook 이벤트 페이로드를 파싱하여 해당 콜백을 호출합니다.
+// HTTP 핸들러와 원격 에이전트가 전달한 Hook 이벤트가 같은 경로로 처리됩니다.
+func (s *Server) Dispatch(event string, body []byte) error {
+	switch event {
+	case EventStop:
+		return s.dispatchStop(body)
+	case EventSessionEnd:
+		return s.dispatchSessionEnd(body)
+	case EventPlanReady:
+		return s.dispatchPlanReady(body)
+	case EventTaskComplete:
+		return s.dispatchTaskComplete(body)
+	case EventProgress:
+		return s.dispatchProgress(body)
+	case EventReviewVerdict:
+		return s.dispatchReviewVerdict(body)
+	default:
+		return fmt.Errorf("알 수 없는 Hook 이벤트: %s", event)
+	}
+}
+
+// serveEvent는 POST 요청 본문을 읽어 Dispatch로 처리합니다. 페이로드 오류는 400으로 응답합니다.
+func (s *Server) serveEvent(w http.ResponseWriter, r *http.Request, event string) {
 	// POST 메서드만 허용
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -110,12 +153,28 @@ func (s *Server) handleHook(w http.ResponseWriter, r *http.Request) {
 	// 페이로드 읽기
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		s.logError("페이로드 읽기 실패: %v", err)
+		s.logError("%s 페이로드 읽기 실패: %v", event, err)
 		http.Error(w, "Failed to read body", http.StatusBadRequest)
 		return
 	}
 	defer r.Body.Close()
 
+	if err := s.Dispatch(event, body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+// handleHook은 Hook 요청을 처리합니다.
+func (s *Server) handleHook(w http.ResponseWriter, r *http.Request) {
+	s.serveEvent(w, r, EventStop)
+}
+
+// dispatchStop은 Stop Hook 페이로드를 처리합니다.
+func (s *Server) dispatchStop(body []byte) error {
 	// 원본 페이로드 로깅 (디버깅용)
 	s.logInfo("Stop Hook 원본 데이터: %s", string(body))
 
@@ -123,8 +182,7 @@ func (s *Server) handleHook(w http.ResponseWriter, r *http.Request) {
 	var payload StopHookPayload
 	if err := json.Unmarshal(body, &payload); err != nil {
 		s.logError("페이로드 파싱 실패: %v", err)
-		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
-		return
+		return errFailedToParse
 	}
 
 	s.logInfo("Stop Hook 파싱 결과: cwd=%s, permission_mode=%s, exit_code=%d",
@@ -134,34 +192,21 @@ func (s *Server) handleHook(w http.ResponseWriter, r *http.Request) {
 	if s.callback != nil {
 		s.callback(&payload)
 	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+	return nil
 }
 
 // handleSessionEnd는 SessionEnd Hook 요청을 처리합니다.
 func (s *Server) handleSessionEnd(w http.ResponseWriter, r *http.Request) {
-	// POST 메서드만 허용
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	// 페이로드 읽기
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		s.logError("SessionEnd 페이로드 읽기 실패: %v", err)
-		http.Error(w, "Failed to read body", http.StatusBadRequest)
-		return
-	}
-	defer r.Body.Close()
+	s.serveEvent(w, r, EventSessionEnd)
+}
 
+// dispatchSessionEnd는 SessionEnd Hook 페이로드를 처리합니다.
+func (s *Server) dispatchSessionEnd(body []byte) error {
 	// 페이로드 파싱
 	var payload SessionEndPayload
 	if err := json.Unmarshal(body, &payload); err != nil {
 		s.logError("SessionEnd 페이로드 파싱 실패: %v", err)
-		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
-		return
+		return errFailedToParse
 	}
 
 	// 종료 사유 로깅
@@ -172,35 +217,22 @@ func (s *Server) handleSessionEnd(w http.ResponseWriter, r *http.Request) {
 	if s.sessionEndCallback != nil {
 		s.sessionEndCallback(&payload)
 	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+	return nil
 }
 
 // handlePlanReady는 Claude Code Plan 완료 알림을 처리합니다.
 // Claude가 프롬프트 지시에 따라 curl로 호출합니다.
 func (s *Server) handlePlanReady(w http.ResponseWriter, r *http.Request) {
-	// POST 메서드만 허용
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	// 페이로드 읽기
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		s.logError("PlanReady 페이로드 읽기 실패: %v", err)
-		http.Error(w, "Failed to read body", http.StatusBadRequest)
-		return
-	}
-	defer r.Body.Close()
+	s.serveEvent(w, r, EventPlanReady)
+}
 
+// dispatchPlanReady는 Plan 완료 알림 페이로드를 처리합니다.
+func (s *Server) dispatchPlanReady(body []byte) error {
 	// 페이로드 파싱
 	var payload PlanReadyPayload
 	if err := json.Unmarshal(body, &payload); err != nil {
 		s.logError("PlanReady 페이로드 파싱 실패: %v", err)
-		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
-		return
+		return errFailedToParse
 	}
 
 	s.logInfo("PlanReady 수신: cwd=%s, task=%s", payload.Cwd, payload.TaskName)
@@ -209,35 +241,22 @@ func (s *Server) handlePlanReady(w http.ResponseWriter, r *http.Request) {
 	if s.planReadyCallback != nil {
 		s.planReadyCallback(&payload)
 	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+	return nil
 }
 
 // handleTaskComplete는 작업 완료 알림을 처리합니다.
 // Claude가 프롬프트 지시에 따라 작업 완료 시 curl로 호출합니다.
 func (s *Server) handleTaskComplete(w http.ResponseWriter, r *http.Request) {
-	// POST 메서드만 허용
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	// 페이로드 읽기
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		s.logError("TaskComplete 페이로드 읽기 실패: %v", err)
-		http.Error(w, "Failed to read body", http.StatusBadRequest)
-		return
-	}
-	defer r.Body.Close()
+	s.serveEvent(w, r, EventTaskComplete)
+}
 
+// dispatchTaskComplete는 작업 완료 알림 페이로드를 처리합니다.
+func (s *Server) dispatchTaskComplete(body []byte) error {
 	// 페이로드 파싱
 	var payload TaskCompletePayload
 	if err := json.Unmarshal(body, &payload); err != nil {
 		s.logError("TaskComplete 페이로드 파싱 실패: %v", err)
-		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
-		return
+		return errFailedToParse
 	}
 
 	s.logInfo("TaskComplete 수신: cwd=%s, status=%s", payload.Cwd, payload.Status)
@@ -246,9 +265,65 @@ func (s *Server) handleTaskComplete(w http.ResponseWriter, r *http.Request) {
 	if s.taskCompleteCallback != nil {
 		s.taskCompleteCallback(&payload)
 	}
+	return nil
+}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
+// handleProgress는 작업 진행 상황(heartbeat) 알림을 처리합니다.
+// 에이전트가 프롬프트 지시에 따라 주요 단계마다 curl로 호출합니다.
+func (s *Server) handleProgress(w http.ResponseWriter, r *http.Request) {
+	s.serveEvent(w, r, EventProgress)
+}
+
+// dispatchProgress는 진행 상황 알림 페이로드를 처리합니다.
+func (s *Server) dispatchProgress(body []byte) error {
+	// 페이로드 파싱
+	var payload ProgressPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		s.logError("Progress 페이로드 파싱 실패: %v", err)
+		return errFailedToParse
+	}
+
+	if payload.Stage == "" {
+		s.logError("Progress 페이로드에 stage 없음")
+		return errMissingStage
+	}
+
+	s.logInfo("Progress 수신: cwd=%s, stage=%s, message=%s", payload.Cwd, payload.Stage, payload.Message)
+
+	// 콜백 호출
+	if s.progressCallback != nil {
+		s.progressCallback(&payload)
+	}
+	return nil
+}
+
+// handleReviewVerdict는 리뷰 에이전트의 판정을 처리합니다.
+// 리뷰 에이전트가 프롬프트 지시에 따라 리뷰를 마치면 curl로 호출합니다.
+func (s *Server) handleReviewVerdict(w http.ResponseWriter, r *http.Request) {
+	s.serveEvent(w, r, EventReviewVerdict)
+}
+
+// dispatchReviewVerdict는 리뷰 판정 페이로드를 처리합니다.
+func (s *Server) dispatchReviewVerdict(body []byte) error {
+	// 페이로드 파싱
+	var payload ReviewVerdictPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		s.logError("ReviewVerdict 페이로드 파싱 실패: %v", err)
+		return errFailedToParse
+	}
+
+	if payload.Verdict != VerdictApprove && payload.Verdict != VerdictRequestChanges {
+		s.logError("ReviewVerdict 판정 값 오류: %q", payload.Verdict)
+		return errInvalidVerdict
+	}
+
+	s.logInfo("ReviewVerdict 수신: cwd=%s, verdict=%s, comments=%d", payload.Cwd, payload.Verdict, len(payload.Comments))
+
+	// 콜백 호출
+	if s.reviewVerdictCallback != nil {
+		s.reviewVerdictCallback(&payload)
+	}
+	return nil
 }
 
 // getReasonDescription은 종료 사유에 대한 설명을 반환합니다.
@@ -166,3 +166,97 @@ func TestPlanReadyPayload_Parse(t *testing.T) {
 		t.Errorf("PlanTitle 불일치: %s", payload.PlanTitle)
 	}
 }
+
+// TestServer_HandleProgress는 Progress 핸들링을 테스트합니다.
+func TestServer_HandleProgress(t *testing.T) {
+	var receivedPayload *ProgressPayload
+
+	server := NewServer(8081, nil)
+	server.SetProgressCallback(func(payload *ProgressPayload) {
+		receivedPayload = payload
+	})
+
+	payload := ProgressPayload{
+		Cwd:     "/test/project",
+		Stage:   StageTestsWritten,
+		Message: "실패하는 테스트 3개 작성",
+	}
+	body, _ := json.Marshal(payload)
+
+	req := httptest.NewRequest("POST", "/hook/progress", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	server.handleProgress(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("상태코드 불일치: got %d, want %d", w.Code, http.StatusOK)
+	}
+
+	if receivedPayload == nil {
+		t.Fatal("콜백이 호출되어야 함")
+	}
+	if receivedPayload.Stage != StageTestsWritten {
+		t.Errorf("Stage 불일치: got %s, want %s", receivedPayload.Stage, StageTestsWritten)
+	}
+	if receivedPayload.Message != "실패하는 테스트 3개 작성" {
+		t.Errorf("Message 불일치: got %s", receivedPayload.Message)
+	}
+}
+
+// TestServer_HandleProgress_MissingStage는 stage 누락 시 400을 반환하는지 테스트합니다.
+func TestServer_HandleProgress_MissingStage(t *testing.T) {
+	called := false
+	server := NewServer(8081, nil)
+	server.SetProgressCallback(func(payload *ProgressPayload) {
+		called = true
+	})
+
+	req := httptest.NewRequest("POST", "/hook/progress", bytes.NewReader([]byte(`{"cwd": "/test/project"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	server.handleProgress(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("상태코드 불일치: got %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if called {
+		t.Error("stage가 없으면 콜백이 호출되지 않아야 함")
+	}
+}
+
+// TestServer_HandleReviewVerdict는 리뷰 판정 수신과 판정 값 검증을 테스트합니다.
+func TestServer_HandleReviewVerdict(t *testing.T) {
+	var receivedPayload *ReviewVerdictPayload
+	server := NewServer(8081, nil)
+	server.SetReviewVerdictCallback(func(payload *ReviewVerdictPayload) {
+		receivedPayload = payload
+	})
+
+	body := `{"cwd": "/test/project", "verdict": "request_changes", "summary": "테스트 부족", "comments": ["경계값 테스트 추가"]}`
+	req := httptest.NewRequest("POST", "/hook/review-verdict", bytes.NewReader([]byte(body)))
+	w := httptest.NewRecorder()
+	server.handleReviewVerdict(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("상태코드 불일치: got %d, want %d", w.Code, http.StatusOK)
+	}
+	if receivedPayload == nil {
+		t.Fatal("콜백이 호출되어야 함")
+	}
+	if receivedPayload.Verdict != VerdictRequestChanges || len(receivedPayload.Comments) != 1 {
+		t.Errorf("판정 불일치: %+v", receivedPayload)
+	}
+
+	receivedPayload = nil
+	req = httptest.NewRequest("POST", "/hook/review-verdict", bytes.NewReader([]byte(`{"cwd": "/test/project", "verdict": "maybe"}`)))
+	w = httptest.NewRecorder()
+	server.handleReviewVerdict(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("알 수 없는 판정은 400이어야 함: %d", w.Code)
+	}
+	if receivedPayload != nil {
+		t.Error("알 수 없는 판정은 콜백을 호출하지 않아야 함")
+	}
+}
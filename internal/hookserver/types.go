@@ -1,5 +1,15 @@
 package hookserver
 
+// Hook 이벤트 이름 (Hook 경로 /hook/{이벤트})
+const (
+	EventStop          = "stop"
+	EventSessionEnd    = "session-end"
+	EventPlanReady     = "plan-ready"
+	EventTaskComplete  = "task-complete"
+	EventProgress      = "progress"
+	EventReviewVerdict = "review-verdict"
+)
+
 // StopHookPayload는 Claude Code Stop Hook 페이로드입니다.
 type StopHookPayload struct {
 	Cwd            string `json:"cwd"`              // 작업 디렉토리
@@ -54,3 +64,41 @@ type TaskCompletePayload struct {
 
 // TaskCompleteCallback은 작업 완료 알림 수신 시 호출되는 콜백입니다.
 type TaskCompleteCallback func(payload *TaskCompletePayload)
+
+// ProgressPayload는 작업 진행 상황(heartbeat) 알림 페이로드입니다.
+// 에이전트가 프롬프트 지시에 따라 주요 단계마다 curl로 전송합니다.
+type ProgressPayload struct {
+	Cwd     string `json:"cwd"`     // 작업 디렉토리
+	Stage   string `json:"stage"`   // 진행 단계 (예: "investigated", "tests_passed")
+	Message string `json:"message"` // 부가 설명 (선택)
+}
+
+// 진행 단계 상수
+const (
+	StageInvestigated = "investigated"  // 원인 조사 완료
+	StagePlanApproved = "plan_approved" // 계획 승인됨
+	StageTestsWritten = "tests_written" // 테스트 작성 완료
+	StageImplemented  = "implemented"   // 구현 완료
+	StageTestsPassed  = "tests_passed"  // 테스트 통과
+)
+
+// ProgressCallback은 진행 상황 알림 수신 시 호출되는 콜백입니다.
+type ProgressCallback func(payload *ProgressPayload)
+
+// ReviewVerdictPayload는 리뷰 에이전트의 판정 페이로드입니다.
+// 리뷰 에이전트가 프롬프트 지시에 따라 리뷰를 마치면 curl로 전송합니다.
+type ReviewVerdictPayload struct {
+	Cwd      string   `json:"cwd"`      // 작업 디렉토리
+	Verdict  string   `json:"verdict"`  // 판정 (approve, request_changes)
+	Summary  string   `json:"summary"`  // 리뷰 요약
+	Comments []string `json:"comments"` // 수정 요청 항목 (request_changes일 때)
+}
+
+// 리뷰 판정 상수
+const (
+	VerdictApprove        = "approve"         // 승인
+	VerdictRequestChanges = "request_changes" // 수정 요청
+)
+
+// ReviewVerdictCallback은 리뷰 판정 수신 시 호출되는 콜백입니다.
+type ReviewVerdictCallback func(payload *ReviewVerdictPayload)
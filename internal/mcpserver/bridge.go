@@ -0,0 +1,98 @@
+package mcpserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Bridge 환경변수 (stdio MCP 설정의 env로 전달)
+const (
+	EnvURL   = "AI_WORKER_MCP_URL"   // MCP 서버 주소 (예: http://localhost:8083/mcp)
+	EnvToken = "AI_WORKER_MCP_TOKEN" // 세션 토큰
+)
+
+// maxLineBytes는 stdio로 받는 메시지 한 줄의 최대 크기입니다.
+const maxLineBytes = maxBodyBytes
+
+// Bridge는 stdio MCP 클라이언트(에이전트)의 메시지를 HTTP MCP 서버로 전달합니다.
+// 메시지는 줄바꿈으로 구분된 JSON이며, 서버 응답을 같은 형식으로 돌려줍니다.
+type Bridge struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewBridge는 새 Bridge를 생성합니다.
+func NewBridge(url, token string) *Bridge {
+	return &Bridge{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: 40 * time.Second},
+	}
+}
+
+// Run은 in이 닫히거나 컨텍스트가 취소될 때까지 메시지를 전달합니다.
+// 서버 전달에 실패한 요청에는 JSON-RPC 에러 응답을 돌려줘 에이전트가 대기하지 않도록 합니다.
+func (b *Bridge) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxLineBytes)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		resp, err := b.forward(ctx, line)
+		if err != nil {
+			var req request
+			if json.Unmarshal(line, &req) != nil || len(req.ID) == 0 {
+				continue // 알림은 응답하지 않음
+			}
+			resp = encodeResponse(errorResponse(req.ID, codeInvalidRequest, err.Error()))
+		}
+		if len(resp) == 0 {
+			continue
+		}
+		if _, err := out.Write(append(bytes.TrimSpace(resp), '\n')); err != nil {
+			return fmt.Errorf("응답 출력 실패: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("입력 읽기 실패: %w", err)
+	}
+	return nil
+}
+
+// forward는 메시지 하나를 서버에 POST하고 응답 본문을 반환합니다. (알림이면 빈 본문)
+func (b *Bridge) forward(ctx context.Context, message []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(message))
+	if err != nil {
+		return nil, fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	req.Header.Set("Authorization", "Bearer "+b.token)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ai-worker MCP 서버 연결 실패: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusAccepted:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("ai-worker MCP 서버 응답 오류 (HTTP %d): %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+}
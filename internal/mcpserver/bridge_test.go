@@ -0,0 +1,40 @@
+package mcpserver
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestBridge_Run은 stdio 메시지를 HTTP 서버로 전달하고 응답을 줄 단위로 돌려주는지 테스트합니다.
+func TestBridge_Run(t *testing.T) {
+	s := newEchoServer()
+	httpServer := httptest.NewServer(s.Handler())
+	defer httpServer.Close()
+
+	in := strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		``,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"text":"hi"}}}`,
+	}, "\n")
+	var out strings.Builder
+	if err := NewBridge(httpServer.URL+Path, s.SessionToken("AI_01")).Run(context.Background(), strings.NewReader(in), &out); err != nil {
+		t.Fatalf("브리지 실행 실패: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"id":1`) || !strings.Contains(lines[1], "AI_01:hi") {
+		t.Errorf("요청마다 응답 한 줄이어야 함: %q", out.String())
+	}
+
+	// 인증 실패 시 요청에는 JSON-RPC 에러로 응답
+	out.Reset()
+	if err := NewBridge(httpServer.URL+Path, "bad").Run(context.Background(), strings.NewReader(`{"jsonrpc":"2.0","id":7,"method":"ping"}`), &out); err != nil {
+		t.Fatalf("브리지 실행 실패: %v", err)
+	}
+	resp := decodeResponse(t, []byte(out.String()))
+	if resp.Error == nil || string(resp.ID) != "7" || !strings.Contains(resp.Error.Message, "401") {
+		t.Errorf("전달 실패는 요청 ID로 에러 응답해야 함: %s", out.String())
+	}
+}
@@ -0,0 +1,324 @@
+// Package mcpserver는 AI 에이전트 세션에 태스크 컨텍스트와 수명주기 도구를 제공하는 MCP 서버입니다.
+// MCP(Model Context Protocol)의 JSON-RPC 2.0 메시지를 Streamable HTTP(POST 단일 응답)로 처리하며,
+// stdio만 지원하는 에이전트는 Bridge로 연결합니다.
+package mcpserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Path는 MCP 엔드포인트 경로입니다.
+const Path = "/mcp"
+
+// ProtocolVersion은 서버가 기본으로 응답하는 MCP 프로토콜 버전입니다.
+const ProtocolVersion = "2025-06-18"
+
+// supportedVersions는 클라이언트가 요청하면 그대로 응답하는 MCP 프로토콜 버전입니다.
+var supportedVersions = map[string]bool{
+	"2025-06-18": true,
+	"2025-03-26": true,
+	"2024-11-05": true,
+}
+
+// maxBodyBytes는 요청 본문 최대 크기입니다.
+const maxBodyBytes = 1 << 20
+
+// JSON-RPC 에러 코드
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// Session은 인증된 에이전트 세션 정보입니다.
+type Session struct {
+	WorkerID string // 토큰을 발급받은 Worker ID
+}
+
+// Tool은 tools/list로 공개하는 도구 정의입니다.
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	InputSchema json.RawMessage `json:"inputSchema"` // JSON Schema (object)
+}
+
+// ToolHandler는 도구 호출을 처리합니다. 반환한 문자열은 텍스트 결과로, 에러는 isError 결과로 전달됩니다.
+type ToolHandler func(ctx context.Context, session Session, args json.RawMessage) (string, error)
+
+// Server는 MCP 서버입니다.
+type Server struct {
+	name       string
+	version    string
+	secret     string
+	logger     *log.Logger
+	httpServer *http.Server
+
+	mu       sync.RWMutex
+	tools    []Tool
+	handlers map[string]ToolHandler
+}
+
+// NewServer는 새 MCP 서버를 생성합니다. secret은 세션 토큰 서명 키입니다.
+func NewServer(name, version, secret string) *Server {
+	return &Server{
+		name:     name,
+		version:  version,
+		secret:   secret,
+		handlers: make(map[string]ToolHandler),
+	}
+}
+
+// SetLogger는 로거를 설정합니다.
+func (s *Server) SetLogger(logger *log.Logger) {
+	s.logger = logger
+}
+
+// AddTool은 도구를 등록합니다. 같은 이름으로 다시 등록하면 교체합니다.
+func (s *Server) AddTool(tool Tool, handler ToolHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.handlers[tool.Name]; !exists {
+		s.tools = append(s.tools, tool)
+	} else {
+		for i := range s.tools {
+			if s.tools[i].Name == tool.Name {
+				s.tools[i] = tool
+			}
+		}
+	}
+	s.handlers[tool.Name] = handler
+}
+
+// SessionToken은 workerID 세션의 Bearer 토큰을 발급합니다. (형식: <workerID>.<HMAC-SHA256>)
+func (s *Server) SessionToken(workerID string) string {
+	return workerID + "." + s.sign(workerID)
+}
+
+// sign은 workerID의 HMAC 서명을 반환합니다.
+func (s *Server) sign(workerID string) string {
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write([]byte(workerID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// authenticate는 Bearer 토큰을 검증하고 세션을 반환합니다.
+func (s *Server) authenticate(token string) (Session, bool) {
+	i := strings.LastIndex(token, ".")
+	if s.secret == "" || i <= 0 {
+		return Session{}, false
+	}
+	workerID := token[:i]
+	if subtle.ConstantTimeCompare([]byte(token[i+1:]), []byte(s.sign(workerID))) != 1 {
+		return Session{}, false
+	}
+	return Session{WorkerID: workerID}, true
+}
+
+// Start는 port에서 MCP 서버를 시작합니다. 컨텍스트가 취소되면 종료합니다.
+func (s *Server) Start(ctx context.Context, port int) error {
+	addr := fmt.Sprintf(":%d", port)
+	s.httpServer = &http.Server{
+		Addr:         addr,
+		Handler:      s.Handler(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	s.logf("[MCP Server] 시작: %s%s", addr, Path)
+
+	// 컨텍스트 취소 시 서버 종료
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.httpServer.Shutdown(shutdownCtx)
+	}()
+
+	if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("MCP 서버 시작 실패: %w", err)
+	}
+	return nil
+}
+
+// Handler는 MCP 엔드포인트 HTTP 핸들러를 반환합니다.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(Path, s.handleMCP)
+	return mux
+}
+
+// handleMCP는 POST로 받은 JSON-RPC 메시지 하나를 처리합니다.
+// 요청은 JSON 응답으로, 알림(id 없음)은 202로 응답합니다. 서버 발신 스트림(GET)은 지원하지 않습니다.
+func (s *Server) handleMCP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	session, ok := s.authenticate(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	resp := s.HandleMessage(r.Context(), session, body)
+	if resp == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// request는 JSON-RPC 요청/알림입니다.
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response는 JSON-RPC 응답입니다.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError는 JSON-RPC 에러 객체입니다.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// HandleMessage는 JSON-RPC 메시지 하나를 처리하고 응답을 반환합니다. 알림이면 nil을 반환합니다.
+func (s *Server) HandleMessage(ctx context.Context, session Session, body []byte) []byte {
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return encodeResponse(errorResponse(json.RawMessage("null"), codeParseError, "JSON 파싱 실패"))
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		id := req.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return encodeResponse(errorResponse(id, codeInvalidRequest, "잘못된 JSON-RPC 요청"))
+	}
+	// 알림(notifications/initialized 등)은 응답하지 않음
+	if len(req.ID) == 0 {
+		return nil
+	}
+
+	result, rpcErr := s.dispatch(ctx, session, req)
+	if rpcErr != nil {
+		return encodeResponse(response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr})
+	}
+	return encodeResponse(response{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+// dispatch는 메서드별로 요청을 처리합니다.
+func (s *Server) dispatch(ctx context.Context, session Session, req request) (interface{}, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		json.Unmarshal(req.Params, &params)
+		version := ProtocolVersion
+		if supportedVersions[params.ProtocolVersion] {
+			version = params.ProtocolVersion
+		}
+		s.logf("[MCP Server] 세션 시작: %s (protocol %s)", session.WorkerID, version)
+		return map[string]interface{}{
+			"protocolVersion": version,
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]string{"name": s.name, "version": s.version},
+		}, nil
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return map[string]interface{}{"tools": s.tools}, nil
+	case "tools/call":
+		return s.callTool(ctx, session, req.Params)
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "지원하지 않는 메서드: " + req.Method}
+	}
+}
+
+// toolResult는 tools/call 결과입니다.
+type toolResult struct {
+	Content []textContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+// textContent는 텍스트 결과 항목입니다.
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// callTool은 도구를 실행합니다. 도구 실행 에러는 에이전트가 읽을 수 있도록 isError 결과로 반환합니다.
+func (s *Server) callTool(ctx context.Context, session Session, raw json.RawMessage) (interface{}, *rpcError) {
+	var params struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(raw, &params); err != nil || params.Name == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "도구 이름 없음"}
+	}
+	s.mu.RLock()
+	handler, ok := s.handlers[params.Name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "알 수 없는 도구: " + params.Name}
+	}
+	if len(params.Arguments) == 0 || string(params.Arguments) == "null" {
+		params.Arguments = json.RawMessage("{}")
+	}
+
+	text, err := handler(ctx, session, params.Arguments)
+	if err != nil {
+		s.logf("[MCP Server] %s %s 실패: %v", session.WorkerID, params.Name, err)
+		return toolResult{Content: []textContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	s.logf("[MCP Server] %s %s 호출", session.WorkerID, params.Name)
+	return toolResult{Content: []textContent{{Type: "text", Text: text}}}, nil
+}
+
+// errorResponse는 JSON-RPC 에러 응답을 만듭니다.
+func errorResponse(id json.RawMessage, code int, message string) response {
+	return response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
+
+// encodeResponse는 응답을 JSON으로 인코딩합니다.
+func encodeResponse(resp response) []byte {
+	data, _ := json.Marshal(resp)
+	return data
+}
+
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Printf(format, args...)
+	}
+}
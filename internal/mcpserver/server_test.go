@@ -0,0 +1,142 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// post는 token으로 인증한 JSON-RPC 메시지를 서버 핸들러에 보냅니다.
+func post(s *Server, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+	return w
+}
+
+// decodeResponse는 JSON-RPC 응답을 파싱합니다.
+func decodeResponse(t *testing.T, data []byte) response {
+	t.Helper()
+	var resp struct {
+		response
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v: %s", err, data)
+	}
+	resp.response.Result = resp.Result
+	return resp.response
+}
+
+func newEchoServer() *Server {
+	s := NewServer("ai-worker", "test", "secret")
+	s.AddTool(Tool{Name: "echo", InputSchema: json.RawMessage(`{"type":"object"}`)},
+		func(ctx context.Context, session Session, args json.RawMessage) (string, error) {
+			var a struct {
+				Text string `json:"text"`
+			}
+			json.Unmarshal(args, &a)
+			if a.Text == "" {
+				return "", errors.New("text 없음")
+			}
+			return session.WorkerID + ":" + a.Text, nil
+		})
+	return s
+}
+
+// TestServer_Auth는 Worker별 세션 토큰 검증을 테스트합니다.
+func TestServer_Auth(t *testing.T) {
+	s := newEchoServer()
+	token := s.SessionToken("AI_01")
+	if !strings.HasPrefix(token, "AI_01.") {
+		t.Errorf("토큰 형식 불일치: %s", token)
+	}
+
+	ping := `{"jsonrpc":"2.0","id":1,"method":"ping"}`
+	if w := post(s, token, ping); w.Code != http.StatusOK {
+		t.Errorf("유효한 토큰은 허용되어야 함: %d", w.Code)
+	}
+	for _, bad := range []string{"", "AI_01", "AI_02" + token[len("AI_01"):], NewServer("x", "v", "other").SessionToken("AI_01")} {
+		if w := post(s, bad, ping); w.Code != http.StatusUnauthorized {
+			t.Errorf("잘못된 토큰 %q는 거부되어야 함: %d", bad, w.Code)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, Path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET은 지원하지 않아야 함: %d", w.Code)
+	}
+}
+
+// TestServer_Lifecycle은 initialize, 알림, tools/list, 알 수 없는 메서드 처리를 테스트합니다.
+func TestServer_Lifecycle(t *testing.T) {
+	s := newEchoServer()
+	token := s.SessionToken("AI_01")
+
+	w := post(s, token, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"claude","version":"1"}}}`)
+	resp := decodeResponse(t, w.Body.Bytes())
+	var init struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ServerInfo      struct {
+			Name string `json:"name"`
+		} `json:"serverInfo"`
+		Capabilities map[string]json.RawMessage `json:"capabilities"`
+	}
+	json.Unmarshal(resp.Result.(json.RawMessage), &init)
+	if init.ProtocolVersion != "2025-03-26" || init.ServerInfo.Name != "ai-worker" || init.Capabilities["tools"] == nil {
+		t.Errorf("initialize 응답 불일치: %s", w.Body.String())
+	}
+
+	w = post(s, token, `{"jsonrpc":"2.0","id":2,"method":"initialize","params":{"protocolVersion":"1999-01-01"}}`)
+	if !strings.Contains(w.Body.String(), ProtocolVersion) {
+		t.Errorf("지원하지 않는 버전은 기본 버전으로 응답해야 함: %s", w.Body.String())
+	}
+
+	if w := post(s, token, `{"jsonrpc":"2.0","method":"notifications/initialized"}`); w.Code != http.StatusAccepted || w.Body.Len() != 0 {
+		t.Errorf("알림은 본문 없이 202여야 함: %d %q", w.Code, w.Body.String())
+	}
+
+	w = post(s, token, `{"jsonrpc":"2.0","id":"a","method":"tools/list"}`)
+	if !strings.Contains(w.Body.String(), `"name":"echo"`) || !strings.Contains(w.Body.String(), `"id":"a"`) {
+		t.Errorf("tools/list 응답 불일치: %s", w.Body.String())
+	}
+
+	resp = decodeResponse(t, post(s, token, `{"jsonrpc":"2.0","id":3,"method":"resources/list"}`).Body.Bytes())
+	if resp.Error == nil || resp.Error.Code != codeMethodNotFound {
+		t.Errorf("알 수 없는 메서드는 -32601이어야 함: %+v", resp.Error)
+	}
+
+	resp = decodeResponse(t, post(s, token, `{not json`).Body.Bytes())
+	if resp.Error == nil || resp.Error.Code != codeParseError {
+		t.Errorf("파싱 실패는 -32700이어야 함: %+v", resp.Error)
+	}
+}
+
+// TestServer_CallTool은 도구 호출 결과, 도구 에러, 알 수 없는 도구 처리를 테스트합니다.
+func TestServer_CallTool(t *testing.T) {
+	s := newEchoServer()
+	token := s.SessionToken("AI_02")
+
+	w := post(s, token, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"echo","arguments":{"text":"hi"}}}`)
+	if !strings.Contains(w.Body.String(), `"text":"AI_02:hi"`) || strings.Contains(w.Body.String(), "isError") {
+		t.Errorf("도구 결과는 세션 Worker로 실행되어야 함: %s", w.Body.String())
+	}
+
+	w = post(s, token, `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo"}}`)
+	if !strings.Contains(w.Body.String(), `"isError":true`) || !strings.Contains(w.Body.String(), "text 없음") {
+		t.Errorf("도구 에러는 isError 결과여야 함: %s", w.Body.String())
+	}
+
+	resp := decodeResponse(t, post(s, token, `{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"unknown"}}`).Body.Bytes())
+	if resp.Error == nil || resp.Error.Code != codeInvalidParams {
+		t.Errorf("알 수 없는 도구는 -32602여야 함: %+v", resp.Error)
+	}
+}
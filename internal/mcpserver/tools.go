@@ -0,0 +1,384 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+	"github.com/zime/slickwebhook/internal/hookserver"
+	"github.com/zime/slickwebhook/internal/jira"
+)
+
+// MaxTaskComments는 get_task가 반환하는 최근 댓글 최대 개수입니다.
+const MaxTaskComments = 20
+
+// progressStages는 post_progress가 허용하는 진행 단계입니다.
+var progressStages = []string{
+	hookserver.StageInvestigated,
+	hookserver.StagePlanApproved,
+	hookserver.StageTestsWritten,
+	hookserver.StageImplemented,
+	hookserver.StageTestsPassed,
+}
+
+// errNoTask는 세션의 Worker가 처리 중인 태스크가 없을 때 반환됩니다.
+var errNoTask = errors.New("처리 중인 태스크 없음")
+
+// TaskInfo는 도구가 참조하는 Worker의 현재 태스크 정보입니다.
+type TaskInfo struct {
+	WorkerID string
+	TaskID   string
+	TaskName string
+	JiraID   string
+	SrcPath  string // Hook 이벤트의 cwd (Worker 작업 경로)
+}
+
+// TaskClient는 태스크 조회와 댓글 작성을 지원하는 ClickUp 클라이언트 인터페이스입니다.
+type TaskClient interface {
+	GetTask(ctx context.Context, taskID string) (*clickup.Task, error)
+	GetComments(ctx context.Context, taskID string) ([]clickup.Comment, error)
+	AddComment(ctx context.Context, taskID, text string) error
+}
+
+// IssueClient는 Jira 이슈 상세 조회 인터페이스입니다.
+type IssueClient interface {
+	GetIssueDetail(ctx context.Context, issueKey string) (*jira.IssueDetail, error)
+}
+
+// QuestionHandler는 ask_reporter 질문을 ClickUp 댓글 외의 채널(Slack 스레드 등)로 알립니다.
+type QuestionHandler func(ctx context.Context, task TaskInfo, question string)
+
+// TaskTools는 기존 ClickUp, Jira, Hook 처리 경로를 MCP 도구로 제공합니다.
+// 모든 도구는 세션 토큰의 Worker가 처리 중인 태스크에만 동작합니다.
+type TaskTools struct {
+	resolve  func(workerID string) (TaskInfo, bool)
+	clickup  TaskClient
+	dispatch func(event string, body []byte) error
+	jira     IssueClient
+	ask      QuestionHandler
+	logger   *log.Logger
+}
+
+// NewTaskTools는 새 TaskTools를 생성합니다.
+// resolve는 Worker ID로 처리 중인 태스크를 찾고, dispatch는 Hook 이벤트를 처리합니다. (hookserver.Server.Dispatch)
+func NewTaskTools(resolve func(workerID string) (TaskInfo, bool), clickup TaskClient, dispatch func(event string, body []byte) error) *TaskTools {
+	return &TaskTools{resolve: resolve, clickup: clickup, dispatch: dispatch}
+}
+
+// SetIssueClient는 Jira 클라이언트를 설정합니다. 설정하지 않으면 Jira 도구는 에러를 반환합니다.
+func (t *TaskTools) SetIssueClient(client IssueClient) {
+	t.jira = client
+}
+
+// SetQuestionHandler는 ask_reporter 질문 알림 핸들러를 설정합니다.
+func (t *TaskTools) SetQuestionHandler(handler QuestionHandler) {
+	t.ask = handler
+}
+
+// SetLogger는 로거를 설정합니다.
+func (t *TaskTools) SetLogger(logger *log.Logger) {
+	t.logger = logger
+}
+
+// Register는 서버에 태스크 도구를 등록합니다.
+func (t *TaskTools) Register(s *Server) {
+	s.AddTool(Tool{
+		Name:        "get_task",
+		Description: "현재 작업 중인 ClickUp 태스크(제목, 상태, 설명, 태그, Jira 이슈 키)와 최근 댓글을 조회합니다. ask_reporter 질문의 답변도 댓글로 확인합니다.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+	}, t.getTask)
+	s.AddTool(Tool{
+		Name:        "get_attachments",
+		Description: "현재 태스크의 ClickUp 첨부파일과 연결된 Jira 이슈의 첨부파일 목록(이름, 형식, 크기, URL)을 조회합니다.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+	}, t.getAttachments)
+	s.AddTool(Tool{
+		Name:        "get_jira_issue",
+		Description: "현재 태스크에 연결된 Jira 이슈의 제목, 본문, 첨부파일을 조회합니다.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+	}, t.getJiraIssue)
+	s.AddTool(Tool{
+		Name:        "post_progress",
+		Description: "작업 진행 상황을 보고합니다. 아래 단계에 도달할 때마다 호출하세요: investigated(원인 조사 완료), plan_approved(계획 승인됨), tests_written(테스트 작성 완료), implemented(구현 완료), tests_passed(테스트 통과).",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{"stage":{"type":"string","enum":["` + strings.Join(progressStages, `","`) + `"]},"message":{"type":"string","description":"한 줄 요약"}},"required":["stage"]}`),
+	}, t.postProgress)
+	s.AddTool(Tool{
+		Name:        "ask_reporter",
+		Description: "요구사항이 불명확할 때 태스크 보고자에게 질문합니다. 질문은 ClickUp 댓글과 Slack 스레드로 전달되며, 답변은 나중에 get_task의 댓글에서 확인합니다.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{"question":{"type":"string","description":"질문 내용"}},"required":["question"]}`),
+	}, t.askReporter)
+	s.AddTool(Tool{
+		Name:        "report_plan",
+		Description: "계획 수립을 마치고 검토를 요청할 때 호출합니다.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{"title":{"type":"string","description":"계획 제목"}}}`),
+	}, t.reportPlan)
+	s.AddTool(Tool{
+		Name:        "complete_task",
+		Description: "모든 작업을 마쳤을 때 한 번 호출하여 완료 처리를 요청합니다. 작업이 끝나지 않았거나 에러가 발생한 경우에는 호출하지 마세요.",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+	}, t.completeTask)
+}
+
+// current는 세션 Worker의 처리 중인 태스크를 반환합니다.
+func (t *TaskTools) current(session Session) (TaskInfo, error) {
+	task, ok := t.resolve(session.WorkerID)
+	if !ok {
+		return TaskInfo{}, fmt.Errorf("%w (Worker %s)", errNoTask, session.WorkerID)
+	}
+	return task, nil
+}
+
+// taskView는 get_task 결과입니다.
+type taskView struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Status        string        `json:"status"`
+	URL           string        `json:"url,omitempty"`
+	JiraID        string        `json:"jira_id,omitempty"`
+	Description   string        `json:"description"`
+	Tags          []string      `json:"tags,omitempty"`
+	Comments      []commentView `json:"comments"`
+	CommentsError string        `json:"comments_error,omitempty"`
+}
+
+// commentView는 태스크 댓글입니다.
+type commentView struct {
+	User string `json:"user"`
+	Date string `json:"date,omitempty"`
+	Text string `json:"text"`
+}
+
+func (t *TaskTools) getTask(ctx context.Context, session Session, _ json.RawMessage) (string, error) {
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	task, err := t.clickup.GetTask(ctx, info.TaskID)
+	if err != nil {
+		return "", fmt.Errorf("태스크 조회 실패: %w", err)
+	}
+
+	view := taskView{
+		ID:          task.ID,
+		Name:        task.Name,
+		Status:      task.Status.Status,
+		URL:         task.URL,
+		JiraID:      info.JiraID,
+		Description: task.Description,
+		Comments:    []commentView{},
+	}
+	for _, tag := range task.Tags {
+		view.Tags = append(view.Tags, tag.Name)
+	}
+	// 댓글 조회 실패는 태스크 정보 반환을 막지 않음
+	comments, err := t.clickup.GetComments(ctx, info.TaskID)
+	if err != nil {
+		view.CommentsError = err.Error()
+	}
+	if len(comments) > MaxTaskComments {
+		comments = comments[:MaxTaskComments]
+	}
+	for _, c := range comments {
+		view.Comments = append(view.Comments, commentView{User: c.User.Username, Date: formatMillis(c.Date), Text: c.CommentText})
+	}
+	return encodeResult(view)
+}
+
+// attachmentView는 get_attachments 결과 항목입니다.
+type attachmentView struct {
+	Source   string `json:"source"` // clickup, jira
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type,omitempty"`
+	Size     int64  `json:"size"`
+	URL      string `json:"url"`
+}
+
+func (t *TaskTools) getAttachments(ctx context.Context, session Session, _ json.RawMessage) (string, error) {
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	task, err := t.clickup.GetTask(ctx, info.TaskID)
+	if err != nil {
+		return "", fmt.Errorf("태스크 조회 실패: %w", err)
+	}
+
+	result := struct {
+		Attachments []attachmentView `json:"attachments"`
+		JiraError   string           `json:"jira_error,omitempty"`
+	}{Attachments: []attachmentView{}}
+	for _, a := range task.Attachments {
+		result.Attachments = append(result.Attachments, attachmentView{Source: "clickup", Name: a.Title, MimeType: a.MimeType, Size: a.Size, URL: a.URL})
+	}
+	if t.jira != nil && info.JiraID != "" {
+		issue, err := t.jira.GetIssueDetail(ctx, info.JiraID)
+		if err != nil {
+			result.JiraError = err.Error()
+		} else {
+			for _, a := range issue.Attachments {
+				result.Attachments = append(result.Attachments, jiraAttachment(a))
+			}
+		}
+	}
+	return encodeResult(result)
+}
+
+// jiraAttachment는 Jira 첨부파일을 결과 항목으로 변환합니다.
+func jiraAttachment(a jira.Attachment) attachmentView {
+	return attachmentView{Source: "jira", Name: a.Filename, MimeType: a.MimeType, Size: int64(a.Size), URL: a.Content}
+}
+
+func (t *TaskTools) getJiraIssue(ctx context.Context, session Session, _ json.RawMessage) (string, error) {
+	if t.jira == nil {
+		return "", errors.New("Jira 연동이 설정되지 않음")
+	}
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	if info.JiraID == "" {
+		return "", errors.New("현재 태스크에 연결된 Jira 이슈 없음")
+	}
+	issue, err := t.jira.GetIssueDetail(ctx, info.JiraID)
+	if err != nil {
+		return "", fmt.Errorf("Jira 이슈 조회 실패: %w", err)
+	}
+	view := struct {
+		Key         string           `json:"key"`
+		Summary     string           `json:"summary"`
+		Description string           `json:"description"`
+		Attachments []attachmentView `json:"attachments"`
+	}{Key: issue.Key, Summary: issue.Summary, Description: issue.Description, Attachments: []attachmentView{}}
+	for _, a := range issue.Attachments {
+		view.Attachments = append(view.Attachments, jiraAttachment(a))
+	}
+	return encodeResult(view)
+}
+
+func (t *TaskTools) postProgress(ctx context.Context, session Session, raw json.RawMessage) (string, error) {
+	var args struct {
+		Stage   string `json:"stage"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("인자 파싱 실패: %w", err)
+	}
+	valid := false
+	for _, stage := range progressStages {
+		valid = valid || args.Stage == stage
+	}
+	if !valid {
+		return "", fmt.Errorf("알 수 없는 진행 단계: %q (%s)", args.Stage, strings.Join(progressStages, ", "))
+	}
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	if err := t.dispatchEvent(hookserver.EventProgress, hookserver.ProgressPayload{Cwd: info.SrcPath, Stage: args.Stage, Message: args.Message}); err != nil {
+		return "", err
+	}
+	return "진행 상황 보고 완료: " + args.Stage, nil
+}
+
+func (t *TaskTools) askReporter(ctx context.Context, session Session, raw json.RawMessage) (string, error) {
+	var args struct {
+		Question string `json:"question"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("인자 파싱 실패: %w", err)
+	}
+	question := strings.TrimSpace(args.Question)
+	if question == "" {
+		return "", errors.New("질문 내용 없음")
+	}
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	comment := fmt.Sprintf("❓ AI 에이전트 질문 (%s)\n\n%s", info.WorkerID, question)
+	if err := t.clickup.AddComment(ctx, info.TaskID, comment); err != nil {
+		return "", fmt.Errorf("질문 댓글 작성 실패: %w", err)
+	}
+	if t.ask != nil {
+		t.ask(ctx, info, question)
+	}
+	return "질문을 태스크 댓글로 남겼습니다. 답변은 get_task의 comments에서 확인하세요.", nil
+}
+
+func (t *TaskTools) reportPlan(ctx context.Context, session Session, raw json.RawMessage) (string, error) {
+	var args struct {
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("인자 파싱 실패: %w", err)
+	}
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	payload := hookserver.PlanReadyPayload{Cwd: info.SrcPath, TaskID: info.TaskID, TaskName: info.TaskName, PlanTitle: args.Title}
+	if err := t.dispatchEvent(hookserver.EventPlanReady, payload); err != nil {
+		return "", err
+	}
+	return "계획 검토 요청 완료", nil
+}
+
+// completeTask는 완료 신호를 보냅니다.
+// 완료 처리(TDD 점검, 리뷰, 에이전트 종료)는 도구 응답보다 오래 걸릴 수 있어 비동기로 실행합니다.
+func (t *TaskTools) completeTask(ctx context.Context, session Session, _ json.RawMessage) (string, error) {
+	info, err := t.current(session)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(hookserver.TaskCompletePayload{Cwd: info.SrcPath, Status: "completed"})
+	if err != nil {
+		return "", err
+	}
+	go func() {
+		if err := t.dispatch(hookserver.EventTaskComplete, body); err != nil {
+			t.logf("[MCP Server] ⚠️ %s 완료 처리 실패: %v", info.WorkerID, err)
+		}
+	}()
+	return "완료 신호를 보냈습니다. 추가 작업 없이 종료하세요.", nil
+}
+
+// dispatchEvent는 payload를 Hook 이벤트로 처리합니다.
+func (t *TaskTools) dispatchEvent(event string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := t.dispatch(event, body); err != nil {
+		return fmt.Errorf("%s 이벤트 처리 실패: %w", event, err)
+	}
+	return nil
+}
+
+func (t *TaskTools) logf(format string, args ...interface{}) {
+	if t.logger != nil {
+		t.logger.Printf(format, args...)
+	}
+}
+
+// encodeResult는 도구 결과를 들여쓴 JSON 텍스트로 변환합니다.
+func encodeResult(v interface{}) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("결과 변환 실패: %w", err)
+	}
+	return string(data), nil
+}
+
+// formatMillis는 ClickUp의 Unix 밀리초 문자열을 RFC3339로 변환합니다. 변환할 수 없으면 그대로 반환합니다.
+func formatMillis(ms string) string {
+	t := clickup.ParseMillis(ms)
+	if t.IsZero() {
+		return ms
+	}
+	return t.Format(time.RFC3339)
+}
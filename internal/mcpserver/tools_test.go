@@ -0,0 +1,199 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+	"github.com/zime/slickwebhook/internal/hookserver"
+	"github.com/zime/slickwebhook/internal/jira"
+)
+
+// fakeTaskClient는 테스트용 ClickUp 클라이언트입니다.
+type fakeTaskClient struct {
+	task        *clickup.Task
+	comments    []clickup.Comment
+	commentsErr error
+	added       []string
+}
+
+func (c *fakeTaskClient) GetTask(ctx context.Context, taskID string) (*clickup.Task, error) {
+	if c.task == nil || c.task.ID != taskID {
+		return nil, errors.New("not found")
+	}
+	return c.task, nil
+}
+
+func (c *fakeTaskClient) GetComments(ctx context.Context, taskID string) ([]clickup.Comment, error) {
+	return c.comments, c.commentsErr
+}
+
+func (c *fakeTaskClient) AddComment(ctx context.Context, taskID, text string) error {
+	c.added = append(c.added, taskID+":"+text)
+	return nil
+}
+
+// fakeIssueClient는 테스트용 Jira 클라이언트입니다.
+type fakeIssueClient struct{}
+
+func (fakeIssueClient) GetIssueDetail(ctx context.Context, key string) (*jira.IssueDetail, error) {
+	return &jira.IssueDetail{
+		Key:         key,
+		Summary:     "로그인 실패",
+		Description: "iOS에서 재현",
+		Attachments: []jira.Attachment{{Filename: "crash.log", MimeType: "text/plain", Content: "https://jira/att/1", Size: 12}},
+	}, nil
+}
+
+// dispatched는 Hook 이벤트 호출 기록입니다.
+type dispatched struct {
+	event string
+	body  string
+}
+
+// newTestTools는 AI_01만 처리 중인 TaskTools와 Hook 호출 기록 채널을 생성합니다.
+func newTestTools(client *fakeTaskClient) (*Server, *TaskTools, chan dispatched) {
+	events := make(chan dispatched, 4)
+	tools := NewTaskTools(func(workerID string) (TaskInfo, bool) {
+		if workerID != "AI_01" {
+			return TaskInfo{}, false
+		}
+		return TaskInfo{WorkerID: "AI_01", TaskID: "task1", TaskName: "로그인 버그", JiraID: "PROJ-1", SrcPath: "/repo"}, true
+	}, client, func(event string, body []byte) error {
+		events <- dispatched{event, string(body)}
+		return nil
+	})
+	s := NewServer("ai-worker", "test", "secret")
+	tools.Register(s)
+	return s, tools, events
+}
+
+// call은 도구를 호출하고 결과 텍스트와 에러 여부를 반환합니다.
+func call(t *testing.T, s *Server, workerID, name, args string) (string, bool) {
+	t.Helper()
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + name + `","arguments":` + args + `}}`
+	var resp struct {
+		Result toolResult `json:"result"`
+		Error  *rpcError  `json:"error"`
+	}
+	if err := json.Unmarshal(post(s, s.SessionToken(workerID), body).Body.Bytes(), &resp); err != nil || resp.Error != nil {
+		t.Fatalf("%s 호출 실패: %v %+v", name, err, resp.Error)
+	}
+	return resp.Result.Content[0].Text, resp.Result.IsError
+}
+
+// TestTaskTools_List는 요청된 도구가 모두 등록되는지 테스트합니다.
+func TestTaskTools_List(t *testing.T) {
+	s, _, _ := newTestTools(&fakeTaskClient{})
+	w := post(s, s.SessionToken("AI_01"), `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	for _, name := range []string{"get_task", "get_attachments", "get_jira_issue", "post_progress", "ask_reporter", "report_plan", "complete_task"} {
+		if !strings.Contains(w.Body.String(), `"name":"`+name+`"`) {
+			t.Errorf("%s 도구가 등록되어야 함", name)
+		}
+	}
+}
+
+// TestTaskTools_Context는 태스크/첨부파일/Jira 조회 도구를 테스트합니다.
+func TestTaskTools_Context(t *testing.T) {
+	client := &fakeTaskClient{
+		task: &clickup.Task{
+			ID: "task1", Name: "로그인 버그", Description: "PROJ-1 로그인 실패",
+			Status:      clickup.TaskStatus{Status: "작업중"},
+			Tags:        []clickup.Tag{{Name: "bug"}},
+			Attachments: []clickup.Attachment{{Title: "screen.png", MimeType: "image/png", URL: "https://clickup/att", Size: 100}},
+		},
+		comments: []clickup.Comment{{CommentText: "iOS만 발생", User: clickup.User{Username: "reporter"}, Date: "1700000000000"}},
+	}
+	s, tools, _ := newTestTools(client)
+
+	text, isErr := call(t, s, "AI_01", "get_task", `{}`)
+	var view taskView
+	json.Unmarshal([]byte(text), &view)
+	if isErr || view.Name != "로그인 버그" || view.Status != "작업중" || view.JiraID != "PROJ-1" || len(view.Comments) != 1 || view.Comments[0].User != "reporter" {
+		t.Errorf("get_task 결과 불일치: %s", text)
+	}
+	if view.Comments[0].Date != time.UnixMilli(1700000000000).Format(time.RFC3339) {
+		t.Errorf("댓글 시각 변환 불일치: %s", view.Comments[0].Date)
+	}
+
+	// 처리 중이 아닌 Worker 세션은 다른 Worker의 태스크에 접근할 수 없음
+	if text, isErr := call(t, s, "AI_02", "get_task", `{}`); !isErr || !strings.Contains(text, "처리 중인 태스크 없음") {
+		t.Errorf("처리 중이 아닌 Worker는 에러여야 함: %s", text)
+	}
+
+	if text, isErr := call(t, s, "AI_01", "get_jira_issue", `{}`); !isErr || !strings.Contains(text, "Jira 연동") {
+		t.Errorf("Jira 미설정 에러여야 함: %s", text)
+	}
+	text, _ = call(t, s, "AI_01", "get_attachments", `{}`)
+	if !strings.Contains(text, "screen.png") || strings.Contains(text, "crash.log") {
+		t.Errorf("Jira 미설정이면 ClickUp 첨부파일만 조회해야 함: %s", text)
+	}
+
+	tools.SetIssueClient(fakeIssueClient{})
+	text, _ = call(t, s, "AI_01", "get_attachments", `{}`)
+	if !strings.Contains(text, `"source": "jira"`) || !strings.Contains(text, "crash.log") {
+		t.Errorf("Jira 첨부파일이 포함되어야 함: %s", text)
+	}
+	text, _ = call(t, s, "AI_01", "get_jira_issue", `{}`)
+	if !strings.Contains(text, `"key": "PROJ-1"`) || !strings.Contains(text, "iOS에서 재현") {
+		t.Errorf("현재 태스크의 Jira 이슈를 조회해야 함: %s", text)
+	}
+	text, _ = call(t, s, "AI_01", "get_jira_issue", `{"key":"PROJ-9"}`)
+	if !strings.Contains(text, `"key": "PROJ-1"`) {
+		t.Errorf("현재 태스크 외의 Jira 이슈는 조회할 수 없어야 함: %s", text)
+	}
+	if text, isErr := call(t, s, "AI_02", "get_jira_issue", `{"key":"PROJ-1"}`); !isErr || !strings.Contains(text, "처리 중인 태스크 없음") {
+		t.Errorf("처리 중이 아닌 Worker는 Jira 이슈를 조회할 수 없어야 함: %s", text)
+	}
+}
+
+// TestTaskTools_Lifecycle은 진행 보고, 계획 보고, 질문, 완료 도구가 Hook 이벤트와 댓글로 전달되는지 테스트합니다.
+func TestTaskTools_Lifecycle(t *testing.T) {
+	client := &fakeTaskClient{}
+	s, tools, events := newTestTools(client)
+
+	if text, isErr := call(t, s, "AI_01", "post_progress", `{"stage":"coding"}`); !isErr || !strings.Contains(text, "알 수 없는 진행 단계") {
+		t.Errorf("알 수 없는 단계는 에러여야 함: %s", text)
+	}
+	call(t, s, "AI_01", "post_progress", `{"stage":"tests_passed","message":"12개 통과"}`)
+	if e := <-events; e.event != hookserver.EventProgress || !strings.Contains(e.body, `"cwd":"/repo"`) || !strings.Contains(e.body, "12개 통과") {
+		t.Errorf("진행 보고 이벤트 불일치: %+v", e)
+	}
+
+	call(t, s, "AI_01", "report_plan", `{"title":"세션 만료 처리"}`)
+	if e := <-events; e.event != hookserver.EventPlanReady || !strings.Contains(e.body, `"task_id":"task1"`) || !strings.Contains(e.body, "세션 만료 처리") {
+		t.Errorf("계획 보고 이벤트 불일치: %+v", e)
+	}
+
+	var asked string
+	tools.SetQuestionHandler(func(ctx context.Context, task TaskInfo, question string) {
+		asked = task.WorkerID + ":" + question
+	})
+	if text, isErr := call(t, s, "AI_01", "ask_reporter", `{"question":" "}`); !isErr {
+		t.Errorf("빈 질문은 에러여야 함: %s", text)
+	}
+	call(t, s, "AI_01", "ask_reporter", `{"question":"Android도 수정할까요?"}`)
+	if len(client.added) != 1 || !strings.HasPrefix(client.added[0], "task1:❓") || !strings.Contains(client.added[0], "Android도 수정할까요?") {
+		t.Errorf("질문은 태스크 댓글로 남아야 함: %v", client.added)
+	}
+	if asked != "AI_01:Android도 수정할까요?" {
+		t.Errorf("질문 핸들러 호출 불일치: %q", asked)
+	}
+
+	call(t, s, "AI_01", "complete_task", `{}`)
+	select {
+	case e := <-events:
+		if e.event != hookserver.EventTaskComplete || !strings.Contains(e.body, `"status":"completed"`) {
+			t.Errorf("완료 이벤트 불일치: %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("완료 이벤트가 전달되어야 함")
+	}
+	if _, isErr := call(t, s, "AI_02", "complete_task", `{}`); !isErr {
+		t.Error("처리 중이 아닌 Worker의 완료 요청은 에러여야 함")
+	}
+}
@@ -0,0 +1,147 @@
+package notifier
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 환경변수 접두어
+const (
+	envRoutePrefix         = "NOTIFY_ROUTE_"
+	envWebhookHeaderPrefix = "NOTIFY_WEBHOOK_HEADER_"
+)
+
+// Config는 환경변수에서 읽은 싱크/라우팅 설정입니다.
+type Config struct {
+	WebhookURL        string            // NOTIFY_WEBHOOK_URL
+	WebhookHeaders    map[string]string // NOTIFY_WEBHOOK_HEADER_<NAME>
+	DiscordWebhookURL string            // NOTIFY_DISCORD_WEBHOOK_URL
+	TeamsWebhookURL   string            // NOTIFY_TEAMS_WEBHOOK_URL
+	SMTP              SMTPConfig        // NOTIFY_SMTP_*
+	Routes            map[string][]string
+	DefaultRoute      []string // NOTIFY_ROUTE_DEFAULT
+}
+
+// LoadConfigFromEnv는 환경변수 목록(os.Environ() 형식)에서 설정을 읽습니다.
+//
+// 라우팅 키 규칙:
+//   - NOTIFY_ROUTE_DEFAULT=slack            → 기본 라우트
+//   - NOTIFY_ROUTE_AI=slack,discord         → "ai.*"
+//   - NOTIFY_ROUTE_AI_PLAN_READY=slack,teams → "ai.plan_ready"
+//   - NOTIFY_ROUTE_EMAIL_NEW=slack,email    → "email.new"
+func LoadConfigFromEnv(environ []string) Config {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if idx := strings.Index(kv, "="); idx > 0 {
+			env[kv[:idx]] = kv[idx+1:]
+		}
+	}
+
+	cfg := Config{
+		WebhookURL:        env["NOTIFY_WEBHOOK_URL"],
+		WebhookHeaders:    make(map[string]string),
+		DiscordWebhookURL: env["NOTIFY_DISCORD_WEBHOOK_URL"],
+		TeamsWebhookURL:   env["NOTIFY_TEAMS_WEBHOOK_URL"],
+		SMTP: SMTPConfig{
+			Host:     env["NOTIFY_SMTP_HOST"],
+			Username: env["NOTIFY_SMTP_USERNAME"],
+			Password: env["NOTIFY_SMTP_PASSWORD"],
+			From:     env["NOTIFY_SMTP_FROM"],
+			To:       splitList(env["NOTIFY_SMTP_TO"]),
+		},
+		Routes: make(map[string][]string),
+	}
+
+	if port, err := strconv.Atoi(env["NOTIFY_SMTP_PORT"]); err == nil {
+		cfg.SMTP.Port = port
+	}
+
+	for key, value := range env {
+		switch {
+		case strings.HasPrefix(key, envWebhookHeaderPrefix):
+			name := strings.ReplaceAll(strings.TrimPrefix(key, envWebhookHeaderPrefix), "_", "-")
+			cfg.WebhookHeaders[name] = value
+		case key == envRoutePrefix+"DEFAULT":
+			cfg.DefaultRoute = splitList(value)
+		case strings.HasPrefix(key, envRoutePrefix):
+			cfg.Routes[routeKeyToEventType(strings.TrimPrefix(key, envRoutePrefix))] = splitList(value)
+		}
+	}
+
+	return cfg
+}
+
+// routeKeyToEventType은 환경변수 접미어를 이벤트 종류로 변환합니다.
+// "AI_PLAN_READY" → "ai.plan_ready", "AI" → "ai.*"
+func routeKeyToEventType(key string) string {
+	key = strings.ToLower(key)
+	if idx := strings.Index(key, "_"); idx > 0 {
+		return key[:idx] + "." + key[idx+1:]
+	}
+	return key + ".*"
+}
+
+// NewRegistryFromConfig는 설정된 싱크를 등록하고 라우트를 적용한 레지스트리를 생성합니다.
+// slackNotifier가 nil이면 Slack 싱크는 등록하지 않습니다 (호출자가 직접 처리하는 경우).
+// 라우트에 설정되지 않은 싱크 이름이 있으면 에러를 반환합니다.
+func NewRegistryFromConfig(cfg Config, slackNotifier Notifier, httpClient *http.Client) (*Registry, error) {
+	r := NewRegistry()
+	available := map[string]bool{SinkSlack: true}
+
+	if slackNotifier != nil {
+		r.Register(slackNotifier)
+	}
+	if cfg.WebhookURL != "" {
+		r.Register(NewWebhookNotifier(cfg.WebhookURL, cfg.WebhookHeaders, httpClient))
+		available[SinkWebhook] = true
+	}
+	if cfg.DiscordWebhookURL != "" {
+		r.Register(NewDiscordNotifier(cfg.DiscordWebhookURL, httpClient))
+		available[SinkDiscord] = true
+	}
+	if cfg.TeamsWebhookURL != "" {
+		r.Register(NewTeamsNotifier(cfg.TeamsWebhookURL, httpClient))
+		available[SinkTeams] = true
+	}
+	if cfg.SMTP.Host != "" {
+		r.Register(NewEmailNotifier(cfg.SMTP))
+		available[SinkEmail] = true
+	}
+
+	check := func(eventType string, sinks []string) error {
+		for _, name := range sinks {
+			if !available[name] {
+				return fmt.Errorf("라우트 %s: 설정되지 않은 싱크 %q", eventType, name)
+			}
+		}
+		return nil
+	}
+
+	if len(cfg.DefaultRoute) > 0 {
+		if err := check("default", cfg.DefaultRoute); err != nil {
+			return nil, err
+		}
+		r.SetDefaultRoute(cfg.DefaultRoute...)
+	}
+	for eventType, sinks := range cfg.Routes {
+		if err := check(eventType, sinks); err != nil {
+			return nil, err
+		}
+		r.Route(eventType, sinks...)
+	}
+
+	return r, nil
+}
+
+// splitList는 쉼표로 구분된 문자열을 목록으로 변환합니다.
+func splitList(s string) []string {
+	var list []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
@@ -0,0 +1,68 @@
+package notifier
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestLoadConfigFromEnv는 환경변수에서 싱크/라우트 설정을 읽는지 테스트합니다.
+func TestLoadConfigFromEnv(t *testing.T) {
+	cfg := LoadConfigFromEnv([]string{
+		"NOTIFY_WEBHOOK_URL=https://hooks.example.com/ai",
+		"NOTIFY_WEBHOOK_HEADER_X_API_KEY=secret",
+		"NOTIFY_DISCORD_WEBHOOK_URL=https://discord.com/api/webhooks/1/abc",
+		"NOTIFY_SMTP_HOST=smtp.example.com",
+		"NOTIFY_SMTP_PORT=2525",
+		"NOTIFY_SMTP_TO=a@example.com, b@example.com",
+		"NOTIFY_ROUTE_DEFAULT=slack",
+		"NOTIFY_ROUTE_AI=slack,discord",
+		"NOTIFY_ROUTE_AI_PLAN_READY=slack, email",
+		"NOTIFY_ROUTE_EMAIL_NEW=slack,webhook",
+		"UNRELATED=1",
+	})
+
+	if cfg.WebhookHeaders["X-API-KEY"] != "secret" {
+		t.Errorf("Webhook 헤더 불일치: %v", cfg.WebhookHeaders)
+	}
+	if cfg.SMTP.Port != 2525 || len(cfg.SMTP.To) != 2 {
+		t.Errorf("SMTP 설정 불일치: %+v", cfg.SMTP)
+	}
+
+	wantRoutes := map[string]string{
+		"ai.*":          "slack,discord",
+		"ai.plan_ready": "slack,email",
+		"email.new":     "slack,webhook",
+	}
+	for eventType, want := range wantRoutes {
+		if got := strings.Join(cfg.Routes[eventType], ","); got != want {
+			t.Errorf("라우트 %s = %q, want %q", eventType, got, want)
+		}
+	}
+	if strings.Join(cfg.DefaultRoute, ",") != "slack" {
+		t.Errorf("기본 라우트 불일치: %v", cfg.DefaultRoute)
+	}
+}
+
+// TestNewRegistryFromConfig는 설정된 싱크만 라우트에 사용할 수 있는지 테스트합니다.
+func TestNewRegistryFromConfig(t *testing.T) {
+	cfg := LoadConfigFromEnv([]string{
+		"NOTIFY_DISCORD_WEBHOOK_URL=https://discord.com/api/webhooks/1/abc",
+		"NOTIFY_ROUTE_AI_COMPLETED=slack,discord",
+	})
+
+	r, err := NewRegistryFromConfig(cfg, nil, nil)
+	if err != nil {
+		t.Fatalf("레지스트리 생성 실패: %v", err)
+	}
+	if !r.Routes("ai.completed", SinkDiscord) {
+		t.Error("ai.completed는 discord로 라우팅되어야 함")
+	}
+	if strings.Join(r.Sinks("ai.failed"), ",") != "slack" {
+		t.Errorf("기본 라우트는 slack이어야 함: %v", r.Sinks("ai.failed"))
+	}
+
+	cfg = LoadConfigFromEnv([]string{"NOTIFY_ROUTE_AI=teams"})
+	if _, err := NewRegistryFromConfig(cfg, nil, nil); err == nil {
+		t.Error("설정되지 않은 teams 싱크 라우트는 에러여야 함")
+	}
+}
@@ -0,0 +1,74 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// DiscordNotifier는 Discord Webhook으로 알림을 전송합니다.
+type DiscordNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// discordPayload는 Discord Webhook 페이로드입니다.
+type discordPayload struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed는 Discord Embed 객체입니다.
+type discordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+}
+
+// discordEmbedField는 Discord Embed 필드입니다.
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// NewDiscordNotifier는 새 DiscordNotifier를 생성합니다.
+func NewDiscordNotifier(url string, httpClient *http.Client) *DiscordNotifier {
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	return &DiscordNotifier{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// Name은 싱크 이름을 반환합니다.
+func (n *DiscordNotifier) Name() string {
+	return SinkDiscord
+}
+
+// Notify는 메시지를 Discord Embed로 전송합니다.
+// 첫 번째 링크는 Embed 제목 링크로, 나머지 링크는 필드로 표시됩니다.
+func (n *DiscordNotifier) Notify(ctx context.Context, msg *Message) error {
+	embed := discordEmbed{
+		Title:       msg.Title,
+		Description: msg.Text,
+		Timestamp:   msg.Time.Format(time.RFC3339),
+	}
+
+	for _, f := range msg.Fields {
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: f.Name, Value: f.Value, Inline: true})
+	}
+
+	for i, l := range msg.Links {
+		if i == 0 {
+			embed.URL = l.URL
+		}
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: l.Label, Value: l.URL})
+	}
+
+	return postJSON(ctx, n.httpClient, n.url, discordPayload{Embeds: []discordEmbed{embed}}, nil)
+}
@@ -0,0 +1,121 @@
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPConfig는 이메일 싱크 설정입니다.
+type SMTPConfig struct {
+	Host     string   // SMTP 서버 호스트
+	Port     int      // SMTP 서버 포트 (기본값: 587)
+	Username string   // 인증 사용자 (비어있으면 인증 생략)
+	Password string   // 인증 비밀번호
+	From     string   // 발신 주소
+	To       []string // 수신 주소 목록
+}
+
+// EmailNotifier는 SMTP로 알림 메일을 전송합니다.
+type EmailNotifier struct {
+	config SMTPConfig
+}
+
+// NewEmailNotifier는 새 EmailNotifier를 생성합니다.
+func NewEmailNotifier(config SMTPConfig) *EmailNotifier {
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	return &EmailNotifier{config: config}
+}
+
+// Name은 싱크 이름을 반환합니다.
+func (n *EmailNotifier) Name() string {
+	return SinkEmail
+}
+
+// Notify는 메시지를 일반 텍스트 메일로 전송합니다.
+// 서버가 STARTTLS를 지원하면 TLS로 전환하고, Username이 설정된 경우에만 인증합니다.
+func (n *EmailNotifier) Notify(ctx context.Context, msg *Message) error {
+	if n.config.Host == "" || n.config.From == "" || len(n.config.To) == 0 {
+		return fmt.Errorf("SMTP 설정이 불완전함 (host/from/to 필요)")
+	}
+
+	addr := net.JoinHostPort(n.config.Host, fmt.Sprintf("%d", n.config.Port))
+	conn, err := (&net.Dialer{Timeout: 10 * time.Second}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("SMTP 연결 실패: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP 클라이언트 생성 실패: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.config.Host}); err != nil {
+			return fmt.Errorf("STARTTLS 실패: %w", err)
+		}
+	}
+
+	if n.config.Username != "" {
+		auth := smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+		if err := c.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP 인증 실패: %w", err)
+		}
+	}
+
+	if err := c.Mail(n.config.From); err != nil {
+		return fmt.Errorf("MAIL FROM 실패: %w", err)
+	}
+	for _, to := range n.config.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("RCPT TO 실패 (%s): %w", to, err)
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("DATA 실패: %w", err)
+	}
+	if _, err := w.Write(n.buildMail(msg)); err != nil {
+		w.Close()
+		return fmt.Errorf("메일 본문 전송 실패: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("메일 전송 실패: %w", err)
+	}
+
+	return c.Quit()
+}
+
+// buildMail은 헤더와 본문을 포함한 메일 원문을 생성합니다.
+func (n *EmailNotifier) buildMail(msg *Message) []byte {
+	subject := msg.Title
+	if subject == "" {
+		subject = msg.EventType
+	}
+
+	var sb strings.Builder
+	sb.WriteString("From: " + n.config.From + "\r\n")
+	sb.WriteString("To: " + strings.Join(n.config.To, ", ") + "\r\n")
+	sb.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	sb.WriteString("Date: " + msg.Time.Format(time.RFC1123Z) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(plainText(msg), "\n", "\r\n"))
+	sb.WriteString("\r\n")
+	return []byte(sb.String())
+}
@@ -0,0 +1,145 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer는 최소한의 SMTP 대화를 처리하는 테스트 서버입니다.
+// STARTTLS/AUTH를 광고하지 않으므로 평문으로 처리됩니다.
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	rcpts    []string
+	data     string
+	done     chan struct{}
+}
+
+// newFakeSMTPServer는 로컬 포트에서 한 번의 세션을 처리하는 서버를 시작합니다.
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("리스너 생성 실패: %v", err)
+	}
+	s := &fakeSMTPServer{listener: ln, done: make(chan struct{})}
+	t.Cleanup(func() { ln.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	write := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	write("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		upper := strings.ToUpper(cmd)
+
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			write("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			s.from = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+			write("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			s.rcpts = append(s.rcpts, strings.Trim(cmd[len("RCPT TO:"):], "<> "))
+			write("250 OK")
+		case upper == "DATA":
+			write("354 End data with <CR><LF>.<CR><LF>")
+			var sb strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				sb.WriteString(dataLine)
+			}
+			s.data = sb.String()
+			write("250 OK")
+		case upper == "QUIT":
+			write("221 Bye")
+			return
+		default:
+			write("250 OK")
+		}
+	}
+}
+
+// TestEmailNotifier는 로컬 SMTP 서버로 메일 전송을 테스트합니다.
+func TestEmailNotifier(t *testing.T) {
+	server := newFakeSMTPServer(t)
+
+	n := NewEmailNotifier(SMTPConfig{
+		Host: "127.0.0.1",
+		Port: server.port(),
+		From: "ai-worker@example.com",
+		To:   []string{"dev1@example.com", "dev2@example.com"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := n.Notify(ctx, testMessage()); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+
+	select {
+	case <-server.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP 세션 종료 대기 시간 초과")
+	}
+
+	if server.from != "ai-worker@example.com" {
+		t.Errorf("MAIL FROM 불일치: %s", server.from)
+	}
+	if len(server.rcpts) != 2 {
+		t.Errorf("RCPT 개수 불일치: %v", server.rcpts)
+	}
+	for _, want := range []string{
+		"Subject: =?utf-8?q?",
+		"Content-Type: text/plain; charset=UTF-8",
+		"모든 테스트 통과",
+		"Worker: AI_01",
+		"https://app.clickup.com/t/abc123",
+	} {
+		if !strings.Contains(server.data, want) {
+			t.Errorf("메일 본문에 %q 누락:\n%s", want, server.data)
+		}
+	}
+}
+
+// TestEmailNotifier_InvalidConfig는 불완전한 설정에서 에러를 반환하는지 테스트합니다.
+func TestEmailNotifier_InvalidConfig(t *testing.T) {
+	n := NewEmailNotifier(SMTPConfig{Host: "127.0.0.1", Port: 1})
+	if err := n.Notify(context.Background(), testMessage()); err == nil {
+		t.Error("From/To가 없으면 에러여야 함")
+	}
+	if NewEmailNotifier(SMTPConfig{}).config.Port != 587 {
+		t.Error("기본 포트는 587이어야 함")
+	}
+}
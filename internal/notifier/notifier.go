@@ -0,0 +1,182 @@
+// Package notifier는 알림 싱크(Slack, Webhook, Discord, Teams, Email)를 추상화하고
+// 이벤트 종류별로 하나 이상의 싱크에 라우팅하는 레지스트리를 제공합니다.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// 싱크 이름 상수
+const (
+	SinkSlack   = "slack"
+	SinkWebhook = "webhook"
+	SinkDiscord = "discord"
+	SinkTeams   = "teams"
+	SinkEmail   = "email"
+)
+
+// Field는 알림에 표시할 키-값 항목입니다.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Link는 알림에 표시할 링크입니다.
+type Link struct {
+	Label string
+	URL   string
+}
+
+// Message는 싱크에 독립적인 알림 메시지입니다.
+type Message struct {
+	EventType string        // 라우팅 키 (예: "ai.completed", "email.new")
+	Title     string        // 제목
+	Text      string        // 본문
+	Fields    []Field       // 부가 정보
+	Links     []Link        // 관련 링크
+	Blocks    []slack.Block // Slack 전용 Block Kit (없으면 Title/Text/Fields로 생성)
+	Time      time.Time     // 이벤트 발생 시간
+}
+
+// Notifier는 알림 싱크 인터페이스입니다.
+type Notifier interface {
+	// Name은 라우팅 설정에서 사용하는 싱크 이름을 반환합니다.
+	Name() string
+	// Notify는 메시지를 전송합니다.
+	Notify(ctx context.Context, msg *Message) error
+}
+
+// Registry는 싱크를 등록하고 이벤트 종류별로 메시지를 라우팅합니다.
+// 라우트는 정확한 이벤트 종류("ai.completed"), 접두어 와일드카드("ai.*"), 기본 라우트 순서로 결정됩니다.
+type Registry struct {
+	mu           sync.RWMutex
+	notifiers    map[string]Notifier
+	routes       map[string][]string
+	defaultRoute []string
+}
+
+// NewRegistry는 새 레지스트리를 생성합니다. 기본 라우트는 Slack입니다.
+func NewRegistry() *Registry {
+	return &Registry{
+		notifiers:    make(map[string]Notifier),
+		routes:       make(map[string][]string),
+		defaultRoute: []string{SinkSlack},
+	}
+}
+
+// Register는 싱크를 등록합니다. 같은 이름의 싱크가 있으면 교체합니다.
+func (r *Registry) Register(n Notifier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifiers[n.Name()] = n
+}
+
+// Route는 이벤트 종류(또는 "ai.*" 형식의 접두어)에 싱크 목록을 지정합니다.
+func (r *Registry) Route(eventType string, sinks ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes[eventType] = sinks
+}
+
+// SetDefaultRoute는 라우트가 지정되지 않은 이벤트의 싱크 목록을 설정합니다.
+func (r *Registry) SetDefaultRoute(sinks ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaultRoute = sinks
+}
+
+// Sinks는 이벤트 종류에 라우팅된 싱크 이름 목록을 반환합니다.
+func (r *Registry) Sinks(eventType string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if sinks, ok := r.routes[eventType]; ok {
+		return sinks
+	}
+	if idx := strings.Index(eventType, "."); idx > 0 {
+		if sinks, ok := r.routes[eventType[:idx]+".*"]; ok {
+			return sinks
+		}
+	}
+	return r.defaultRoute
+}
+
+// Routes는 이벤트 종류가 지정한 싱크로 라우팅되는지 확인합니다.
+func (r *Registry) Routes(eventType, sink string) bool {
+	for _, name := range r.Sinks(eventType) {
+		if name == sink {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify는 메시지를 라우팅된 모든 싱크로 전송합니다.
+// exclude에 지정한 싱크는 건너뜁니다 (호출자가 직접 처리하는 경우).
+// 일부 싱크가 실패해도 나머지는 전송하며, 실패한 싱크의 에러를 합쳐 반환합니다.
+func (r *Registry) Notify(ctx context.Context, msg *Message, exclude ...string) error {
+	if msg.Time.IsZero() {
+		msg.Time = time.Now()
+	}
+
+	var errs []error
+	for _, name := range r.Sinks(msg.EventType) {
+		if contains(exclude, name) {
+			continue
+		}
+
+		r.mu.RLock()
+		n, ok := r.notifiers[name]
+		r.mu.RUnlock()
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("등록되지 않은 싱크: %s", name))
+			continue
+		}
+		if err := n.Notify(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s 전송 실패: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// contains는 목록에 값이 있는지 확인합니다.
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// plainText는 Title/Text/Fields/Links로 일반 텍스트 본문을 생성합니다.
+// Block Kit이나 카드를 지원하지 않는 싱크(이메일, 일반 Webhook 폴백)에서 사용합니다.
+func plainText(msg *Message) string {
+	var sb strings.Builder
+	if msg.Text != "" {
+		sb.WriteString(msg.Text)
+		sb.WriteString("\n")
+	}
+	if len(msg.Fields) > 0 {
+		sb.WriteString("\n")
+		for _, f := range msg.Fields {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", f.Name, f.Value))
+		}
+	}
+	if len(msg.Links) > 0 {
+		sb.WriteString("\n")
+		for _, l := range msg.Links {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", l.Label, l.URL))
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,136 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// mockNotifier는 테스트용 싱크입니다.
+type mockNotifier struct {
+	name     string
+	err      error
+	mu       sync.Mutex
+	received []*Message
+}
+
+func (m *mockNotifier) Name() string { return m.name }
+
+func (m *mockNotifier) Notify(ctx context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.received = append(m.received, msg)
+	return m.err
+}
+
+func (m *mockNotifier) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.received)
+}
+
+// TestRegistry_Sinks는 라우트 우선순위(정확 일치 → 접두어 → 기본)를 테스트합니다.
+func TestRegistry_Sinks(t *testing.T) {
+	r := NewRegistry()
+	r.Route("ai.*", SinkSlack, SinkDiscord)
+	r.Route("ai.completed", SinkSlack, SinkTeams)
+
+	tests := []struct {
+		eventType string
+		want      string
+	}{
+		{"ai.completed", "slack,teams"},
+		{"ai.failed", "slack,discord"},
+		{"email.new", "slack"},
+		{"unknown", "slack"},
+	}
+
+	for _, tt := range tests {
+		got := strings.Join(r.Sinks(tt.eventType), ",")
+		if got != tt.want {
+			t.Errorf("Sinks(%q) = %q, want %q", tt.eventType, got, tt.want)
+		}
+	}
+
+	if !r.Routes("ai.failed", SinkDiscord) {
+		t.Error("ai.failed는 discord로 라우팅되어야 함")
+	}
+	if r.Routes("email.new", SinkDiscord) {
+		t.Error("email.new는 discord로 라우팅되지 않아야 함")
+	}
+}
+
+// TestRegistry_Notify는 라우팅된 싱크로만 전송되고 exclude가 적용되는지 테스트합니다.
+func TestRegistry_Notify(t *testing.T) {
+	slackSink := &mockNotifier{name: SinkSlack}
+	discordSink := &mockNotifier{name: SinkDiscord}
+	teamsSink := &mockNotifier{name: SinkTeams}
+
+	r := NewRegistry()
+	r.Register(slackSink)
+	r.Register(discordSink)
+	r.Register(teamsSink)
+	r.Route("ai.completed", SinkSlack, SinkDiscord)
+
+	msg := &Message{EventType: "ai.completed", Title: "완료"}
+	if err := r.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+	if msg.Time.IsZero() {
+		t.Error("Time이 비어있으면 현재 시간으로 채워져야 함")
+	}
+
+	if err := r.Notify(context.Background(), &Message{EventType: "ai.completed"}, SinkSlack); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+
+	if slackSink.count() != 1 {
+		t.Errorf("slack 전송 횟수: got %d, want 1", slackSink.count())
+	}
+	if discordSink.count() != 2 {
+		t.Errorf("discord 전송 횟수: got %d, want 2", discordSink.count())
+	}
+	if teamsSink.count() != 0 {
+		t.Errorf("teams 전송 횟수: got %d, want 0", teamsSink.count())
+	}
+}
+
+// TestRegistry_Notify_PartialFailure는 일부 싱크 실패 시에도 나머지가 전송되는지 테스트합니다.
+func TestRegistry_Notify_PartialFailure(t *testing.T) {
+	failing := &mockNotifier{name: SinkWebhook, err: errors.New("boom")}
+	ok := &mockNotifier{name: SinkSlack}
+
+	r := NewRegistry()
+	r.Register(failing)
+	r.Register(ok)
+	r.SetDefaultRoute(SinkWebhook, SinkSlack, SinkEmail)
+
+	err := r.Notify(context.Background(), &Message{EventType: "email.new"})
+	if err == nil {
+		t.Fatal("에러가 반환되어야 함")
+	}
+	if !strings.Contains(err.Error(), "webhook 전송 실패") {
+		t.Errorf("webhook 실패가 포함되어야 함: %v", err)
+	}
+	if !strings.Contains(err.Error(), "등록되지 않은 싱크: email") {
+		t.Errorf("미등록 싱크 에러가 포함되어야 함: %v", err)
+	}
+	if ok.count() != 1 {
+		t.Error("실패와 무관하게 slack은 전송되어야 함")
+	}
+}
+
+// TestPlainText는 일반 텍스트 본문 생성을 테스트합니다.
+func TestPlainText(t *testing.T) {
+	got := plainText(&Message{
+		Text:   "본문",
+		Fields: []Field{{Name: "Worker", Value: "AI_01"}},
+		Links:  []Link{{Label: "ClickUp", URL: "https://app.clickup.com/t/abc"}},
+	})
+	want := "본문\n\nWorker: AI_01\n\nClickUp: https://app.clickup.com/t/abc"
+	if got != want {
+		t.Errorf("plainText 불일치:\ngot  %q\nwant %q", got, want)
+	}
+}
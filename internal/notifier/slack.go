@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+)
+
+// SlackPoster는 Slack 메시지 전송 인터페이스입니다.
+type SlackPoster interface {
+	PostMessage(ctx context.Context, channelID string, blocks []slack.Block, text string) error
+}
+
+// SlackNotifier는 Slack 채널로 알림을 전송합니다.
+type SlackNotifier struct {
+	client    SlackPoster
+	channelID string
+}
+
+// NewSlackNotifier는 새 SlackNotifier를 생성합니다.
+func NewSlackNotifier(client SlackPoster, channelID string) *SlackNotifier {
+	return &SlackNotifier{
+		client:    client,
+		channelID: channelID,
+	}
+}
+
+// Name은 싱크 이름을 반환합니다.
+func (n *SlackNotifier) Name() string {
+	return SinkSlack
+}
+
+// Notify는 메시지를 Slack으로 전송합니다.
+// Blocks가 있으면 그대로 사용하고, 없으면 Title/Text/Fields로 Block을 생성합니다.
+func (n *SlackNotifier) Notify(ctx context.Context, msg *Message) error {
+	if n.channelID == "" {
+		return fmt.Errorf("Slack 채널이 설정되지 않음")
+	}
+
+	blocks := msg.Blocks
+	if len(blocks) == 0 {
+		blocks = buildSlackBlocks(msg)
+	}
+
+	text := msg.Title
+	if text == "" {
+		text = msg.Text
+	}
+
+	return n.client.PostMessage(ctx, n.channelID, blocks, text)
+}
+
+// buildSlackBlocks는 메시지 내용으로 기본 Block Kit을 생성합니다.
+func buildSlackBlocks(msg *Message) []slack.Block {
+	blocks := make([]slack.Block, 0, 3)
+
+	if msg.Title != "" {
+		headerText := slack.NewTextBlockObject(slack.PlainTextType, msg.Title, true, false)
+		blocks = append(blocks, slack.NewHeaderBlock(headerText))
+	}
+
+	if msg.Text != "" {
+		bodyText := slack.NewTextBlockObject(slack.MarkdownType, msg.Text, false, false)
+		blocks = append(blocks, slack.NewSectionBlock(bodyText, nil, nil))
+	}
+
+	if len(msg.Fields) > 0 || len(msg.Links) > 0 {
+		fields := make([]*slack.TextBlockObject, 0, len(msg.Fields)+len(msg.Links))
+		for _, f := range msg.Fields {
+			fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, "*"+f.Name+":*\n"+f.Value, false, false))
+		}
+		for _, l := range msg.Links {
+			fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("<%s|%s>", l.URL, l.Label), false, false))
+		}
+		blocks = append(blocks, slack.NewSectionBlock(nil, fields, nil))
+	}
+
+	return blocks
+}
@@ -0,0 +1,98 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+)
+
+// TeamsNotifier는 Microsoft Teams Incoming Webhook으로 MessageCard 알림을 전송합니다.
+type TeamsNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// teamsMessageCard는 Teams Incoming Webhook MessageCard 페이로드입니다.
+type teamsMessageCard struct {
+	Type            string         `json:"@type"`
+	Context         string         `json:"@context"`
+	Summary         string         `json:"summary"`
+	Title           string         `json:"title,omitempty"`
+	Text            string         `json:"text,omitempty"`
+	Sections        []teamsSection `json:"sections,omitempty"`
+	PotentialAction []teamsAction  `json:"potentialAction,omitempty"`
+}
+
+// teamsSection은 MessageCard 섹션입니다.
+type teamsSection struct {
+	Facts []teamsFact `json:"facts"`
+}
+
+// teamsFact는 MessageCard 키-값 항목입니다.
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// teamsAction은 MessageCard OpenUri 액션입니다.
+type teamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []teamsTarget `json:"targets"`
+}
+
+// teamsTarget은 OpenUri 액션 대상입니다.
+type teamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+// NewTeamsNotifier는 새 TeamsNotifier를 생성합니다.
+func NewTeamsNotifier(url string, httpClient *http.Client) *TeamsNotifier {
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	return &TeamsNotifier{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// Name은 싱크 이름을 반환합니다.
+func (n *TeamsNotifier) Name() string {
+	return SinkTeams
+}
+
+// Notify는 메시지를 MessageCard로 전송합니다.
+// Fields는 facts 섹션으로, Links는 OpenUri 버튼으로 표시됩니다.
+func (n *TeamsNotifier) Notify(ctx context.Context, msg *Message) error {
+	summary := msg.Title
+	if summary == "" {
+		summary = msg.EventType
+	}
+
+	card := teamsMessageCard{
+		Type:    "MessageCard",
+		Context: "https://schema.org/extensions",
+		Summary: summary,
+		Title:   msg.Title,
+		Text:    msg.Text,
+	}
+
+	if len(msg.Fields) > 0 {
+		section := teamsSection{}
+		for _, f := range msg.Fields {
+			section.Facts = append(section.Facts, teamsFact{Name: f.Name, Value: f.Value})
+		}
+		card.Sections = append(card.Sections, section)
+	}
+
+	for _, l := range msg.Links {
+		card.PotentialAction = append(card.PotentialAction, teamsAction{
+			Type:    "OpenUri",
+			Name:    l.Label,
+			Targets: []teamsTarget{{OS: "default", URI: l.URL}},
+		})
+	}
+
+	return postJSON(ctx, n.httpClient, n.url, card, nil)
+}
@@ -0,0 +1,98 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultHTTPClient는 HTTP 싱크의 기본 클라이언트입니다.
+var defaultHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// WebhookNotifier는 일반 JSON Webhook으로 알림을 전송합니다.
+type WebhookNotifier struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// webhookPayload는 일반 JSON Webhook 페이로드입니다.
+type webhookPayload struct {
+	EventType string  `json:"event_type"`
+	Title     string  `json:"title"`
+	Text      string  `json:"text"`
+	Fields    []Field `json:"fields,omitempty"`
+	Links     []Link  `json:"links,omitempty"`
+	Time      string  `json:"time"` // RFC 3339
+}
+
+// NewWebhookNotifier는 새 WebhookNotifier를 생성합니다.
+// headers는 인증 토큰 등 요청에 추가할 헤더입니다.
+func NewWebhookNotifier(url string, headers map[string]string, httpClient *http.Client) *WebhookNotifier {
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	return &WebhookNotifier{
+		url:        url,
+		headers:    headers,
+		httpClient: httpClient,
+	}
+}
+
+// Name은 싱크 이름을 반환합니다.
+func (n *WebhookNotifier) Name() string {
+	return SinkWebhook
+}
+
+// Notify는 메시지를 JSON으로 POST합니다.
+func (n *WebhookNotifier) Notify(ctx context.Context, msg *Message) error {
+	payload := webhookPayload{
+		EventType: msg.EventType,
+		Title:     msg.Title,
+		Text:      msg.Text,
+		Fields:    msg.Fields,
+		Links:     msg.Links,
+		Time:      msg.Time.Format(time.RFC3339),
+	}
+	return postJSON(ctx, n.httpClient, n.url, payload, n.headers)
+}
+
+// postJSON은 payload를 JSON으로 직렬화하여 POST합니다.
+// 2xx 이외의 응답은 에러로 처리합니다.
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}, headers map[string]string) error {
+	if url == "" {
+		return fmt.Errorf("Webhook URL이 설정되지 않음")
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("페이로드 직렬화 실패: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("API 호출 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API 에러 (상태코드: %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
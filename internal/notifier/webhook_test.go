@@ -0,0 +1,131 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// captureServer는 요청 본문과 헤더를 기록하는 테스트 서버를 생성합니다.
+func captureServer(t *testing.T, status int) (*httptest.Server, *[]byte, *http.Header) {
+	t.Helper()
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header.Clone()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &body, &header
+}
+
+// testMessage는 테스트용 메시지를 생성합니다.
+func testMessage() *Message {
+	return &Message{
+		EventType: "ai.completed",
+		Title:     "✅ AI 작업 완료",
+		Text:      "모든 테스트 통과",
+		Fields:    []Field{{Name: "Worker", Value: "AI_01"}},
+		Links:     []Link{{Label: "ClickUp에서 보기", URL: "https://app.clickup.com/t/abc123"}},
+		Time:      time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+	}
+}
+
+// TestWebhookNotifier는 일반 JSON Webhook 페이로드와 헤더를 테스트합니다.
+func TestWebhookNotifier(t *testing.T) {
+	server, body, header := captureServer(t, http.StatusOK)
+
+	n := NewWebhookNotifier(server.URL, map[string]string{"Authorization": "Bearer token"}, nil)
+	if err := n.Notify(context.Background(), testMessage()); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(*body, &payload); err != nil {
+		t.Fatalf("페이로드 파싱 실패: %v", err)
+	}
+	if payload["event_type"] != "ai.completed" {
+		t.Errorf("event_type 불일치: %v", payload["event_type"])
+	}
+	if payload["time"] != "2026-01-02T15:04:05Z" {
+		t.Errorf("time 불일치: %v", payload["time"])
+	}
+	if header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Authorization 헤더 불일치: %q", header.Get("Authorization"))
+	}
+	if header.Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type 불일치: %q", header.Get("Content-Type"))
+	}
+}
+
+// TestWebhookNotifier_ErrorStatus는 2xx 이외 응답을 에러로 처리하는지 테스트합니다.
+func TestWebhookNotifier_ErrorStatus(t *testing.T) {
+	server, _, _ := captureServer(t, http.StatusInternalServerError)
+
+	n := NewWebhookNotifier(server.URL, nil, nil)
+	if err := n.Notify(context.Background(), testMessage()); err == nil {
+		t.Error("500 응답은 에러여야 함")
+	}
+
+	if err := NewWebhookNotifier("", nil, nil).Notify(context.Background(), testMessage()); err == nil {
+		t.Error("URL이 비어있으면 에러여야 함")
+	}
+}
+
+// TestDiscordNotifier는 Discord Embed 페이로드를 테스트합니다.
+func TestDiscordNotifier(t *testing.T) {
+	server, body, _ := captureServer(t, http.StatusNoContent)
+
+	n := NewDiscordNotifier(server.URL, nil)
+	if err := n.Notify(context.Background(), testMessage()); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+
+	var payload discordPayload
+	if err := json.Unmarshal(*body, &payload); err != nil {
+		t.Fatalf("페이로드 파싱 실패: %v", err)
+	}
+	if len(payload.Embeds) != 1 {
+		t.Fatalf("Embed 개수 불일치: %d", len(payload.Embeds))
+	}
+
+	embed := payload.Embeds[0]
+	if embed.Title != "✅ AI 작업 완료" || embed.Description != "모든 테스트 통과" {
+		t.Errorf("Embed 내용 불일치: %+v", embed)
+	}
+	if embed.URL != "https://app.clickup.com/t/abc123" {
+		t.Errorf("Embed URL 불일치: %s", embed.URL)
+	}
+	if len(embed.Fields) != 2 || !embed.Fields[0].Inline {
+		t.Errorf("Embed 필드 불일치: %+v", embed.Fields)
+	}
+}
+
+// TestTeamsNotifier는 Teams MessageCard 페이로드를 테스트합니다.
+func TestTeamsNotifier(t *testing.T) {
+	server, body, _ := captureServer(t, http.StatusOK)
+
+	n := NewTeamsNotifier(server.URL, nil)
+	if err := n.Notify(context.Background(), testMessage()); err != nil {
+		t.Fatalf("전송 실패: %v", err)
+	}
+
+	var card teamsMessageCard
+	if err := json.Unmarshal(*body, &card); err != nil {
+		t.Fatalf("페이로드 파싱 실패: %v", err)
+	}
+	if card.Type != "MessageCard" || card.Summary != "✅ AI 작업 완료" {
+		t.Errorf("카드 헤더 불일치: %+v", card)
+	}
+	if len(card.Sections) != 1 || card.Sections[0].Facts[0].Name != "Worker" {
+		t.Errorf("facts 불일치: %+v", card.Sections)
+	}
+	if len(card.PotentialAction) != 1 || card.PotentialAction[0].Targets[0].URI != "https://app.clickup.com/t/abc123" {
+		t.Errorf("OpenUri 액션 불일치: %+v", card.PotentialAction)
+	}
+}
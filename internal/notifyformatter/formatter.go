@@ -0,0 +1,361 @@
+package notifyformatter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/zime/slickwebhook/internal/notifier"
+)
+
+// compiledTemplate은 파싱된 이벤트 템플릿입니다.
+type compiledTemplate struct {
+	header *template.Template
+	body   *template.Template
+}
+
+// templateData는 템플릿 실행 시 전달되는 데이터입니다.
+type templateData struct {
+	Event
+	TaskURL string // ClickUp 태스크 링크 (TaskID 없으면 빈 문자열)
+	JiraURL string // Jira 이슈 링크 (JiraID 또는 JiraBaseURL 없으면 빈 문자열)
+}
+
+// emailTemplateData는 이메일 템플릿 실행 시 전달되는 데이터입니다.
+type emailTemplateData struct {
+	Email
+	TimeText  string // 수신 시간 (2006-01-02 15:04:05)
+	JiraLinks string // Jira 이슈 링크 목록 (mrkdwn, JiraBaseURL 없으면 빈 문자열)
+}
+
+// Formatter는 AI Worker 이벤트와 새 이메일을 Slack Block Kit 메시지로 변환합니다.
+type Formatter struct {
+	config    Config
+	templates map[EventType]compiledTemplate
+}
+
+// NewFormatter는 새 포맷터를 생성합니다.
+// 기본 템플릿에 Config.Templates의 오버라이드를 적용하며, 템플릿 문법 오류 시 에러를 반환합니다.
+func NewFormatter(config Config) (*Formatter, error) {
+	if config.ClickUpBaseURL == "" {
+		config.ClickUpBaseURL = DefaultConfig().ClickUpBaseURL
+	}
+	config.ClickUpBaseURL = strings.TrimSuffix(config.ClickUpBaseURL, "/")
+	config.JiraBaseURL = strings.TrimSuffix(config.JiraBaseURL, "/")
+
+	merged := DefaultTemplates()
+	for eventType, override := range config.Templates {
+		tmpl := merged[eventType]
+		if override.Header != "" {
+			tmpl.Header = override.Header
+		}
+		if override.Body != "" {
+			tmpl.Body = override.Body
+		}
+		merged[eventType] = tmpl
+	}
+
+	f := &Formatter{
+		config:    config,
+		templates: make(map[EventType]compiledTemplate, len(merged)),
+	}
+
+	for eventType, tmpl := range merged {
+		header, err := template.New(string(eventType) + "_header").Parse(tmpl.Header)
+		if err != nil {
+			return nil, fmt.Errorf("%s 헤더 템플릿 파싱 실패: %w", eventType, err)
+		}
+		body, err := template.New(string(eventType) + "_body").Parse(tmpl.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s 본문 템플릿 파싱 실패: %w", eventType, err)
+		}
+		f.templates[eventType] = compiledTemplate{header: header, body: body}
+	}
+
+	return f, nil
+}
+
+// TaskURL은 ClickUp 태스크 링크를 반환합니다.
+func (f *Formatter) TaskURL(taskID string) string {
+	if taskID == "" {
+		return ""
+	}
+	return f.config.ClickUpBaseURL + "/t/" + taskID
+}
+
+// JiraURL은 Jira 이슈 링크를 반환합니다. JiraBaseURL이 없으면 빈 문자열을 반환합니다.
+func (f *Formatter) JiraURL(issueKey string) string {
+	if issueKey == "" || f.config.JiraBaseURL == "" {
+		return ""
+	}
+	return f.config.JiraBaseURL + "/browse/" + issueKey
+}
+
+// JiraLinks는 Jira 이슈 키 목록을 mrkdwn 링크 목록으로 변환합니다. (예: "<URL|ITSM-1>, <URL|ITSM-2>")
+// JiraBaseURL이 없으면 빈 문자열을 반환합니다.
+func (f *Formatter) JiraLinks(issueKeys []string) string {
+	var links []string
+	for _, key := range issueKeys {
+		if url := f.JiraURL(key); url != "" {
+			links = append(links, fmt.Sprintf("<%s|%s>", url, key))
+		}
+	}
+	return strings.Join(links, ", ")
+}
+
+// Render는 이벤트를 전체 Block Kit 메시지로 변환합니다.
+// 헤더, 필드(Worker/제목/Jira), 본문, 링크 버튼, 푸터 순서로 구성되며 폴백 텍스트를 함께 반환합니다.
+// 태스크 스레드의 부모 메시지처럼 단독으로 보이는 메시지에 사용합니다.
+func (f *Formatter) Render(event Event) ([]slack.Block, string) {
+	data := f.newTemplateData(event)
+	header, body := f.execute(event.Type, data, data.Detail)
+
+	blocks := make([]slack.Block, 0, 5)
+
+	// 1. Header Block
+	headerText := slack.NewTextBlockObject(slack.PlainTextType, header, true, false)
+	blocks = append(blocks, slack.NewHeaderBlock(headerText))
+
+	// 2. 필드 Section Block
+	fields := []*slack.TextBlockObject{
+		slack.NewTextBlockObject(slack.MarkdownType, "*Worker:*\n"+escapeSlackText(event.WorkerID), false, false),
+	}
+	if event.TaskName != "" {
+		fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, "*제목:*\n"+escapeSlackText(event.TaskName), false, false))
+	}
+	if event.JiraID != "" {
+		jiraText := event.JiraID
+		if data.JiraURL != "" {
+			jiraText = fmt.Sprintf("<%s|%s>", data.JiraURL, event.JiraID)
+		}
+		fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, "*Jira 이슈:*\n"+jiraText, false, false))
+	}
+	blocks = append(blocks, slack.NewSectionBlock(nil, fields, nil))
+
+	// 3. 본문 Section Block
+	if body != "" {
+		bodyText := slack.NewTextBlockObject(slack.MarkdownType, body, false, false)
+		blocks = append(blocks, slack.NewSectionBlock(bodyText, nil, nil))
+	}
+
+	// 4. 링크 버튼 Actions Block
+	var buttons []slack.BlockElement
+	if data.TaskURL != "" {
+		text := slack.NewTextBlockObject(slack.PlainTextType, "ClickUp에서 보기", true, false)
+		buttons = append(buttons, slack.NewButtonBlockElement("open_clickup", event.TaskID, text).WithURL(data.TaskURL))
+	}
+	if data.JiraURL != "" {
+		text := slack.NewTextBlockObject(slack.PlainTextType, "Jira 이슈 보기", true, false)
+		buttons = append(buttons, slack.NewButtonBlockElement("open_jira", event.JiraID, text).WithURL(data.JiraURL))
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, slack.NewActionBlock("", buttons...))
+	}
+
+	// 5. Context Block (푸터)
+	blocks = append(blocks, f.contextBlock(data.Time))
+
+	return blocks, f.fallbackText(header, event)
+}
+
+// RenderReply는 이벤트를 스레드 답글용 간결한 Block Kit 메시지로 변환합니다.
+// 태스크 정보는 부모 메시지에 있으므로 헤더와 본문, 푸터만 포함합니다.
+func (f *Formatter) RenderReply(event Event) ([]slack.Block, string) {
+	data := f.newTemplateData(event)
+	header, body := f.execute(event.Type, data, data.Detail)
+
+	text := "*" + header + "*"
+	if body != "" {
+		text += "\n" + body
+	}
+
+	blocks := []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		f.contextBlock(data.Time),
+	}
+
+	return blocks, f.fallbackText(header, event)
+}
+
+// Message는 이벤트를 싱크 독립적인 알림 메시지로 변환합니다.
+// 이벤트 종류는 "ai." 접두어를 붙여 라우팅 키로 사용하며, Slack 싱크용으로 Render 결과를 함께 담습니다.
+func (f *Formatter) Message(event Event) *notifier.Message {
+	data := f.newTemplateData(event)
+	header, body := f.execute(event.Type, data, data.Detail)
+	blocks, _ := f.Render(event)
+
+	msg := &notifier.Message{
+		EventType: MessageEventType(event.Type),
+		Title:     header,
+		Text:      body,
+		Fields:    []notifier.Field{{Name: "Worker", Value: event.WorkerID}},
+		Blocks:    blocks,
+		Time:      data.Time,
+	}
+	if event.TaskName != "" {
+		msg.Fields = append(msg.Fields, notifier.Field{Name: "제목", Value: event.TaskName})
+	}
+	if event.JiraID != "" {
+		msg.Fields = append(msg.Fields, notifier.Field{Name: "Jira 이슈", Value: event.JiraID})
+	}
+	if data.TaskURL != "" {
+		msg.Links = append(msg.Links, notifier.Link{Label: "ClickUp에서 보기", URL: data.TaskURL})
+	}
+	if data.JiraURL != "" {
+		msg.Links = append(msg.Links, notifier.Link{Label: "Jira 이슈 보기", URL: data.JiraURL})
+	}
+
+	return msg
+}
+
+// RenderEmail은 새 이메일을 Block Kit 메시지로 변환합니다.
+// 헤더, 메타 정보(EventEmailNew 템플릿 본문), 본문 미리보기(최대 300자), 구분선, 푸터 순서로 구성됩니다.
+func (f *Formatter) RenderEmail(email Email) ([]slack.Block, string) {
+	data := f.newEmailTemplateData(email)
+	header, meta := f.execute(EventEmailNew, data, "")
+
+	blocks := make([]slack.Block, 0, 5)
+
+	// 1. Header Block
+	headerText := slack.NewTextBlockObject(slack.PlainTextType, header, true, false)
+	blocks = append(blocks, slack.NewHeaderBlock(headerText))
+
+	// 2. 메타 정보 Section Block
+	if meta != "" {
+		metaText := slack.NewTextBlockObject(slack.MarkdownType, meta, false, false)
+		blocks = append(blocks, slack.NewSectionBlock(metaText, nil, nil))
+	}
+
+	// 3. 본문 미리보기 Section Block (인용 형식)
+	if preview := truncateText(email.Text, 300); preview != "" {
+		preview = strings.ReplaceAll(escapeSlackText(preview), "\n", "\n> ")
+		bodyText := slack.NewTextBlockObject(slack.MarkdownType, "> "+preview, false, false)
+		blocks = append(blocks, slack.NewSectionBlock(bodyText, nil, nil))
+	}
+
+	// 4. Divider
+	blocks = append(blocks, slack.NewDividerBlock())
+
+	// 5. Context Block (푸터)
+	blocks = append(blocks, f.contextBlock(data.Time))
+
+	return blocks, "새 이메일: " + email.Subject
+}
+
+// EmailMessage는 새 이메일을 싱크 독립적인 알림 메시지로 변환합니다.
+// 라우팅 키는 EventEmailNew이며, Slack 싱크용으로 RenderEmail 결과를 함께 담습니다.
+func (f *Formatter) EmailMessage(email Email) *notifier.Message {
+	data := f.newEmailTemplateData(email)
+	blocks, _ := f.RenderEmail(email)
+
+	msg := &notifier.Message{
+		EventType: string(EventEmailNew),
+		Title:     "📧 새 이메일: " + email.Subject,
+		Text:      truncateText(email.Text, 300),
+		Fields: []notifier.Field{
+			{Name: "발신자", Value: email.From},
+			{Name: "제목", Value: email.Subject},
+			{Name: "시간", Value: data.TimeText},
+		},
+		Blocks: blocks,
+		Time:   data.Time,
+	}
+	for _, key := range email.JiraKeys {
+		if url := f.JiraURL(key); url != "" {
+			msg.Links = append(msg.Links, notifier.Link{Label: key, URL: url})
+		}
+	}
+
+	return msg
+}
+
+// MessageEventType은 이벤트 종류를 알림 라우팅 키로 변환합니다. (예: completed → ai.completed)
+func MessageEventType(eventType EventType) string {
+	return "ai." + string(eventType)
+}
+
+// newTemplateData는 이벤트로 템플릿 데이터를 생성합니다.
+func (f *Formatter) newTemplateData(event Event) templateData {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	return templateData{
+		Event:   event,
+		TaskURL: f.TaskURL(event.TaskID),
+		JiraURL: f.JiraURL(event.JiraID),
+	}
+}
+
+// newEmailTemplateData는 이메일로 템플릿 데이터를 생성합니다.
+// 발신자와 제목은 mrkdwn에 그대로 넣을 수 있도록 이스케이프합니다.
+func (f *Formatter) newEmailTemplateData(email Email) emailTemplateData {
+	if email.Time.IsZero() {
+		email.Time = time.Now()
+	}
+	data := emailTemplateData{
+		Email:     email,
+		TimeText:  email.Time.Format("2006-01-02 15:04:05"),
+		JiraLinks: f.JiraLinks(email.JiraKeys),
+	}
+	data.From = escapeSlackText(email.From)
+	data.Subject = escapeSlackText(email.Subject)
+	return data
+}
+
+// execute는 이벤트 종류에 맞는 템플릿으로 헤더와 본문을 생성합니다.
+// 템플릿 실행 실패 시 이벤트 종류와 fallbackBody로 대체합니다.
+func (f *Formatter) execute(eventType EventType, data any, fallbackBody string) (string, string) {
+	tmpl, ok := f.templates[eventType]
+	if !ok {
+		return string(eventType), fallbackBody
+	}
+
+	var header, body bytes.Buffer
+	if err := tmpl.header.Execute(&header, data); err != nil {
+		header.Reset()
+		header.WriteString(string(eventType))
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		body.Reset()
+		body.WriteString(fallbackBody)
+	}
+
+	return strings.TrimSpace(header.String()), strings.TrimSpace(body.String())
+}
+
+// contextBlock은 푸터와 시간을 표시하는 Context 블록을 생성합니다.
+func (f *Formatter) contextBlock(t time.Time) *slack.ContextBlock {
+	text := t.Format("2006-01-02 15:04:05")
+	if f.config.Footer != "" {
+		text = f.config.Footer + " · " + text
+	}
+	return slack.NewContextBlock("", slack.NewTextBlockObject(slack.PlainTextType, text, true, false))
+}
+
+// fallbackText는 Block을 지원하지 않는 클라이언트용 텍스트를 생성합니다.
+func (f *Formatter) fallbackText(header string, event Event) string {
+	if event.TaskName == "" {
+		return fmt.Sprintf("%s (%s)", header, event.WorkerID)
+	}
+	return fmt.Sprintf("%s (%s): %s", header, event.WorkerID, event.TaskName)
+}
+
+// escapeSlackText는 Slack 특수문자를 이스케이프합니다.
+func escapeSlackText(text string) string {
+	text = strings.ReplaceAll(text, "&", "&amp;")
+	text = strings.ReplaceAll(text, "<", "&lt;")
+	text = strings.ReplaceAll(text, ">", "&gt;")
+	return text
+}
+
+// truncateText는 텍스트를 지정된 길이로 자릅니다.
+func truncateText(text string, maxLen int) string {
+	text = strings.TrimSpace(text)
+	if len(text) <= maxLen {
+		return text
+	}
+	return text[:maxLen] + "..."
+}
@@ -0,0 +1,266 @@
+package notifyformatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// blocksJSON은 검증을 위해 Block 목록을 JSON 문자열로 변환합니다.
+func blocksJSON(t *testing.T, blocks []slack.Block) string {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(blocks); err != nil {
+		t.Fatalf("Block 직렬화 실패: %v", err)
+	}
+	return buf.String()
+}
+
+// TestFormatter_Render는 전체 Block Kit 렌더링을 테스트합니다.
+func TestFormatter_Render(t *testing.T) {
+	config := DefaultConfig()
+	config.JiraBaseURL = "https://example.atlassian.net/"
+	f, err := NewFormatter(config)
+	if err != nil {
+		t.Fatalf("포맷터 생성 실패: %v", err)
+	}
+
+	blocks, fallback := f.Render(Event{
+		Type:     EventCompleted,
+		WorkerID: "AI_01",
+		TaskID:   "abc123",
+		TaskName: "로그인 <버그> 수정",
+		JiraID:   "ITSM-1234",
+		Detail:   "모든 테스트 통과",
+		Time:     time.Date(2026, 1, 2, 15, 4, 5, 0, time.Local),
+	})
+
+	// Header, 필드, 본문, 버튼, 푸터
+	if len(blocks) != 5 {
+		t.Fatalf("Block 개수 불일치: got %d, want 5", len(blocks))
+	}
+	if blocks[0].BlockType() != slack.MBTHeader {
+		t.Errorf("첫 Block은 Header여야 함: got %s", blocks[0].BlockType())
+	}
+	if blocks[3].BlockType() != slack.MBTAction {
+		t.Errorf("네 번째 Block은 Actions여야 함: got %s", blocks[3].BlockType())
+	}
+
+	js := blocksJSON(t, blocks)
+	for _, want := range []string{
+		"✅ AI 작업 완료",
+		"https://app.clickup.com/t/abc123",
+		"https://example.atlassian.net/browse/ITSM-1234",
+		"모든 테스트 통과",
+		"2026-01-02 15:04:05",
+	} {
+		if !strings.Contains(js, want) {
+			t.Errorf("Block에 %q가 포함되어야 함", want)
+		}
+	}
+
+	// 태스크 제목은 이스케이프되어야 함
+	if !strings.Contains(js, "&lt;버그&gt;") {
+		t.Error("태스크 제목의 특수문자가 이스케이프되어야 함")
+	}
+
+	if !strings.Contains(fallback, "AI_01") || !strings.Contains(fallback, "로그인") {
+		t.Errorf("폴백 텍스트 불일치: %s", fallback)
+	}
+}
+
+// TestFormatter_Render_NoJiraBaseURL은 Jira 기본 URL이 없을 때 링크 없이 렌더링되는지 테스트합니다.
+func TestFormatter_Render_NoJiraBaseURL(t *testing.T) {
+	f, err := NewFormatter(DefaultConfig())
+	if err != nil {
+		t.Fatalf("포맷터 생성 실패: %v", err)
+	}
+
+	blocks, _ := f.Render(Event{
+		Type:     EventPlanReady,
+		WorkerID: "AI_02",
+		TaskID:   "abc123",
+		JiraID:   "ITSM-1234",
+	})
+
+	js := blocksJSON(t, blocks)
+	if strings.Contains(js, "/browse/") {
+		t.Error("JiraBaseURL이 없으면 Jira 링크가 없어야 함")
+	}
+	if !strings.Contains(js, "ITSM-1234") {
+		t.Error("Jira 이슈 키는 표시되어야 함")
+	}
+	if strings.Contains(js, "open_jira") {
+		t.Error("JiraBaseURL이 없으면 Jira 버튼이 없어야 함")
+	}
+}
+
+// TestFormatter_TemplateOverride는 이벤트별 템플릿 오버라이드를 테스트합니다.
+func TestFormatter_TemplateOverride(t *testing.T) {
+	config := DefaultConfig()
+	config.ClickUpBaseURL = "https://clickup.example.com"
+	config.Templates[EventFailed] = Template{
+		Body: "{{.WorkerID}} 실패: {{.Detail}} ({{.TaskURL}})",
+	}
+
+	f, err := NewFormatter(config)
+	if err != nil {
+		t.Fatalf("포맷터 생성 실패: %v", err)
+	}
+
+	blocks, _ := f.RenderReply(Event{
+		Type:     EventFailed,
+		WorkerID: "AI_03",
+		TaskID:   "t1",
+		Detail:   "API 에러",
+	})
+
+	js := blocksJSON(t, blocks)
+	// 헤더는 기본값 유지
+	if !strings.Contains(js, "❌ AI 작업 실패") {
+		t.Error("오버라이드하지 않은 헤더는 기본값이어야 함")
+	}
+	if !strings.Contains(js, "AI_03 실패: API 에러 (https://clickup.example.com/t/t1)") {
+		t.Errorf("오버라이드된 본문이 적용되어야 함: %s", js)
+	}
+}
+
+// TestNewFormatter_InvalidTemplate은 잘못된 템플릿 문법 시 에러를 반환하는지 테스트합니다.
+func TestNewFormatter_InvalidTemplate(t *testing.T) {
+	config := DefaultConfig()
+	config.Templates[EventCompleted] = Template{Header: "{{.WorkerID"}
+
+	if _, err := NewFormatter(config); err == nil {
+		t.Error("잘못된 템플릿은 에러를 반환해야 함")
+	}
+}
+
+// TestFormatter_Message는 싱크 독립 메시지 변환을 테스트합니다.
+func TestFormatter_Message(t *testing.T) {
+	config := DefaultConfig()
+	config.JiraBaseURL = "https://example.atlassian.net"
+	f, err := NewFormatter(config)
+	if err != nil {
+		t.Fatalf("포맷터 생성 실패: %v", err)
+	}
+
+	msg := f.Message(Event{
+		Type:     EventFailed,
+		WorkerID: "AI_02",
+		TaskID:   "abc123",
+		TaskName: "결제 오류",
+		JiraID:   "ITSM-42",
+		Detail:   "빌드 실패",
+	})
+
+	if msg.EventType != "ai.failed" {
+		t.Errorf("EventType 불일치: %s", msg.EventType)
+	}
+	if msg.Title == "" || !strings.Contains(msg.Text, "빌드 실패") {
+		t.Errorf("제목/본문 불일치: %q / %q", msg.Title, msg.Text)
+	}
+	if len(msg.Fields) != 3 {
+		t.Errorf("필드 개수 불일치: %+v", msg.Fields)
+	}
+	if len(msg.Links) != 2 || msg.Links[1].URL != "https://example.atlassian.net/browse/ITSM-42" {
+		t.Errorf("링크 불일치: %+v", msg.Links)
+	}
+	if len(msg.Blocks) == 0 || msg.Time.IsZero() {
+		t.Error("Blocks와 Time이 채워져야 함")
+	}
+}
+
+// TestFormatter_RenderEmail는 이메일 Block Kit 렌더링과 템플릿 오버라이드를 테스트합니다.
+func TestFormatter_RenderEmail(t *testing.T) {
+	config := DefaultConfig()
+	config.JiraBaseURL = "https://example.atlassian.net"
+	config.Templates[EventEmailNew] = Template{Header: "📨 메일 도착"}
+	f, err := NewFormatter(config)
+	if err != nil {
+		t.Fatalf("포맷터 생성 실패: %v", err)
+	}
+
+	email := Email{
+		From:     "jira@example.com",
+		Subject:  "ITSM-1234 <로그인> 오류",
+		Text:     "첫 줄\n둘째 줄",
+		JiraKeys: []string{"ITSM-1234"},
+		Time:     time.Date(2025, 1, 7, 14, 30, 0, 0, time.UTC),
+	}
+	blocks, fallback := f.RenderEmail(email)
+
+	// Header + Meta + Body + Divider + Context = 5
+	if len(blocks) != 5 {
+		t.Fatalf("Block 수 불일치: %d", len(blocks))
+	}
+	out := blocksJSON(t, blocks)
+	for _, want := range []string{
+		"📨 메일 도착",
+		"*제목:* ITSM-1234 &lt;로그인&gt; 오류",
+		"*시간:* 2025-01-07 14:30:00",
+		"<https://example.atlassian.net/browse/ITSM-1234|ITSM-1234>",
+		"> 첫 줄\\n> 둘째 줄",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("렌더링 결과에 %q 없음: %s", want, out)
+		}
+	}
+	if !strings.Contains(fallback, "ITSM-1234") {
+		t.Errorf("폴백 텍스트 불일치: %q", fallback)
+	}
+
+	msg := f.EmailMessage(email)
+	if msg.EventType != "email.new" {
+		t.Errorf("EventType 불일치: %s", msg.EventType)
+	}
+	if len(msg.Links) != 1 || msg.Links[0].URL != "https://example.atlassian.net/browse/ITSM-1234" {
+		t.Errorf("링크 불일치: %+v", msg.Links)
+	}
+}
+
+// TestEscapeSlackText는 특수문자 이스케이프를 테스트합니다.
+func TestEscapeSlackText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"hello", "hello"},
+		{"<script>", "&lt;script&gt;"},
+		{"a & b", "a &amp; b"},
+		{"<test>&value</test>", "&lt;test&gt;&amp;value&lt;/test&gt;"},
+	}
+
+	for _, tt := range tests {
+		result := escapeSlackText(tt.input)
+		if result != tt.expected {
+			t.Errorf("escapeSlackText(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+// TestTruncateText는 텍스트 자르기를 테스트합니다.
+func TestTruncateText(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxLen   int
+		expected string
+	}{
+		{"hello", 10, "hello"},
+		{"hello world", 5, "hello..."},
+		{"  trimmed  ", 20, "trimmed"},
+		{"", 10, ""},
+	}
+
+	for _, tt := range tests {
+		result := truncateText(tt.input, tt.maxLen)
+		if result != tt.expected {
+			t.Errorf("truncateText(%q, %d) = %q, want %q", tt.input, tt.maxLen, result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Package notifyformatter는 AI Worker와 Email 모니터 알림을 Slack Block Kit 메시지로 변환합니다.
+package notifyformatter
+
+import "time"
+
+// EventType은 알림 이벤트 종류입니다.
+type EventType string
+
+const (
+	EventWorking     EventType = "working"      // 작업 진행 중
+	EventProgress    EventType = "progress"     // 진행 상황 (heartbeat)
+	EventPlanReady   EventType = "plan_ready"   // 계획 수립 완료 (검토 필요)
+	EventRateLimited EventType = "rate_limited" // Rate Limit 도달
+	EventFailed      EventType = "failed"       // 실패 (API 에러, Context 초과 등)
+	EventCancelled   EventType = "cancelled"    // 취소 (사용자 취소, 태스크 삭제/이동/취소 상태)
+	EventCompleted   EventType = "completed"    // 작업 완료
+	EventRecovered   EventType = "recovered"    // 재시작 후 작업중 태스크 복구
+	EventSLABreached EventType = "sla_breached" // 대기/실행 SLA 초과
+	EventSLAResolved EventType = "sla_resolved" // SLA 초과 해소
+
+	EventPreflightFailed EventType = "preflight_failed" // 사전 점검 실패 (태스크 시작 안함)
+	EventQuestion        EventType = "question"         // 에이전트가 보고자에게 질문 (답변 필요)
+)
+
+// EventEmailNew는 Email 모니터의 새 이메일 알림입니다. 알림 라우팅 키로도 그대로 사용합니다.
+const EventEmailNew EventType = "email.new"
+
+// AllEventTypes는 템플릿 오버라이드 대상이 되는 모든 AI Worker 이벤트 종류입니다.
+var AllEventTypes = []EventType{
+	EventWorking,
+	EventProgress,
+	EventPlanReady,
+	EventRateLimited,
+	EventFailed,
+	EventCancelled,
+	EventCompleted,
+	EventRecovered,
+	EventSLABreached,
+	EventSLAResolved,
+	EventPreflightFailed,
+	EventQuestion,
+}
+
+// Event는 알림으로 렌더링할 AI Worker 이벤트입니다.
+type Event struct {
+	Type     EventType
+	WorkerID string    // Worker ID (예: "AI_01")
+	TaskID   string    // ClickUp 태스크 ID
+	TaskName string    // 태스크 제목
+	JiraID   string    // Jira 이슈 키 (예: "ITSM-1234")
+	Detail   string    // 이벤트별 상세 내용 (mrkdwn)
+	Time     time.Time // 이벤트 발생 시간 (비어있으면 렌더링 시각)
+}
+
+// Email은 알림으로 렌더링할 새 이메일입니다.
+type Email struct {
+	From     string    // 발신자
+	Subject  string    // 표시용 제목 (Jira 이메일은 "이슈키 이슈타이틀" 형식)
+	Text     string    // 본문
+	JiraKeys []string  // 제목/본문에서 추출한 Jira 이슈 키 (중복 제거)
+	Time     time.Time // 수신 시간
+}
+
+// Template은 이벤트 종류별 메시지 템플릿입니다.
+// Header와 Body는 text/template 문법을 사용하며 Event 필드와 TaskURL, JiraURL을 참조할 수 있습니다.
+// 예: "{{.WorkerID}} 작업 완료", "{{.Detail}}\n<{{.TaskURL}}|태스크 보기>"
+// EventEmailNew 템플릿은 Email 필드(From, Subject는 이스케이프됨)와 TimeText, JiraLinks를 참조합니다.
+type Template struct {
+	Header string // 헤더 문구 (plain text)
+	Body   string // 본문 (mrkdwn)
+}
+
+// Config는 포맷터 설정입니다.
+type Config struct {
+	JiraBaseURL    string                 // Jira 이슈 링크용 (예: https://example.atlassian.net)
+	ClickUpBaseURL string                 // ClickUp 태스크 링크용 (기본: https://app.clickup.com)
+	Footer         string                 // Context 블록 푸터 문구
+	Templates      map[EventType]Template // 이벤트별 템플릿 오버라이드 (빈 필드는 기본값 사용)
+}
+
+// DefaultConfig는 기본 설정을 반환합니다.
+func DefaultConfig() Config {
+	return Config{
+		ClickUpBaseURL: "https://app.clickup.com",
+		Footer:         "AI Worker 자동 알림",
+		Templates:      map[EventType]Template{},
+	}
+}
+
+// DefaultTemplates는 이벤트 종류별 기본 템플릿을 반환합니다.
+func DefaultTemplates() map[EventType]Template {
+	return map[EventType]Template{
+		EventWorking:     {Header: "🔄 AI 작업 진행 중", Body: "{{.Detail}}"},
+		EventProgress:    {Header: "🔄 진행 상황", Body: "{{.Detail}}"},
+		EventPlanReady:   {Header: "📋 계획 수립 완료 - 검토 필요", Body: "{{.Detail}}"},
+		EventRateLimited: {Header: "⚠️ Rate Limit 대기", Body: "{{.Detail}}"},
+		EventFailed:      {Header: "❌ AI 작업 실패", Body: "{{.Detail}}"},
+		EventCancelled:   {Header: "↩️ AI 작업 취소됨", Body: "{{.Detail}}"},
+		EventCompleted:   {Header: "✅ AI 작업 완료", Body: "{{.Detail}}"},
+		EventRecovered:   {Header: "🔁 AI 작업 복구", Body: "{{.Detail}}"},
+		EventSLABreached: {Header: "🚨 SLA 초과", Body: "{{.Detail}}"},
+		EventSLAResolved: {Header: "✅ SLA 초과 해소", Body: "{{.Detail}}"},
+
+		EventPreflightFailed: {Header: "🛑 사전 점검 실패", Body: "{{.Detail}}"},
+		EventQuestion:        {Header: "❓ 에이전트 질문 - 답변 필요", Body: "{{.Detail}}"},
+
+		EventEmailNew: {
+			Header: "📧 새 이메일 알림",
+			Body:   "*발신자:* {{.From}}\n*제목:* {{.Subject}}\n*시간:* {{.TimeText}}{{if .JiraLinks}}\n*🔗 Jira 이슈:* {{.JiraLinks}}{{end}}",
+		},
+	}
+}
@@ -0,0 +1,384 @@
+package remoteagent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/hookserver"
+)
+
+// RetryDelay는 코디네이터 연결 실패 후 다시 시도하기까지 기다리는 시간입니다.
+const RetryDelay = 5 * time.Second
+
+// errNotRegistered는 코디네이터가 에이전트를 모를 때(코디네이터 재시작 등) 반환됩니다.
+var errNotRegistered = errors.New("코디네이터에 등록되지 않은 에이전트")
+
+// AgentConfig는 에이전트 설정입니다.
+type AgentConfig struct {
+	CoordinatorURL string            // 코디네이터 에이전트 API 주소 (예: http://ai-worker:8082)
+	Token          string            // 공유 인증 토큰
+	Registration   Registration      // 등록 정보 (AgentID, 저장소, 모델, 슬롯)
+	RepoPaths      map[string]string // 저장소 이름 → 로컬 경로
+	HookPort       int               // AI 도구가 Hook을 보내는 로컬 포트
+}
+
+// Agent는 코디네이터에서 작업을 받아 로컬에서 실행하는 원격 에이전트입니다.
+type Agent struct {
+	config     AgentConfig
+	runner     Runner
+	client     *http.Client
+	retryDelay time.Duration
+	logger     *log.Logger
+
+	mu      sync.Mutex
+	running map[string]*runningJob // Worker ID → 실행 중인 작업
+}
+
+// runningJob은 에이전트에서 실행 중인 작업입니다.
+type runningJob struct {
+	dir     string // 로컬 작업 경로
+	started bool   // AI 도구 실행 완료 여부 (시작 전에는 세션 확인으로 제거하지 않음)
+}
+
+// NewAgent는 새 Agent를 생성합니다.
+func NewAgent(config AgentConfig, runner Runner) *Agent {
+	return &Agent{
+		config:     config,
+		runner:     runner,
+		client:     &http.Client{Timeout: DefaultPollTimeout + 10*time.Second},
+		retryDelay: RetryDelay,
+		running:    make(map[string]*runningJob),
+	}
+}
+
+// SetLogger는 로거를 설정합니다.
+func (a *Agent) SetLogger(logger *log.Logger) {
+	a.logger = logger
+}
+
+// Run은 로컬 Hook 서버를 시작하고 코디네이터에 등록한 뒤 컨텍스트가 취소될 때까지 작업을 받아 실행합니다.
+func (a *Agent) Run(ctx context.Context) error {
+	hookServer := &http.Server{
+		Addr:         fmt.Sprintf(":%d", a.config.HookPort),
+		Handler:      a.HookHandler(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		if err := hookServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errChan <- fmt.Errorf("Hook 서버 시작 실패: %w", err)
+		}
+	}()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		hookServer.Shutdown(shutdownCtx)
+	}()
+	a.logf("[Agent] Hook 서버 시작: %s", hookServer.Addr)
+
+	registered := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errChan:
+			return err
+		default:
+		}
+
+		if !registered {
+			if err := a.register(ctx); err != nil {
+				a.logf("[Agent] ⚠️ 코디네이터 등록 실패: %v", err)
+				a.wait(ctx)
+				continue
+			}
+			registered = true
+			a.logf("[Agent] 코디네이터 등록 완료: %s", a.config.CoordinatorURL)
+		}
+
+		job, err := a.poll(ctx)
+		switch {
+		case errors.Is(err, errNotRegistered):
+			a.logf("[Agent] 코디네이터에 등록 정보가 없어 다시 등록합니다")
+			registered = false
+		case err != nil:
+			if ctx.Err() == nil {
+				a.logf("[Agent] ⚠️ 작업 요청 실패: %v", err)
+				a.wait(ctx)
+			}
+		case job != nil:
+			if job.Kind == JobRun {
+				// 다음 poll과 Hook이 바로 올 수 있으므로 실행 전에 등록
+				a.track(*job)
+			}
+			go a.handleJob(ctx, *job)
+		}
+	}
+}
+
+// handleJob은 코디네이터에서 받은 작업을 처리합니다.
+func (a *Agent) handleJob(ctx context.Context, job Job) {
+	switch job.Kind {
+	case JobRun:
+		if err := a.runJob(ctx, job); err != nil {
+			a.logf("[Agent] ❌ 작업 실행 실패: %s: %v", job.WorkerID, err)
+			payload, _ := json.Marshal(AgentErrorPayload{Message: err.Error()})
+			if err := a.sendHook(ctx, HookEvent{WorkerID: job.WorkerID, Event: EventAgentError, Payload: payload}); err != nil {
+				a.logf("[Agent] ⚠️ 실행 실패 보고 실패: %s: %v", job.WorkerID, err)
+			}
+		}
+	case JobTerminate:
+		a.untrack(job.WorkerID)
+		if err := a.runner.Terminate(job.WorkerID); err != nil {
+			a.logf("[Agent] ⚠️ AI 도구 종료 실패: %s: %v", job.WorkerID, err)
+		} else {
+			a.logf("[Agent] AI 도구 종료: %s", job.WorkerID)
+		}
+	default:
+		a.logf("[Agent] ⚠️ 알 수 없는 작업 종류 무시: %s", job.Kind)
+	}
+}
+
+// runJob은 저장소의 로컬 경로에서 AI 도구를 실행합니다.
+func (a *Agent) runJob(ctx context.Context, job Job) error {
+	workDir, ok := a.config.RepoPaths[job.Repo]
+	if !ok {
+		a.untrack(job.WorkerID)
+		return fmt.Errorf("저장소 경로 없음: %s", job.Repo)
+	}
+
+	opts := aiworker.RunOptions{Model: job.Model, Mode: job.Mode}
+	if _, err := a.runner.Invoke(ctx, workDir, job.Prompt, job.WorkerID, opts); err != nil {
+		a.untrack(job.WorkerID)
+		return err
+	}
+	a.mu.Lock()
+	if running, ok := a.running[job.WorkerID]; ok {
+		running.started = true
+	}
+	a.mu.Unlock()
+	a.logf("[Agent] 🚀 작업 시작: %s (저장소: %s, 경로: %s)", job.WorkerID, job.Repo, workDir)
+	return nil
+}
+
+// HookHandler는 로컬 AI 도구의 Hook(/hook/{이벤트})을 받아 코디네이터로 전달하는 핸들러를 반환합니다.
+// cwd로 실행 중인 Worker를 찾으며, 세션 종료 이벤트를 받으면 실행 목록에서 제거합니다.
+func (a *Agent) HookHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		event := strings.TrimPrefix(r.URL.Path, "/hook/")
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			return
+		}
+		var payload struct {
+			Cwd string `json:"cwd"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			http.Error(w, "Failed to parse payload", http.StatusBadRequest)
+			return
+		}
+
+		workerID := a.workerByDir(payload.Cwd)
+		if workerID == "" {
+			a.logf("[Agent] 실행 중인 Worker가 없는 Hook 무시: %s (cwd: %s)", event, payload.Cwd)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if event == hookserver.EventSessionEnd {
+			a.untrack(workerID)
+		}
+
+		if err := a.sendHook(r.Context(), HookEvent{WorkerID: workerID, Event: event, Payload: body}); err != nil {
+			a.logf("[Agent] ⚠️ Hook 전달 실패: %s → %s: %v", event, workerID, err)
+			http.Error(w, "Failed to forward hook", http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+// track은 실행할 Worker와 작업 경로를 실행 목록에 등록합니다.
+func (a *Agent) track(job Job) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.running[job.WorkerID] = &runningJob{dir: filepath.Clean(a.config.RepoPaths[job.Repo])}
+}
+
+// untrack은 Worker를 실행 목록에서 제거합니다.
+func (a *Agent) untrack(workerID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.running, workerID)
+}
+
+// workerByDir는 cwd(또는 그 상위 경로)에서 실행 중인 Worker ID를 찾습니다.
+func (a *Agent) workerByDir(cwd string) string {
+	if cwd == "" {
+		return ""
+	}
+	cwd = filepath.Clean(cwd)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for workerID, running := range a.running {
+		if cwd == running.dir || strings.HasPrefix(cwd, running.dir+string(filepath.Separator)) {
+			return workerID
+		}
+	}
+	return ""
+}
+
+// runningWorkers는 실행 중인 Worker ID 목록을 반환합니다.
+// Runner가 세션 확인을 지원하면 종료된 세션은 목록에서 제거합니다.
+func (a *Agent) runningWorkers() []string {
+	a.mu.Lock()
+	var ids, started []string
+	for workerID, running := range a.running {
+		ids = append(ids, workerID)
+		if running.started {
+			started = append(started, workerID)
+		}
+	}
+	a.mu.Unlock()
+
+	checker, ok := a.runner.(aiworker.AgentSessionChecker)
+	if !ok {
+		return ids
+	}
+	dead := make(map[string]bool)
+	for _, workerID := range started {
+		if alive, err := checker.IsSessionAlive(workerID); err == nil && !alive {
+			a.untrack(workerID)
+			dead[workerID] = true
+		}
+	}
+	alive := ids[:0]
+	for _, workerID := range ids {
+		if !dead[workerID] {
+			alive = append(alive, workerID)
+		}
+	}
+	return alive
+}
+
+// register는 코디네이터에 에이전트를 등록합니다.
+func (a *Agent) register(ctx context.Context) error {
+	reg := a.config.Registration
+	reg.Running = a.runningWorkers()
+	body, err := json.Marshal(reg)
+	if err != nil {
+		return fmt.Errorf("등록 정보 직렬화 실패: %w", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPost, PathRegister, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("등록 거부 (status %d)", resp.StatusCode)
+	}
+	return nil
+}
+
+// poll은 코디네이터에 작업을 요청합니다. 작업이 없으면 nil을 반환합니다.
+func (a *Agent) poll(ctx context.Context) (*Job, error) {
+	path := PathPoll
+	if running := a.runningWorkers(); len(running) > 0 {
+		path += "?running=" + url.QueryEscape(strings.Join(running, ","))
+	}
+
+	resp, err := a.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var job Job
+		if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+			return nil, fmt.Errorf("작업 파싱 실패: %w", err)
+		}
+		return &job, nil
+	case http.StatusNoContent:
+		return nil, nil
+	case http.StatusNotFound:
+		return nil, errNotRegistered
+	default:
+		return nil, fmt.Errorf("작업 요청 거부 (status %d)", resp.StatusCode)
+	}
+}
+
+// sendHook은 Hook 이벤트를 코디네이터로 전달합니다.
+func (a *Agent) sendHook(ctx context.Context, event HookEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("Hook 이벤트 직렬화 실패: %w", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPost, PathHook, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("Hook 전달 거부 (status %d): %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// do는 인증 헤더를 붙여 코디네이터에 요청합니다.
+func (a *Agent) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(a.config.CoordinatorURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+a.config.Token)
+	req.Header.Set(HeaderAgentID, a.config.Registration.AgentID)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("코디네이터 요청 실패: %w", err)
+	}
+	return resp, nil
+}
+
+// wait는 retryDelay만큼 기다립니다. 컨텍스트가 취소되면 바로 반환합니다.
+func (a *Agent) wait(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(a.retryDelay):
+	}
+}
+
+func (a *Agent) logf(format string, args ...interface{}) {
+	if a.logger != nil {
+		a.logger.Printf(format, args...)
+	}
+}
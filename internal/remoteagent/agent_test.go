@@ -0,0 +1,134 @@
+package remoteagent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// MockRunner는 실행/종료 요청을 기록하는 테스트용 Runner입니다.
+type MockRunner struct {
+	mu         sync.Mutex
+	Invoked    []string // "WorkerID@workDir"
+	Options    []aiworker.RunOptions
+	Terminated []string
+	started    chan struct{}
+}
+
+func (m *MockRunner) Invoke(ctx context.Context, workDir, prompt, workerID string, opts aiworker.RunOptions) (*aiworker.InvokeResult, error) {
+	m.mu.Lock()
+	m.Invoked = append(m.Invoked, workerID+"@"+workDir)
+	m.Options = append(m.Options, opts)
+	m.mu.Unlock()
+	m.started <- struct{}{}
+	return &aiworker.InvokeResult{WorkDir: workDir}, nil
+}
+
+func (m *MockRunner) Terminate(workerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Terminated = append(m.Terminated, workerID)
+	return nil
+}
+
+// TestAgent_RunAndForwardHook은 에이전트가 작업을 받아 실행하고 로컬 Hook을 코디네이터로 전달하는지 테스트합니다.
+func TestAgent_RunAndForwardHook(t *testing.T) {
+	coordinator := NewCoordinator(testToken)
+	coordinator.pollTimeout = 100 * time.Millisecond
+	coordinator.SetWorkDirResolver(func(workerID string) (string, bool) {
+		return "agent://repo-a/" + workerID, true
+	})
+	dispatched := make(chan string, 1)
+	coordinator.SetHookDispatcher(func(event string, body []byte) error {
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		dispatched <- event + " " + payload["cwd"].(string)
+		return nil
+	})
+	server := httptest.NewServer(coordinator.Handler())
+	defer server.Close()
+
+	runner := &MockRunner{started: make(chan struct{}, 1)}
+	agent := NewAgent(AgentConfig{
+		CoordinatorURL: server.URL,
+		Token:          testToken,
+		Registration:   Registration{AgentID: "linux-1", Repos: []string{"repo-a"}},
+		RepoPaths:      map[string]string{"repo-a": "/home/build/repo-a"},
+	}, runner)
+	agent.retryDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go agent.Run(ctx)
+
+	// 등록될 때까지 대기 후 배정
+	deadline := time.Now().Add(2 * time.Second)
+	for coordinator.CheckAvailable("repo-a", aimodel.AIModelClaude) != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("에이전트 등록 시간 초과")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	job := Job{Kind: JobRun, WorkerID: "AI_01", Repo: "repo-a", Model: aimodel.AIModelOpenCode, Mode: aiworker.ModeDirect}
+	if _, err := coordinator.Assign(job); err != nil {
+		t.Fatalf("배정 실패: %v", err)
+	}
+
+	select {
+	case <-runner.started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("작업 실행 시간 초과")
+	}
+	runner.mu.Lock()
+	if runner.Invoked[0] != "AI_01@/home/build/repo-a" {
+		t.Errorf("실행 경로 불일치: %s", runner.Invoked[0])
+	}
+	if want := (aiworker.RunOptions{Model: aimodel.AIModelOpenCode, Mode: aiworker.ModeDirect}); runner.Options[0] != want {
+		t.Errorf("실행 옵션 불일치: %+v", runner.Options[0])
+	}
+	runner.mu.Unlock()
+
+	// 로컬 Hook → 코디네이터 (cwd는 코디네이터 Worker 경로로 변환)
+	req := httptest.NewRequest(http.MethodPost, "/hook/task-complete",
+		bytes.NewReader([]byte(`{"cwd": "/home/build/repo-a/sub", "status": "completed"}`)))
+	w := httptest.NewRecorder()
+	agent.HookHandler().ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Hook 전달 실패: %d %s", w.Code, w.Body.String())
+	}
+	select {
+	case got := <-dispatched:
+		if got != "task-complete agent://repo-a/AI_01" {
+			t.Errorf("Hook 처리 불일치: %s", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Hook 처리 시간 초과")
+	}
+
+	// 종료 요청 전달
+	coordinator.Terminate("AI_01")
+	deadline = time.Now().Add(2 * time.Second)
+	for {
+		runner.mu.Lock()
+		terminated := len(runner.Terminated)
+		runner.mu.Unlock()
+		if terminated == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("종료 요청 전달 시간 초과")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if agent.workerByDir("/home/build/repo-a") != "" {
+		t.Error("종료한 Worker는 실행 목록에서 제거되어야 함")
+	}
+}
@@ -0,0 +1,428 @@
+package remoteagent
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// Coordinator는 원격 에이전트를 등록받고 원격 Worker의 작업을 배정하는 중앙 서버입니다.
+type Coordinator struct {
+	token        string
+	pollTimeout  time.Duration
+	dispatch     func(event string, body []byte) error // Hook 이벤트 처리 (hookserver.Server.Dispatch)
+	resolveDir   func(workerID string) (string, bool)  // Worker ID → 코디네이터의 Worker SrcPath
+	onAgentError func(workerID, message string)        // 에이전트 실행 실패 처리
+	now          func() time.Time
+	logger       *log.Logger
+	httpServer   *http.Server
+
+	mu     sync.Mutex
+	agents map[string]*agentEntry // 에이전트 ID → 등록 정보
+	jobs   map[string]assignment  // Worker ID → 배정된 에이전트
+}
+
+// agentEntry는 등록된 에이전트 상태입니다.
+type agentEntry struct {
+	reg          Registration
+	lastSeen     time.Time
+	lastAssigned time.Time
+	running      map[string]bool // 에이전트가 마지막 poll에서 보고한 실행 중인 Worker ID
+	queue        []Job
+	notify       chan struct{}
+}
+
+// assignment는 Worker에 배정된 에이전트와 저장소입니다.
+type assignment struct {
+	agentID string
+	repo    string
+}
+
+// NewCoordinator는 새 Coordinator를 생성합니다. token은 에이전트 인증용 공유 토큰입니다.
+func NewCoordinator(token string) *Coordinator {
+	return &Coordinator{
+		token:       token,
+		pollTimeout: DefaultPollTimeout,
+		now:         time.Now,
+		agents:      make(map[string]*agentEntry),
+		jobs:        make(map[string]assignment),
+	}
+}
+
+// SetLogger는 로거를 설정합니다.
+func (c *Coordinator) SetLogger(logger *log.Logger) {
+	c.logger = logger
+}
+
+// SetHookDispatcher는 에이전트가 전달한 Hook 이벤트를 처리할 함수를 설정합니다.
+func (c *Coordinator) SetHookDispatcher(dispatch func(event string, body []byte) error) {
+	c.dispatch = dispatch
+}
+
+// SetWorkDirResolver는 Worker ID로 코디네이터의 작업 경로(SrcPath)를 찾는 함수를 설정합니다.
+// 전달된 Hook 이벤트의 cwd는 이 경로로 바뀌어 로컬 Hook과 같은 방식으로 Worker를 찾습니다.
+func (c *Coordinator) SetWorkDirResolver(resolve func(workerID string) (string, bool)) {
+	c.resolveDir = resolve
+}
+
+// SetErrorHandler는 에이전트가 작업 실행 실패를 보고했을 때 호출할 함수를 설정합니다.
+func (c *Coordinator) SetErrorHandler(handler func(workerID, message string)) {
+	c.onAgentError = handler
+}
+
+// Start는 에이전트 API 서버를 시작합니다.
+func (c *Coordinator) Start(ctx context.Context, port int) error {
+	addr := fmt.Sprintf(":%d", port)
+	c.httpServer = &http.Server{
+		Addr:         addr,
+		Handler:      c.Handler(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: c.pollTimeout + 10*time.Second, // long-poll 응답 대기 포함
+	}
+
+	c.logf("[Agent Coordinator] 시작: %s", addr)
+
+	// 컨텍스트 취소 시 서버 종료
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		c.httpServer.Shutdown(shutdownCtx)
+	}()
+
+	if err := c.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("에이전트 서버 시작 실패: %w", err)
+	}
+	return nil
+}
+
+// Handler는 에이전트 API HTTP 핸들러를 반환합니다.
+func (c *Coordinator) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathRegister, c.authenticated(c.handleRegister))
+	mux.HandleFunc(PathPoll, c.authenticated(c.handlePoll))
+	mux.HandleFunc(PathHook, c.authenticated(c.handleHook))
+	return mux
+}
+
+// authenticated는 공유 토큰과 에이전트 ID 헤더를 확인합니다.
+func (c *Coordinator) authenticated(next func(w http.ResponseWriter, r *http.Request, agentID string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if c.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(c.token)) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		agentID := r.Header.Get(HeaderAgentID)
+		if agentID == "" {
+			http.Error(w, "Missing agent ID", http.StatusBadRequest)
+			return
+		}
+		next(w, r, agentID)
+	}
+}
+
+// handleRegister는 에이전트 등록(재등록 포함)을 처리합니다.
+func (c *Coordinator) handleRegister(w http.ResponseWriter, r *http.Request, agentID string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var reg Registration
+	if err := json.NewDecoder(r.Body).Decode(&reg); err != nil {
+		http.Error(w, "Failed to parse registration", http.StatusBadRequest)
+		return
+	}
+	if reg.AgentID != agentID {
+		http.Error(w, "Agent ID mismatch", http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	entry, ok := c.agents[agentID]
+	if !ok {
+		entry = &agentEntry{notify: make(chan struct{}, 1)}
+		c.agents[agentID] = entry
+	}
+	entry.reg = reg
+	entry.lastSeen = c.now()
+	entry.running = toSet(reg.Running)
+	c.mu.Unlock()
+
+	c.logf("[Agent Coordinator] 에이전트 등록: %s (%s, 저장소: %v, 모델: %v, 슬롯: %d)",
+		agentID, reg.Hostname, reg.Repos, reg.Models, reg.slots())
+	w.WriteHeader(http.StatusOK)
+}
+
+// handlePoll은 에이전트의 작업 요청을 처리합니다.
+// 대기 중인 작업이 있으면 바로 반환하고, 없으면 pollTimeout까지 기다린 뒤 204를 반환합니다.
+// 등록되지 않은 에이전트는 404를 받고 다시 등록해야 합니다.
+func (c *Coordinator) handlePoll(w http.ResponseWriter, r *http.Request, agentID string) {
+	var running map[string]bool
+	if v := r.URL.Query().Get("running"); v != "" {
+		running = toSet(strings.Split(v, ","))
+	}
+
+	timer := time.NewTimer(c.pollTimeout)
+	defer timer.Stop()
+
+	for {
+		c.mu.Lock()
+		entry, ok := c.agents[agentID]
+		if !ok {
+			c.mu.Unlock()
+			http.Error(w, "Agent not registered", http.StatusNotFound)
+			return
+		}
+		entry.lastSeen = c.now()
+		entry.running = running
+		if len(entry.queue) > 0 {
+			job := entry.queue[0]
+			entry.queue = entry.queue[1:]
+			if job.Kind == JobRun {
+				// 다음 poll에서 에이전트가 보고하기 전까지 실행 중으로 간주
+				if entry.running == nil {
+					entry.running = make(map[string]bool)
+				}
+				entry.running[job.WorkerID] = true
+			}
+			c.mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(job)
+			return
+		}
+		notify := entry.notify
+		c.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// handleHook은 에이전트가 전달한 Hook 이벤트를 처리합니다.
+// 해당 Worker가 요청한 에이전트에 배정되어 있을 때만 처리합니다.
+func (c *Coordinator) handleHook(w http.ResponseWriter, r *http.Request, agentID string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+	var event HookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "Failed to parse event", http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	assigned, ok := c.jobs[event.WorkerID]
+	if entry := c.agents[agentID]; entry != nil {
+		entry.lastSeen = c.now()
+	}
+	c.mu.Unlock()
+	if !ok || assigned.agentID != agentID {
+		c.logf("[Agent Coordinator] 배정되지 않은 Worker의 이벤트 무시: %s → %s (%s)", agentID, event.WorkerID, event.Event)
+		http.Error(w, "Worker not assigned to agent", http.StatusConflict)
+		return
+	}
+
+	if event.Event == EventAgentError {
+		var payload AgentErrorPayload
+		json.Unmarshal(event.Payload, &payload)
+		c.logf("[Agent Coordinator] 에이전트 실행 실패: %s → %s: %s", agentID, event.WorkerID, payload.Message)
+
+		c.mu.Lock()
+		delete(c.jobs, event.WorkerID)
+		c.mu.Unlock()
+		if c.onAgentError != nil {
+			c.onAgentError(event.WorkerID, payload.Message)
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	payload, err := c.rewriteCwd(event.WorkerID, event.Payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if c.dispatch == nil {
+		http.Error(w, "Hook dispatcher not configured", http.StatusServiceUnavailable)
+		return
+	}
+	if err := c.dispatch(event.Event, payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// rewriteCwd는 Hook 페이로드의 cwd를 코디네이터의 Worker 작업 경로로 바꿉니다.
+func (c *Coordinator) rewriteCwd(workerID string, raw json.RawMessage) ([]byte, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("Hook 페이로드 파싱 실패: %w", err)
+	}
+	if c.resolveDir != nil {
+		if dir, ok := c.resolveDir(workerID); ok {
+			payload["cwd"] = dir
+		}
+	}
+	return json.Marshal(payload)
+}
+
+// CheckAvailable은 저장소와 모델을 실행할 수 있는 온라인 에이전트에 빈 슬롯이 있는지 확인합니다.
+func (c *Coordinator) CheckAvailable(repo string, model aimodel.AIModelType) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pickLocked(repo, model) == "" {
+		return fmt.Errorf("%w (저장소: %s, 모델: %s)", aiworker.ErrAgentUnavailable, repo, model)
+	}
+	return nil
+}
+
+// Assign은 작업을 실행할 에이전트를 골라 대기열에 넣고 에이전트 ID를 반환합니다.
+// 빈 슬롯이 가장 많은 에이전트를, 같으면 가장 오래전에 배정받은 에이전트를 고릅니다.
+func (c *Coordinator) Assign(job Job) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.jobs, job.WorkerID) // 같은 Worker의 이전 배정은 새 배정으로 대체
+	agentID := c.pickLocked(job.Repo, job.Model)
+	if agentID == "" {
+		return "", fmt.Errorf("%w (저장소: %s, 모델: %s)", aiworker.ErrAgentUnavailable, job.Repo, job.Model)
+	}
+
+	entry := c.agents[agentID]
+	entry.lastAssigned = c.now()
+	c.jobs[job.WorkerID] = assignment{agentID: agentID, repo: job.Repo}
+	c.enqueueLocked(entry, job)
+
+	c.logf("[Agent Coordinator] 작업 배정: %s → %s (저장소: %s)", job.WorkerID, agentID, job.Repo)
+	return agentID, nil
+}
+
+// Terminate는 Worker에 배정된 에이전트에 종료 작업을 보내고 배정을 해제합니다.
+func (c *Coordinator) Terminate(workerID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	assigned, ok := c.jobs[workerID]
+	if !ok {
+		return nil
+	}
+	delete(c.jobs, workerID)
+
+	entry, ok := c.agents[assigned.agentID]
+	if !ok {
+		return fmt.Errorf("에이전트를 찾을 수 없음: %s", assigned.agentID)
+	}
+	c.enqueueLocked(entry, Job{Kind: JobTerminate, WorkerID: workerID})
+	return nil
+}
+
+// IsSessionAlive는 Worker에 배정된 에이전트가 온라인이고 작업을 실행 중(또는 전달 대기 중)인지 확인합니다.
+func (c *Coordinator) IsSessionAlive(workerID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	assigned, ok := c.jobs[workerID]
+	if !ok {
+		return false
+	}
+	entry, ok := c.agents[assigned.agentID]
+	if !ok || !c.onlineLocked(entry) {
+		return false
+	}
+	if entry.running[workerID] {
+		return true
+	}
+	for _, job := range entry.queue {
+		if job.Kind == JobRun && job.WorkerID == workerID {
+			return true
+		}
+	}
+	return false
+}
+
+// pickLocked는 작업을 배정할 에이전트 ID를 고릅니다. 없으면 빈 문자열입니다.
+// 한 에이전트에서 같은 저장소는 동시에 하나만 실행합니다 (cwd로 Worker를 구분하기 때문).
+func (c *Coordinator) pickLocked(repo string, model aimodel.AIModelType) string {
+	load := make(map[string]int)
+	busyRepo := make(map[string]bool)
+	for _, assigned := range c.jobs {
+		load[assigned.agentID]++
+		busyRepo[assigned.agentID+"/"+assigned.repo] = true
+	}
+
+	best := ""
+	bestFree := 0
+	for id, entry := range c.agents {
+		if !c.onlineLocked(entry) || !entry.reg.supports(repo, model) || busyRepo[id+"/"+repo] {
+			continue
+		}
+		free := entry.reg.slots() - load[id]
+		if free <= 0 {
+			continue
+		}
+		if best == "" || free > bestFree ||
+			(free == bestFree && entry.lastAssigned.Before(c.agents[best].lastAssigned)) {
+			best, bestFree = id, free
+		}
+	}
+	return best
+}
+
+// onlineLocked는 에이전트가 AgentTimeout 안에 poll했는지 확인합니다.
+func (c *Coordinator) onlineLocked(entry *agentEntry) bool {
+	return c.now().Sub(entry.lastSeen) <= AgentTimeout
+}
+
+// enqueueLocked는 에이전트 대기열에 작업을 넣고 대기 중인 poll을 깨웁니다.
+func (c *Coordinator) enqueueLocked(entry *agentEntry, job Job) {
+	entry.queue = append(entry.queue, job)
+	select {
+	case entry.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *Coordinator) logf(format string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, args...)
+	}
+}
+
+// toSet은 문자열 목록을 집합으로 변환합니다. 빈 문자열은 제외합니다.
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
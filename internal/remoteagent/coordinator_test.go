@@ -0,0 +1,211 @@
+package remoteagent
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+const testToken = "secret"
+
+// request는 인증 헤더를 붙여 코디네이터 핸들러를 호출합니다.
+func request(c *Coordinator, method, path, agentID string, body interface{}) *httptest.ResponseRecorder {
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set(HeaderAgentID, agentID)
+	w := httptest.NewRecorder()
+	c.Handler().ServeHTTP(w, req)
+	return w
+}
+
+// newTestCoordinator는 linux-1(repo-a, claude)과 linux-2(repo-a/repo-b, 모든 모델, 2슬롯)가 등록된 코디네이터를 생성합니다.
+func newTestCoordinator(t *testing.T) *Coordinator {
+	t.Helper()
+	c := NewCoordinator(testToken)
+	c.pollTimeout = 50 * time.Millisecond
+
+	for _, reg := range []Registration{
+		{AgentID: "linux-1", Repos: []string{"repo-a"}, Models: []aimodel.AIModelType{aimodel.AIModelClaude}},
+		{AgentID: "linux-2", Repos: []string{"repo-a", "repo-b"}, Slots: 2},
+	} {
+		if w := request(c, http.MethodPost, PathRegister, reg.AgentID, reg); w.Code != http.StatusOK {
+			t.Fatalf("에이전트 등록 실패: %s (%d)", reg.AgentID, w.Code)
+		}
+	}
+	return c
+}
+
+// TestCoordinator_Auth는 토큰과 에이전트 ID 검증을 테스트합니다.
+func TestCoordinator_Auth(t *testing.T) {
+	c := NewCoordinator(testToken)
+
+	req := httptest.NewRequest(http.MethodGet, PathPoll, nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	req.Header.Set(HeaderAgentID, "linux-1")
+	w := httptest.NewRecorder()
+	c.Handler().ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("잘못된 토큰은 401이어야 함: %d", w.Code)
+	}
+
+	if w := request(c, http.MethodGet, PathPoll, "", nil); w.Code != http.StatusBadRequest {
+		t.Errorf("에이전트 ID가 없으면 400이어야 함: %d", w.Code)
+	}
+	if w := request(c, http.MethodGet, PathPoll, "unknown", nil); w.Code != http.StatusNotFound {
+		t.Errorf("등록되지 않은 에이전트는 404여야 함: %d", w.Code)
+	}
+	if w := request(c, http.MethodPost, PathRegister, "linux-1", Registration{AgentID: "linux-2"}); w.Code != http.StatusBadRequest {
+		t.Errorf("헤더와 등록 정보의 에이전트 ID가 다르면 400이어야 함: %d", w.Code)
+	}
+}
+
+// TestCoordinator_Assign은 저장소/모델/슬롯에 따른 에이전트 선택을 테스트합니다.
+func TestCoordinator_Assign(t *testing.T) {
+	c := newTestCoordinator(t)
+
+	if err := c.CheckAvailable("repo-c", aimodel.AIModelClaude); !errors.Is(err, aiworker.ErrAgentUnavailable) {
+		t.Errorf("저장소를 가진 에이전트가 없으면 ErrAgentUnavailable이어야 함: %v", err)
+	}
+
+	// repo-b는 linux-2만 가능
+	agentID, err := c.Assign(Job{Kind: JobRun, WorkerID: "AI_01", Repo: "repo-b", Model: aimodel.AIModelClaude})
+	if err != nil || agentID != "linux-2" {
+		t.Fatalf("배정 불일치: %s, %v", agentID, err)
+	}
+
+	// opencode는 linux-1이 지원하지 않으므로 linux-2의 남은 슬롯에 배정
+	agentID, err = c.Assign(Job{Kind: JobRun, WorkerID: "AI_02", Repo: "repo-a", Model: aimodel.AIModelOpenCode})
+	if err != nil || agentID != "linux-2" {
+		t.Fatalf("모델 지원 에이전트에 배정되어야 함: %s, %v", agentID, err)
+	}
+
+	// linux-2는 슬롯이 모두 찼으므로 opencode는 더 이상 불가, claude는 linux-1로
+	if err := c.CheckAvailable("repo-a", aimodel.AIModelOpenCode); !errors.Is(err, aiworker.ErrAgentUnavailable) {
+		t.Errorf("빈 슬롯이 없으면 ErrAgentUnavailable이어야 함: %v", err)
+	}
+	// Invoker 확인은 Worker 기본 모델이 아니라 태스크 실행 모델 기준
+	invoker := NewRemoteInvoker(c, "repo-a", aimodel.AIModelClaude)
+	config := aiworker.WorkerConfig{ID: "AI_03", AIModelType: aimodel.AIModelClaude}
+	if err := invoker.CheckAvailable(config, aimodel.AIModelOpenCode); !errors.Is(err, aiworker.ErrAgentUnavailable) {
+		t.Errorf("오버라이드 모델 기준으로 확인해야 함: %v", err)
+	}
+	if err := invoker.CheckAvailable(config, ""); err != nil {
+		t.Errorf("모델이 없으면 Worker 기본 모델로 확인해야 함: %v", err)
+	}
+	agentID, err = c.Assign(Job{Kind: JobRun, WorkerID: "AI_03", Repo: "repo-a", Model: aimodel.AIModelClaude})
+	if err != nil || agentID != "linux-1" {
+		t.Fatalf("빈 슬롯이 있는 에이전트에 배정되어야 함: %s, %v", agentID, err)
+	}
+
+	// 종료하면 슬롯 반환
+	if err := c.Terminate("AI_02"); err != nil {
+		t.Fatalf("종료 실패: %v", err)
+	}
+	if err := c.CheckAvailable("repo-a", aimodel.AIModelOpenCode); err != nil {
+		t.Errorf("종료 후 슬롯이 반환되어야 함: %v", err)
+	}
+}
+
+// TestCoordinator_Poll은 작업 전달, 세션 확인, 오프라인 처리를 테스트합니다.
+func TestCoordinator_Poll(t *testing.T) {
+	c := newTestCoordinator(t)
+
+	if w := request(c, http.MethodGet, PathPoll, "linux-1", nil); w.Code != http.StatusNoContent {
+		t.Fatalf("작업이 없으면 204여야 함: %d", w.Code)
+	}
+
+	agentID, err := c.Assign(Job{Kind: JobRun, WorkerID: "AI_01", Repo: "repo-a", Model: aimodel.AIModelClaude, Prompt: "작업"})
+	if err != nil {
+		t.Fatalf("배정 실패: %v", err)
+	}
+	if !c.IsSessionAlive("AI_01") {
+		t.Error("전달 대기 중인 작업은 실행 중으로 간주해야 함")
+	}
+
+	w := request(c, http.MethodGet, PathPoll, agentID, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("작업 전달 실패: %d", w.Code)
+	}
+	var job Job
+	json.Unmarshal(w.Body.Bytes(), &job)
+	if job.Kind != JobRun || job.WorkerID != "AI_01" || job.Prompt != "작업" {
+		t.Errorf("작업 불일치: %+v", job)
+	}
+	if !c.IsSessionAlive("AI_01") {
+		t.Error("전달한 작업은 다음 poll 전까지 실행 중으로 간주해야 함")
+	}
+
+	// 에이전트가 실행 중 목록에서 빼면 세션 종료
+	request(c, http.MethodGet, PathPoll, agentID, nil)
+	if c.IsSessionAlive("AI_01") {
+		t.Error("에이전트가 보고하지 않은 작업은 종료된 것으로 간주해야 함")
+	}
+
+	// 오프라인 에이전트에는 배정하지 않음
+	request(c, http.MethodGet, PathPoll+"?running=AI_01", agentID, nil)
+	c.now = func() time.Time { return time.Now().Add(AgentTimeout + time.Second) }
+	if c.IsSessionAlive("AI_01") {
+		t.Error("오프라인 에이전트의 세션은 종료된 것으로 간주해야 함")
+	}
+	if err := c.CheckAvailable("repo-a", aimodel.AIModelClaude); !errors.Is(err, aiworker.ErrAgentUnavailable) {
+		t.Errorf("오프라인 에이전트에는 배정하지 않아야 함: %v", err)
+	}
+}
+
+// TestCoordinator_Hook은 Hook 전달 시 배정 확인, cwd 변환, 실행 실패 처리를 테스트합니다.
+func TestCoordinator_Hook(t *testing.T) {
+	c := newTestCoordinator(t)
+	c.SetWorkDirResolver(func(workerID string) (string, bool) {
+		return "agent://repo-b/" + workerID, true
+	})
+	var gotEvent string
+	var gotPayload map[string]interface{}
+	c.SetHookDispatcher(func(event string, body []byte) error {
+		gotEvent = event
+		return json.Unmarshal(body, &gotPayload)
+	})
+	var failed, failMessage string
+	c.SetErrorHandler(func(workerID, message string) {
+		failed, failMessage = workerID, message
+	})
+
+	if _, err := c.Assign(Job{Kind: JobRun, WorkerID: "AI_01", Repo: "repo-b"}); err != nil {
+		t.Fatalf("배정 실패: %v", err)
+	}
+
+	event := HookEvent{WorkerID: "AI_01", Event: "plan-ready", Payload: json.RawMessage(`{"cwd":"/home/build/repo-b","plan_title":"계획"}`)}
+	if w := request(c, http.MethodPost, PathHook, "linux-1", event); w.Code != http.StatusConflict {
+		t.Errorf("배정되지 않은 에이전트의 Hook은 409여야 함: %d", w.Code)
+	}
+	if w := request(c, http.MethodPost, PathHook, "linux-2", event); w.Code != http.StatusOK {
+		t.Fatalf("Hook 전달 실패: %d", w.Code)
+	}
+	if gotEvent != "plan-ready" || gotPayload["cwd"] != "agent://repo-b/AI_01" || gotPayload["plan_title"] != "계획" {
+		t.Errorf("Hook 전달 불일치: %s %v", gotEvent, gotPayload)
+	}
+
+	errEvent := HookEvent{WorkerID: "AI_01", Event: EventAgentError, Payload: json.RawMessage(`{"message":"tmux 없음"}`)}
+	if w := request(c, http.MethodPost, PathHook, "linux-2", errEvent); w.Code != http.StatusOK {
+		t.Fatalf("실행 실패 전달 실패: %d", w.Code)
+	}
+	if failed != "AI_01" || failMessage != "tmux 없음" {
+		t.Errorf("실행 실패 처리 불일치: %s %s", failed, failMessage)
+	}
+	if c.IsSessionAlive("AI_01") {
+		t.Error("실행 실패한 작업은 배정이 해제되어야 함")
+	}
+}
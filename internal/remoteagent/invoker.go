@@ -0,0 +1,79 @@
+package remoteagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// RemoteInvoker는 원격 에이전트에 작업을 배정하는 코디네이터 측 Invoker입니다.
+// Worker마다 하나씩 생성하며, 프롬프트 가공(TDD 문구, Hook 지시)은 실행하는 에이전트가 담당합니다.
+type RemoteInvoker struct {
+	coordinator *Coordinator
+	repo        string
+	model       aimodel.AIModelType // Worker 기본 모델
+}
+
+// NewRemoteInvoker는 repo 저장소를 가진 에이전트에서 실행하는 RemoteInvoker를 생성합니다.
+func NewRemoteInvoker(coordinator *Coordinator, repo string, model aimodel.AIModelType) *RemoteInvoker {
+	return &RemoteInvoker{
+		coordinator: coordinator,
+		repo:        repo,
+		model:       model,
+	}
+}
+
+// InvokePlan은 Worker 기본 모델의 plan 모드로 원격 실행합니다.
+func (i *RemoteInvoker) InvokePlan(ctx context.Context, workDir, prompt, workerID string) (*aiworker.InvokeResult, error) {
+	return i.Invoke(ctx, workDir, prompt, workerID, aiworker.RunOptions{})
+}
+
+// Invoke는 opts의 모델/모드로 실행할 작업을 에이전트에 배정합니다.
+func (i *RemoteInvoker) Invoke(ctx context.Context, workDir, prompt, workerID string, opts aiworker.RunOptions) (*aiworker.InvokeResult, error) {
+	model := opts.Model
+	if model == "" {
+		model = i.model
+	}
+
+	agentID, err := i.coordinator.Assign(Job{
+		Kind:     JobRun,
+		WorkerID: workerID,
+		Repo:     i.repo,
+		Prompt:   prompt,
+		Model:    model,
+		Mode:     opts.Mode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("원격 에이전트 배정 실패: %w", err)
+	}
+
+	return &aiworker.InvokeResult{
+		WorkDir:   fmt.Sprintf("%s:%s", agentID, i.repo),
+		Prompt:    prompt,
+		StartedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// Terminate는 에이전트에 실행 중인 AI 도구 종료를 요청합니다.
+func (i *RemoteInvoker) Terminate(workerID string) error {
+	return i.coordinator.Terminate(workerID)
+}
+
+// IsSessionAlive는 배정된 에이전트에서 작업이 실행 중인지 확인합니다.
+func (i *RemoteInvoker) IsSessionAlive(workerID string) (bool, error) {
+	return i.coordinator.IsSessionAlive(workerID), nil
+}
+
+// CheckAvailable은 Worker 저장소와 실행 모델(비어있으면 Worker 기본 모델)을 실행할 수 있는 에이전트가 있는지 확인합니다.
+func (i *RemoteInvoker) CheckAvailable(config aiworker.WorkerConfig, model aimodel.AIModelType) error {
+	if model == "" {
+		model = config.AIModelType
+	}
+	if model == "" {
+		model = i.model
+	}
+	return i.coordinator.CheckAvailable(i.repo, model)
+}
@@ -0,0 +1,105 @@
+// Package remoteagent는 중앙 ai-worker(코디네이터)와 다른 머신의 에이전트 프로세스 사이의 작업 배정을 담당합니다.
+// 에이전트는 HTTP로 실행 가능한 저장소/모델을 등록하고 작업을 long-poll로 받아 로컬에서 실행하며,
+// 로컬 Hook 이벤트를 인증된 요청으로 코디네이터에 전달합니다.
+package remoteagent
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+const (
+	// HeaderAgentID는 요청한 에이전트 ID 헤더입니다. (인증은 Authorization: Bearer <토큰>)
+	HeaderAgentID = "X-Agent-ID"
+
+	// DefaultPollTimeout은 작업이 없을 때 poll 요청을 붙잡아 두는 최대 시간입니다.
+	DefaultPollTimeout = 30 * time.Second
+
+	// AgentTimeout은 마지막 poll 이후 에이전트를 오프라인으로 보는 시간입니다.
+	AgentTimeout = 2 * DefaultPollTimeout
+
+	// EventAgentError는 에이전트가 배정된 작업을 실행하지 못했을 때 보내는 이벤트입니다.
+	EventAgentError = "agent-error"
+)
+
+// 코디네이터 API 경로
+const (
+	PathRegister = "/agent/register"
+	PathPoll     = "/agent/poll"
+	PathHook     = "/agent/hook"
+)
+
+// Registration은 에이전트 등록 요청입니다.
+type Registration struct {
+	AgentID  string                `json:"agent_id"`
+	Hostname string                `json:"hostname"`
+	Repos    []string              `json:"repos"`             // 실행 가능한 저장소 이름
+	Models   []aimodel.AIModelType `json:"models,omitempty"`  // 실행 가능한 AI 모델 (비어있으면 모두)
+	Slots    int                   `json:"slots,omitempty"`   // 동시 실행 수 (기본: 1)
+	Running  []string              `json:"running,omitempty"` // 재등록 시 실행 중인 Worker ID
+}
+
+// supports는 에이전트가 저장소와 모델을 실행할 수 있는지 확인합니다.
+func (r Registration) supports(repo string, model aimodel.AIModelType) bool {
+	hasRepo := false
+	for _, name := range r.Repos {
+		if name == repo {
+			hasRepo = true
+			break
+		}
+	}
+	if !hasRepo {
+		return false
+	}
+	if len(r.Models) == 0 || model == "" {
+		return true
+	}
+	for _, m := range r.Models {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+// slots는 동시 실행 수를 반환합니다.
+func (r Registration) slots() int {
+	if r.Slots <= 0 {
+		return 1
+	}
+	return r.Slots
+}
+
+// JobKind는 에이전트에 전달하는 작업 종류입니다.
+type JobKind string
+
+const (
+	JobRun       JobKind = "run"       // AI 도구 실행
+	JobTerminate JobKind = "terminate" // 실행 중인 AI 도구 종료
+)
+
+// Job은 poll 응답으로 에이전트에 전달하는 작업입니다.
+type Job struct {
+	Kind     JobKind             `json:"kind"`
+	WorkerID string              `json:"worker_id"`
+	Repo     string              `json:"repo,omitempty"`
+	Prompt   string              `json:"prompt,omitempty"`
+	Model    aimodel.AIModelType `json:"model,omitempty"`
+	Mode     aiworker.AgentMode  `json:"mode,omitempty"`
+}
+
+// HookEvent는 에이전트가 코디네이터로 전달하는 Hook 이벤트입니다.
+// Event는 hookserver 이벤트 이름(stop, plan-ready 등) 또는 EventAgentError입니다.
+type HookEvent struct {
+	WorkerID string          `json:"worker_id"`
+	Event    string          `json:"event"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// AgentErrorPayload는 EventAgentError 페이로드입니다.
+type AgentErrorPayload struct {
+	Message string `json:"message"`
+}
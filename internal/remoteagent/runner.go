@@ -0,0 +1,137 @@
+package remoteagent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// Runner는 에이전트 머신에서 AI 도구를 실행/종료하는 인터페이스입니다.
+type Runner interface {
+	aiworker.OptionInvoker
+	aiworker.AgentTerminator
+}
+
+// TmuxRunner는 tmux 세션에서 AI 도구를 실행하는 Runner입니다. (터미널 앱이 없는 Linux 빌드 머신용)
+// 세션 이름은 Worker ID입니다.
+type TmuxRunner struct {
+	hookServerPort int
+	run            func(name string, args ...string) error // 테스트에서 교체
+}
+
+// NewTmuxRunner는 새 TmuxRunner를 생성합니다. hookServerPort는 에이전트의 로컬 Hook 포트입니다.
+func NewTmuxRunner(hookServerPort int) *TmuxRunner {
+	return &TmuxRunner{
+		hookServerPort: hookServerPort,
+		run: func(name string, args ...string) error {
+			return exec.Command(name, args...).Run()
+		},
+	}
+}
+
+// Invoke는 tmux 세션을 만들어 opts의 모델/모드로 AI 도구를 실행합니다.
+func (r *TmuxRunner) Invoke(ctx context.Context, workDir, prompt, workerID string, opts aiworker.RunOptions) (*aiworker.InvokeResult, error) {
+	model := opts.Model
+	if model == "" {
+		model = aimodel.AIModelClaude
+	}
+
+	// TDD 문구와 Hook 지시는 로컬 Invoker와 같은 방식으로 추가
+	fullPrompt := aiworker.NewDefaultInvokerWithModel(r.hookServerPort, aiworker.TerminalTypeDefault, model).
+		WithOptions(opts).AddTDDSuffix(prompt)
+
+	// 프롬프트를 임시 파일에 저장 (이스케이프 문제 회피)
+	tmpFile, err := os.CreateTemp("", "claude_prompt_*.txt")
+	if err != nil {
+		return nil, fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+
+	if _, err := tmpFile.WriteString(fullPrompt); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpPath)
+		return nil, fmt.Errorf("프롬프트 저장 실패: %w", err)
+	}
+	tmpFile.Close()
+
+	command := BuildTmuxCommand(model, opts.Mode, tmpPath)
+	if err := r.run("tmux", "new-session", "-d", "-s", workerID, "-c", workDir, command); err != nil {
+		os.Remove(tmpPath)
+		return nil, fmt.Errorf("tmux 세션 시작 실패: %w", err)
+	}
+
+	return &aiworker.InvokeResult{
+		WorkDir:   workDir,
+		Prompt:    fullPrompt,
+		StartedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// Terminate는 Worker의 tmux 세션을 종료합니다. 세션이 없으면 무시합니다.
+func (r *TmuxRunner) Terminate(workerID string) error {
+	if alive, _ := r.IsSessionAlive(workerID); !alive {
+		return nil
+	}
+	if err := r.run("tmux", "kill-session", "-t", workerID); err != nil {
+		return fmt.Errorf("tmux 세션 종료 실패: %w", err)
+	}
+	return nil
+}
+
+// IsSessionAlive는 Worker의 tmux 세션이 있는지 확인합니다.
+func (r *TmuxRunner) IsSessionAlive(workerID string) (bool, error) {
+	return r.run("tmux", "has-session", "-t", workerID) == nil, nil
+}
+
+// BuildTmuxCommand는 프롬프트 파일을 읽어 AI 도구를 실행하는 쉘 명령을 생성합니다.
+// 터미널 스크립트와 같은 명령을 사용하며, 프롬프트 파일은 실행 후 삭제합니다.
+func BuildTmuxCommand(model aimodel.AIModelType, mode aiworker.AgentMode, promptFilePath string) string {
+	path := "'" + strings.ReplaceAll(promptFilePath, "'", "'\\''") + "'"
+	switch model {
+	case aimodel.AIModelOpenCode:
+		return fmt.Sprintf(`opencode --prompt "$(cat %s)" && rm -f %s`, path, path)
+	case aimodel.AIModelAmpcode:
+		return fmt.Sprintf("cat %s | amp && rm -f %s", path, path)
+	default:
+		return fmt.Sprintf("cat %s | claude --permission-mode %s && rm -f %s", path, mode.PermissionMode(), path)
+	}
+}
+
+// TerminalRunner는 macOS 터미널 창에서 AI 도구를 실행하는 Runner입니다. (로컬 Worker와 같은 방식)
+type TerminalRunner struct {
+	invoker      *aiworker.DefaultInvoker
+	terminalType aiworker.TerminalType
+}
+
+// NewTerminalRunner는 새 TerminalRunner를 생성합니다. hookServerPort는 에이전트의 로컬 Hook 포트입니다.
+func NewTerminalRunner(hookServerPort int, terminalType aiworker.TerminalType) *TerminalRunner {
+	return &TerminalRunner{
+		invoker:      aiworker.NewDefaultInvokerWithConfig(hookServerPort, terminalType),
+		terminalType: terminalType,
+	}
+}
+
+// Invoke는 터미널 창을 열어 opts의 모델/모드로 AI 도구를 실행합니다.
+func (r *TerminalRunner) Invoke(ctx context.Context, workDir, prompt, workerID string, opts aiworker.RunOptions) (*aiworker.InvokeResult, error) {
+	return r.invoker.Invoke(ctx, workDir, prompt, workerID, opts)
+}
+
+// Terminate는 Worker ID로 터미널 창을 찾아 종료합니다.
+func (r *TerminalRunner) Terminate(workerID string) error {
+	return aiworker.GetTerminalHandler(r.terminalType).Terminate(workerID)
+}
+
+// IsSessionAlive는 Worker의 터미널 창이 살아있는지 확인합니다.
+func (r *TerminalRunner) IsSessionAlive(workerID string) (bool, error) {
+	checker, ok := aiworker.GetTerminalHandler(r.terminalType).(aiworker.SessionChecker)
+	if !ok {
+		return false, fmt.Errorf("%s 터미널은 세션 확인을 지원하지 않음", r.terminalType)
+	}
+	return checker.IsSessionAlive(workerID)
+}
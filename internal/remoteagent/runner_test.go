@@ -0,0 +1,80 @@
+package remoteagent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/aiworker"
+	"github.com/zime/slickwebhook/internal/aiworker/aimodel"
+)
+
+// TestBuildTmuxCommand는 모델/모드별 tmux 실행 명령을 테스트합니다.
+func TestBuildTmuxCommand(t *testing.T) {
+	tests := []struct {
+		model aimodel.AIModelType
+		mode  aiworker.AgentMode
+		want  string
+	}{
+		{aimodel.AIModelClaude, aiworker.ModePlan, "cat '/tmp/p.txt' | claude --permission-mode plan && rm -f '/tmp/p.txt'"},
+		{aimodel.AIModelClaude, aiworker.ModeDirect, "cat '/tmp/p.txt' | claude --permission-mode acceptEdits && rm -f '/tmp/p.txt'"},
+		{aimodel.AIModelOpenCode, "", `opencode --prompt "$(cat '/tmp/p.txt')" && rm -f '/tmp/p.txt'`},
+		{aimodel.AIModelAmpcode, "", "cat '/tmp/p.txt' | amp && rm -f '/tmp/p.txt'"},
+	}
+
+	for _, tt := range tests {
+		if got := BuildTmuxCommand(tt.model, tt.mode, "/tmp/p.txt"); got != tt.want {
+			t.Errorf("%s/%s 명령 불일치:\n got %s\nwant %s", tt.model, tt.mode, got, tt.want)
+		}
+	}
+}
+
+// TestTmuxRunner는 tmux 세션 시작/확인/종료 명령을 테스트합니다.
+func TestTmuxRunner(t *testing.T) {
+	var calls [][]string
+	sessions := map[string]bool{}
+	runner := NewTmuxRunner(9090)
+	runner.run = func(name string, args ...string) error {
+		calls = append(calls, args)
+		switch args[0] {
+		case "new-session":
+			sessions[args[3]] = true
+		case "has-session":
+			if !sessions[args[2]] {
+				return errors.New("no session")
+			}
+		}
+		return nil
+	}
+
+	result, err := runner.Invoke(context.Background(), "/home/build/repo-a", "버그 수정", "AI_01", aiworker.RunOptions{})
+	if err != nil {
+		t.Fatalf("실행 실패: %v", err)
+	}
+	start := calls[0]
+	if strings.Join(start[:6], " ") != "new-session -d -s AI_01 -c /home/build/repo-a" {
+		t.Errorf("tmux 세션 인자 불일치: %v", start)
+	}
+	if !strings.Contains(start[6], "claude --permission-mode plan") {
+		t.Errorf("기본 모델은 claude plan 모드여야 함: %s", start[6])
+	}
+	if !strings.Contains(result.Prompt, "TDD") || !strings.Contains(result.Prompt, "localhost:9090/hook/task-complete") {
+		t.Error("프롬프트에 TDD 문구와 로컬 Hook 지시가 포함되어야 함")
+	}
+
+	// 프롬프트 파일은 세션 명령이 삭제하므로 테스트에서 정리
+	promptFile := strings.TrimSuffix(strings.SplitN(start[6], "'", 3)[1], "'")
+	os.Remove(promptFile)
+
+	if alive, _ := runner.IsSessionAlive("AI_01"); !alive {
+		t.Error("시작한 세션은 살아있어야 함")
+	}
+	if err := runner.Terminate("AI_02"); err != nil || calls[len(calls)-1][0] == "kill-session" {
+		t.Error("없는 세션은 종료하지 않아야 함")
+	}
+	if err := runner.Terminate("AI_01"); err != nil || calls[len(calls)-1][0] != "kill-session" {
+		t.Errorf("세션 종료 명령 불일치: %v", calls[len(calls)-1])
+	}
+}
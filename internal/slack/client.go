@@ -94,6 +94,32 @@ func parseSlackTimestamp(ts string) time.Time {
 // PostMessage는 채널에 Block Kit 형식의 메시지를 전송합니다.
 // text는 Block을 지원하지 않는 클라이언트를 위한 폴백 텍스트입니다.
 func (c *SlackClient) PostMessage(ctx context.Context, channelID string, blocks []slack.Block, text string) error {
+	_, _, err := c.api.PostMessageContext(ctx, channelID, buildMsgOptions(blocks, text)...)
+	return err
+}
+
+// PostMessageWithTS는 메시지를 전송하고 메시지 타임스탬프를 반환합니다.
+// 반환된 타임스탬프는 UpdateMessage로 메시지를 갱신할 때 사용합니다.
+func (c *SlackClient) PostMessageWithTS(ctx context.Context, channelID string, blocks []slack.Block, text string) (string, error) {
+	_, ts, err := c.api.PostMessageContext(ctx, channelID, buildMsgOptions(blocks, text)...)
+	return ts, err
+}
+
+// PostThreadReply는 스레드(threadTS)에 답글을 전송하고 답글의 타임스탬프를 반환합니다.
+func (c *SlackClient) PostThreadReply(ctx context.Context, channelID, threadTS string, blocks []slack.Block, text string) (string, error) {
+	options := append(buildMsgOptions(blocks, text), slack.MsgOptionTS(threadTS))
+	_, ts, err := c.api.PostMessageContext(ctx, channelID, options...)
+	return ts, err
+}
+
+// UpdateMessage는 기존 메시지를 chat.update로 갱신합니다.
+func (c *SlackClient) UpdateMessage(ctx context.Context, channelID, timestamp string, blocks []slack.Block, text string) error {
+	_, _, _, err := c.api.UpdateMessageContext(ctx, channelID, timestamp, buildMsgOptions(blocks, text)...)
+	return err
+}
+
+// buildMsgOptions는 텍스트와 Block으로 메시지 옵션을 구성합니다.
+func buildMsgOptions(blocks []slack.Block, text string) []slack.MsgOption {
 	options := []slack.MsgOption{
 		slack.MsgOptionText(text, false),
 	}
@@ -102,8 +128,7 @@ func (c *SlackClient) PostMessage(ctx context.Context, channelID string, blocks
 		options = append(options, slack.MsgOptionBlocks(blocks...))
 	}
 
-	_, _, err := c.api.PostMessageContext(ctx, channelID, options...)
-	return err
+	return options
 }
 
 // UploadFile은 채널에 파일을 업로드합니다.
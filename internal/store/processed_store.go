@@ -17,6 +17,8 @@ type ProcessedStore interface {
 	MarkProcessed(messageID string, subject string) error
 	// GetCount는 저장된 레코드 수를 반환합니다.
 	GetCount() (int, error)
+	// CountSince는 since 이후 처리된 레코드 수를 반환합니다.
+	CountSince(since time.Time) (int, error)
 	// Cleanup은 오래된 레코드를 정리합니다 (retentionDays일 이전).
 	Cleanup(retentionDays int) (int, error)
 	// Close는 DB 연결을 닫습니다.
@@ -104,6 +106,20 @@ func (s *SQLiteProcessedStore) GetCount() (int, error) {
 	return count, nil
 }
 
+// CountSince는 since 이후 처리된 레코드 수를 반환합니다.
+func (s *SQLiteProcessedStore) CountSince(since time.Time) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM processed_emails WHERE processed_at >= ?", since.In(time.Local)).Scan(&count) // processed_at은 로컬 시각으로 저장됨
+	if err != nil {
+		return 0, fmt.Errorf("카운트 조회 실패: %w", err)
+	}
+
+	return count, nil
+}
+
 // Cleanup은 오래된 레코드를 정리합니다.
 func (s *SQLiteProcessedStore) Cleanup(retentionDays int) (int, error) {
 	s.mu.Lock()
@@ -0,0 +1,315 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TaskRun은 AI Worker의 태스크 실행 한 건의 기록입니다.
+type TaskRun struct {
+	ID           int64
+	TaskID       string
+	JiraID       string
+	ListID       string
+	WorkerID     string
+	Model        string // AI 모델 (claude, opencode, ampcode)
+	Terminal     string // 터미널 종류
+	StartedAt    time.Time
+	EndedAt      time.Time // 비어있으면 진행 중
+	FinalState   string    // 종료 상태 (completed, failed, cancelled), 진행 중이면 빈 문자열
+	Reason       string    // 종료 사유
+	StopReasons  []string  // Stop Hook 원인 기록 (plan_ready, rate_limit 등)
+	InputTokens  int64
+	OutputTokens int64
+	Verification string // 검증 결과 (예: tests_passed), 없으면 빈 문자열
+}
+
+// Duration은 실행 시간을 반환합니다. 진행 중이면 0입니다.
+func (r TaskRun) Duration() time.Duration {
+	if r.EndedAt.IsZero() {
+		return 0
+	}
+	return r.EndedAt.Sub(r.StartedAt)
+}
+
+// TaskRunResult는 실행 종료 시 기록하는 결과입니다.
+type TaskRunResult struct {
+	FinalState   string
+	Reason       string
+	EndedAt      time.Time
+	InputTokens  int64
+	OutputTokens int64
+	Verification string
+}
+
+// TaskRunFilter는 실행 기록 조회 조건입니다. 빈 필드는 조건에서 제외됩니다.
+type TaskRunFilter struct {
+	Since    time.Time
+	WorkerID string
+	ListID   string
+	Model    string
+}
+
+// TaskRunStore는 태스크 실행 기록 저장소입니다.
+type TaskRunStore interface {
+	// StartRun은 실행 시작을 기록하고 실행 ID를 반환합니다.
+	StartRun(run TaskRun) (int64, error)
+	// AddStopReason은 실행 중 발생한 Stop 원인을 추가합니다.
+	AddStopReason(id int64, reason string) error
+	// FinishRun은 실행 종료 결과를 기록합니다.
+	FinishRun(id int64, result TaskRunResult) error
+	// ListRuns는 조건에 맞는 실행 기록을 시작 시각 순으로 반환합니다.
+	ListRuns(filter TaskRunFilter) ([]TaskRun, error)
+	// Close는 DB 연결을 닫습니다.
+	Close() error
+}
+
+// SQLiteTaskRunStore는 SQLite 기반 TaskRunStore 구현입니다.
+type SQLiteTaskRunStore struct {
+	db   *sql.DB
+	mu   sync.RWMutex
+	path string
+}
+
+// NewSQLiteTaskRunStore는 새로운 SQLite 기반 실행 기록 저장소를 생성합니다.
+func NewSQLiteTaskRunStore(dbPath string) (*SQLiteTaskRunStore, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("DB 열기 실패: %w", err)
+	}
+
+	// 테이블 생성
+	createTableSQL := `
+	CREATE TABLE IF NOT EXISTS task_runs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		task_id TEXT NOT NULL,
+		jira_id TEXT,
+		list_id TEXT,
+		worker_id TEXT NOT NULL,
+		model TEXT,
+		terminal TEXT,
+		started_at DATETIME NOT NULL,
+		ended_at DATETIME,
+		final_state TEXT,
+		reason TEXT,
+		stop_reasons TEXT,
+		input_tokens INTEGER DEFAULT 0,
+		output_tokens INTEGER DEFAULT 0,
+		verification TEXT
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_runs_started_at ON task_runs(started_at);
+	CREATE INDEX IF NOT EXISTS idx_task_runs_worker_id ON task_runs(worker_id);
+	`
+
+	if _, err := db.Exec(createTableSQL); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("테이블 생성 실패: %w", err)
+	}
+
+	return &SQLiteTaskRunStore{
+		db:   db,
+		path: dbPath,
+	}, nil
+}
+
+// StartRun은 실행 시작을 기록하고 실행 ID를 반환합니다.
+func (s *SQLiteTaskRunStore) StartRun(run TaskRun) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if run.StartedAt.IsZero() {
+		run.StartedAt = time.Now()
+	}
+
+	result, err := s.db.Exec(
+		`INSERT INTO task_runs (task_id, jira_id, list_id, worker_id, model, terminal, started_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		run.TaskID, run.JiraID, run.ListID, run.WorkerID, run.Model, run.Terminal, run.StartedAt.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("삽입 실패: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("실행 ID 조회 실패: %w", err)
+	}
+	return id, nil
+}
+
+// AddStopReason은 실행 중 발생한 Stop 원인을 추가합니다.
+func (s *SQLiteTaskRunStore) AddStopReason(id int64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.db.Exec(
+		`UPDATE task_runs SET stop_reasons = CASE
+			WHEN stop_reasons IS NULL OR stop_reasons = '' THEN ?
+			ELSE stop_reasons || ',' || ? END
+		WHERE id = ?`,
+		reason, reason, id,
+	)
+	if err != nil {
+		return fmt.Errorf("Stop 원인 기록 실패: %w", err)
+	}
+	return nil
+}
+
+// FinishRun은 실행 종료 결과를 기록합니다.
+func (s *SQLiteTaskRunStore) FinishRun(id int64, result TaskRunResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if result.EndedAt.IsZero() {
+		result.EndedAt = time.Now()
+	}
+
+	_, err := s.db.Exec(
+		`UPDATE task_runs SET ended_at = ?, final_state = ?, reason = ?,
+			input_tokens = ?, output_tokens = ?, verification = ?
+		WHERE id = ?`,
+		result.EndedAt.UTC(), result.FinalState, result.Reason,
+		result.InputTokens, result.OutputTokens, result.Verification, id,
+	)
+	if err != nil {
+		return fmt.Errorf("종료 기록 실패: %w", err)
+	}
+	return nil
+}
+
+// ListRuns는 조건에 맞는 실행 기록을 시작 시각 순으로 반환합니다.
+func (s *SQLiteTaskRunStore) ListRuns(filter TaskRunFilter) ([]TaskRun, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	query := `SELECT id, task_id, jira_id, list_id, worker_id, model, terminal, started_at, ended_at,
+		final_state, reason, stop_reasons, input_tokens, output_tokens, verification
+		FROM task_runs WHERE 1=1`
+	var args []interface{}
+	if !filter.Since.IsZero() {
+		query += " AND started_at >= ?"
+		args = append(args, filter.Since.UTC()) // 시각은 UTC 문자열로 저장되므로 문자열 비교가 가능하도록 맞춤
+	}
+	if filter.WorkerID != "" {
+		query += " AND worker_id = ?"
+		args = append(args, filter.WorkerID)
+	}
+	if filter.ListID != "" {
+		query += " AND list_id = ?"
+		args = append(args, filter.ListID)
+	}
+	if filter.Model != "" {
+		query += " AND model = ?"
+		args = append(args, filter.Model)
+	}
+	query += " ORDER BY started_at, id"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []TaskRun
+	for rows.Next() {
+		var run TaskRun
+		var jiraID, listID, model, terminal, finalState, reason, stopReasons, verification sql.NullString
+		var endedAt sql.NullTime
+		if err := rows.Scan(&run.ID, &run.TaskID, &jiraID, &listID, &run.WorkerID, &model, &terminal,
+			&run.StartedAt, &endedAt, &finalState, &reason, &stopReasons,
+			&run.InputTokens, &run.OutputTokens, &verification); err != nil {
+			return nil, fmt.Errorf("행 읽기 실패: %w", err)
+		}
+		run.JiraID = jiraID.String
+		run.ListID = listID.String
+		run.Model = model.String
+		run.Terminal = terminal.String
+		run.EndedAt = endedAt.Time
+		run.FinalState = finalState.String
+		run.Reason = reason.String
+		if stopReasons.String != "" {
+			run.StopReasons = strings.Split(stopReasons.String, ",")
+		}
+		run.Verification = verification.String
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("조회 실패: %w", err)
+	}
+	return runs, nil
+}
+
+// Close는 DB 연결을 닫습니다.
+func (s *SQLiteTaskRunStore) Close() error {
+	return s.db.Close()
+}
+
+// TaskRunSummary는 그룹(리스트, 모델 등)별 실행 통계입니다.
+type TaskRunSummary struct {
+	Key                string
+	Total              int
+	Completed          int
+	Failed             int
+	Cancelled          int
+	Running            int
+	SuccessRate        float64        // 종료된 실행 중 완료 비율 (0~1)
+	MeanTimeToComplete time.Duration  // 완료된 실행의 평균 소요 시간
+	Failures           map[string]int // 실패/취소 원인별 건수 (예: "failed:context_exceeded")
+	InputTokens        int64
+	OutputTokens       int64
+}
+
+// SummarizeTaskRuns는 실행 기록을 key 기준으로 묶어 통계를 계산합니다. 결과는 Key 순으로 정렬됩니다.
+func SummarizeTaskRuns(runs []TaskRun, key func(TaskRun) string) []TaskRunSummary {
+	byKey := make(map[string]*TaskRunSummary)
+	durations := make(map[string]time.Duration)
+
+	for _, run := range runs {
+		k := key(run)
+		summary, ok := byKey[k]
+		if !ok {
+			summary = &TaskRunSummary{Key: k, Failures: make(map[string]int)}
+			byKey[k] = summary
+		}
+
+		summary.Total++
+		summary.InputTokens += run.InputTokens
+		summary.OutputTokens += run.OutputTokens
+
+		switch run.FinalState {
+		case "":
+			summary.Running++
+		case "completed":
+			summary.Completed++
+			durations[k] += run.Duration()
+		default:
+			if run.FinalState == "cancelled" {
+				summary.Cancelled++
+			} else {
+				summary.Failed++
+			}
+			cause := run.FinalState
+			if len(run.StopReasons) > 0 {
+				cause += ":" + run.StopReasons[len(run.StopReasons)-1]
+			}
+			summary.Failures[cause]++
+		}
+	}
+
+	summaries := make([]TaskRunSummary, 0, len(byKey))
+	for k, summary := range byKey {
+		if finished := summary.Total - summary.Running; finished > 0 {
+			summary.SuccessRate = float64(summary.Completed) / float64(finished)
+		}
+		if summary.Completed > 0 {
+			summary.MeanTimeToComplete = durations[k] / time.Duration(summary.Completed)
+		}
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Key < summaries[j].Key })
+	return summaries
+}
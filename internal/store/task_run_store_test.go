@@ -0,0 +1,86 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestSQLiteTaskRunStore는 실행 기록 저장/조회를 테스트합니다.
+func TestSQLiteTaskRunStore(t *testing.T) {
+	s, err := NewSQLiteTaskRunStore(filepath.Join(t.TempDir(), "task_runs.db"))
+	if err != nil {
+		t.Fatalf("저장소 생성 실패: %v", err)
+	}
+	defer s.Close()
+
+	base := time.Now().Add(-48 * time.Hour)
+	oldID, _ := s.StartRun(TaskRun{TaskID: "old", WorkerID: "AI_01", StartedAt: base.Add(-10 * 24 * time.Hour)})
+	s.FinishRun(oldID, TaskRunResult{FinalState: "completed", EndedAt: base.Add(-10*24*time.Hour + time.Hour)})
+
+	id, err := s.StartRun(TaskRun{TaskID: "t1", JiraID: "ITSM-1", ListID: "list2", WorkerID: "AI_02", Model: "claude", StartedAt: base})
+	if err != nil {
+		t.Fatalf("시작 기록 실패: %v", err)
+	}
+	s.AddStopReason(id, "plan_ready")
+	s.AddStopReason(id, "rate_limit")
+	if err := s.FinishRun(id, TaskRunResult{FinalState: "completed", EndedAt: base.Add(30 * time.Minute),
+		InputTokens: 1200, OutputTokens: 300, Verification: "tests_passed"}); err != nil {
+		t.Fatalf("종료 기록 실패: %v", err)
+	}
+	s.StartRun(TaskRun{TaskID: "t2", ListID: "list1", WorkerID: "AI_01", StartedAt: base.Add(time.Hour)})
+
+	runs, err := s.ListRuns(TaskRunFilter{Since: time.Now().Add(-7 * 24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("조회 실패: %v", err)
+	}
+	if len(runs) != 2 || runs[0].TaskID != "t1" || runs[1].TaskID != "t2" {
+		t.Fatalf("기간 조회 불일치: %+v", runs)
+	}
+
+	run := runs[0]
+	if run.Duration() != 30*time.Minute || run.InputTokens != 1200 || run.Verification != "tests_passed" ||
+		len(run.StopReasons) != 2 || run.StopReasons[1] != "rate_limit" {
+		t.Errorf("실행 기록 불일치: %+v", run)
+	}
+	if !runs[1].EndedAt.IsZero() || runs[1].FinalState != "" {
+		t.Errorf("진행 중 실행은 종료 시각/상태가 없어야 함: %+v", runs[1])
+	}
+
+	runs, _ = s.ListRuns(TaskRunFilter{WorkerID: "AI_02"})
+	if len(runs) != 1 || runs[0].TaskID != "t1" {
+		t.Errorf("Worker 조회 불일치: %+v", runs)
+	}
+}
+
+// TestSummarizeTaskRuns는 그룹별 성공률/평균 완료 시간/실패 분류를 테스트합니다.
+func TestSummarizeTaskRuns(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	runs := []TaskRun{
+		{ListID: "list1", FinalState: "completed", StartedAt: start, EndedAt: start.Add(20 * time.Minute)},
+		{ListID: "list1", FinalState: "completed", StartedAt: start, EndedAt: start.Add(40 * time.Minute)},
+		{ListID: "list1", FinalState: "failed", StopReasons: []string{"plan_ready", "context_exceeded"}, StartedAt: start, EndedAt: start.Add(time.Hour)},
+		{ListID: "list1", FinalState: "cancelled", StartedAt: start, EndedAt: start.Add(time.Minute)},
+		{ListID: "list1", StartedAt: start},
+		{ListID: "list2", FinalState: "failed", StartedAt: start, EndedAt: start.Add(time.Minute)},
+	}
+
+	summaries := SummarizeTaskRuns(runs, func(r TaskRun) string { return r.ListID })
+	if len(summaries) != 2 || summaries[0].Key != "list1" {
+		t.Fatalf("그룹 불일치: %+v", summaries)
+	}
+
+	s := summaries[0]
+	if s.Total != 5 || s.Completed != 2 || s.Failed != 1 || s.Cancelled != 1 || s.Running != 1 {
+		t.Errorf("건수 불일치: %+v", s)
+	}
+	if s.SuccessRate != 0.5 || s.MeanTimeToComplete != 30*time.Minute {
+		t.Errorf("성공률/평균 완료 시간 불일치: %v, %v", s.SuccessRate, s.MeanTimeToComplete)
+	}
+	if s.Failures["failed:context_exceeded"] != 1 || s.Failures["cancelled"] != 1 {
+		t.Errorf("실패 분류 불일치: %v", s.Failures)
+	}
+	if summaries[1].SuccessRate != 0 || summaries[1].Failures["failed"] != 1 {
+		t.Errorf("list2 통계 불일치: %+v", summaries[1])
+	}
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// StatusCancelled는 진행 중인 AI 작업을 중단시키는 ClickUp 상태입니다.
+const StatusCancelled = "취소"
+
+// TaskCanceller는 진행 중인 태스크 작업을 중단할 수 있는 Processor입니다.
+// Processor가 구현하면 태스크 삭제/이동/취소 이벤트에서 호출됩니다.
+type TaskCanceller interface {
+	// CancelTask는 태스크를 처리 중인 Worker가 있으면 작업을 중단하고 true를 반환합니다.
+	// destListID는 이동 이벤트의 대상 리스트 ID이며, 다른 이벤트에서는 빈 문자열입니다.
+	CancelTask(ctx context.Context, taskID, destListID, reason string) bool
+}
+
+// Cancellation은 작업 중단 대상 이벤트의 분석 결과입니다.
+type Cancellation struct {
+	Reason     string // 중단 사유 (Slack 알림/결정 로그용)
+	DestListID string // 이동 대상 리스트 ID (taskMoved만)
+}
+
+// DetectCancellation은 이벤트가 진행 중인 작업을 중단해야 하는 이벤트인지 확인합니다.
+// 태스크 삭제, 리스트 이동, 상태가 취소로 변경된 경우가 해당합니다.
+func DetectCancellation(event *clickup.WebhookEvent) (Cancellation, bool) {
+	switch event.Event {
+	case clickup.EventTaskDeleted:
+		return Cancellation{Reason: "태스크가 삭제되었습니다"}, true
+
+	case clickup.EventTaskMoved:
+		dest := movedListID(event)
+		return Cancellation{Reason: "태스크가 다른 리스트로 이동되었습니다", DestListID: dest}, true
+
+	case clickup.EventTaskStatusUpdated, clickup.EventTaskUpdated:
+		for _, item := range event.HistoryItems {
+			if item.Field == "status" && containsFold(historyValues(item.After), StatusCancelled) {
+				return Cancellation{Reason: "태스크 상태가 '" + StatusCancelled + "'로 변경되었습니다"}, true
+			}
+		}
+	}
+
+	return Cancellation{}, false
+}
+
+// movedListID는 이동 이벤트에서 대상 리스트 ID를 추출합니다.
+// 이력 항목의 after 객체 id를 우선 사용하고, 없으면 parent_id를 사용합니다.
+func movedListID(event *clickup.WebhookEvent) string {
+	for _, item := range event.HistoryItems {
+		if after, ok := item.After.(map[string]interface{}); ok {
+			if id, ok := after["id"].(string); ok && strings.TrimSpace(id) != "" {
+				return id
+			}
+		}
+	}
+	return event.GetListIDFromEvent()
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+)
+
+// cancellingProcessor는 TaskCanceller를 구현하는 테스트용 프로세서입니다.
+type cancellingProcessor struct {
+	MockProcessor
+	inFlight  map[string]string // 태스크 ID → Worker 리스트 ID
+	cancelled []string
+}
+
+func (p *cancellingProcessor) CancelTask(ctx context.Context, taskID, destListID, reason string) bool {
+	listID, ok := p.inFlight[taskID]
+	if !ok || (destListID != "" && destListID == listID) {
+		return false
+	}
+	delete(p.inFlight, taskID)
+	p.cancelled = append(p.cancelled, taskID+": "+reason)
+	return true
+}
+
+// TestDetectCancellation은 작업 중단 이벤트 판별을 테스트합니다.
+func TestDetectCancellation(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want bool
+		dest string
+	}{
+		{"삭제", `{"event": "taskDeleted", "task_id": "t1"}`, true, ""},
+		{"이동", `{"event": "taskMoved", "task_id": "t1", "history_items": [{"id": "h1", "field": "section_moved",
+			"before": {"id": "ai-list-1"}, "after": {"id": "other-list"}}]}`, true, "other-list"},
+		{"취소 상태", `{"event": "taskStatusUpdated", "task_id": "t1", "history_items": [{"id": "h2", "field": "status",
+			"after": {"status": "취소"}}]}`, true, ""},
+		{"다른 상태", `{"event": "taskStatusUpdated", "task_id": "t1", "history_items": [{"id": "h3", "field": "status",
+			"after": {"status": "개발완료"}}]}`, false, ""},
+		{"생성", `{"event": "taskCreated", "task_id": "t1"}`, false, ""},
+	}
+
+	for _, tt := range tests {
+		cancel, ok := DetectCancellation(parseEvent(t, tt.data))
+		if ok != tt.want || cancel.DestListID != tt.dest {
+			t.Errorf("%s: got (%v, %q), want (%v, %q)", tt.name, ok, cancel.DestListID, tt.want, tt.dest)
+		}
+		if ok && cancel.Reason == "" {
+			t.Errorf("%s: 중단 사유가 있어야 함", tt.name)
+		}
+	}
+}
+
+// TestHandler_CancelInFlightTask는 진행 중인 태스크의 삭제/이동/취소 처리를 테스트합니다.
+func TestHandler_CancelInFlightTask(t *testing.T) {
+	processor := &cancellingProcessor{inFlight: map[string]string{
+		"t1": "ai-list-1",
+		"t2": "ai-list-1",
+		"t3": "ai-list-1",
+	}}
+	handler := NewHandler(processor, "")
+
+	// 같은 리스트로의 이동은 중단하지 않음
+	decision := handler.processEvent(context.Background(), parseEvent(t, `{"event": "taskMoved", "task_id": "t1",
+		"history_items": [{"id": "h1", "field": "section_moved", "after": {"id": "ai-list-1"}}]}`))
+	if decision.Action == ActionCancelled {
+		t.Errorf("같은 리스트 이동은 중단하지 않아야 함: %+v", decision)
+	}
+
+	decision = handler.processEvent(context.Background(), parseEvent(t, `{"event": "taskMoved", "task_id": "t1",
+		"history_items": [{"id": "h2", "field": "section_moved", "after": {"id": "other-list"}}]}`))
+	if decision.Action != ActionCancelled || decision.ListID != "other-list" {
+		t.Errorf("다른 리스트 이동은 중단해야 함: %+v", decision)
+	}
+
+	decision = handler.processEvent(context.Background(), parseEvent(t, `{"event": "taskDeleted", "task_id": "t2"}`))
+	if decision.Action != ActionCancelled {
+		t.Errorf("삭제는 중단해야 함: %+v", decision)
+	}
+
+	decision = handler.processEvent(context.Background(), parseEvent(t, `{"event": "taskStatusUpdated", "task_id": "t3",
+		"history_items": [{"id": "h3", "field": "status", "parent_id": "ai-list-1", "after": {"status": "취소"}}]}`))
+	if decision.Action != ActionCancelled {
+		t.Errorf("취소 상태 변경은 중단해야 함: %+v", decision)
+	}
+
+	// 진행 중이 아닌 태스크 삭제는 일반 흐름으로 무시
+	decision = handler.processEvent(context.Background(), parseEvent(t, `{"event": "taskDeleted", "task_id": "t9"}`))
+	if decision.Action != ActionIgnored {
+		t.Errorf("진행 중이 아닌 태스크 삭제는 무시되어야 함: %+v", decision)
+	}
+
+	if len(processor.cancelled) != 3 || len(processor.EnqueuedTasks) != 0 {
+		t.Errorf("중단/큐 추가 불일치: cancelled=%v enqueued=%v", processor.cancelled, processor.EnqueuedTasks)
+	}
+}
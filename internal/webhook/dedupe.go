@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// Deduplicator는 일정 시간 안에 재전송된 웹훅 이벤트를 걸러냅니다.
+// ClickUp은 응답 지연/실패 시 같은 이벤트를 같은 이력 항목 ID로 다시 보내므로
+// "이벤트 타입 + 이력 항목 ID"를 키로 사용합니다.
+type Deduplicator struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]time.Time // 키 → 처음 수신 시각
+}
+
+// NewDeduplicator는 새 Deduplicator를 생성합니다.
+func NewDeduplicator(window time.Duration) *Deduplicator {
+	return &Deduplicator{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// IsDuplicate는 이벤트가 중복인지 확인하고, 처음 보는 이력 항목은 기록합니다.
+// 모든 이력 항목이 윈도우 안에서 이미 수신된 경우에만 중복으로 판단하며,
+// 이력 항목 ID가 없는 이벤트는 중복 판단을 하지 않습니다.
+func (d *Deduplicator) IsDuplicate(event *clickup.WebhookEvent) bool {
+	keys := dedupeKeys(event)
+	if len(keys) == 0 {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.prune(now)
+
+	duplicate := true
+	for _, key := range keys {
+		if _, ok := d.seen[key]; !ok {
+			duplicate = false
+			d.seen[key] = now
+		}
+	}
+	return duplicate
+}
+
+// prune은 윈도우가 지난 키를 제거합니다. 호출자가 mu를 잡고 있어야 합니다.
+func (d *Deduplicator) prune(now time.Time) {
+	for key, t := range d.seen {
+		if now.Sub(t) >= d.window {
+			delete(d.seen, key)
+		}
+	}
+}
+
+// dedupeKeys는 이벤트의 중복 판단 키 목록을 반환합니다.
+func dedupeKeys(event *clickup.WebhookEvent) []string {
+	var keys []string
+	for _, item := range event.HistoryItems {
+		if item.ID != "" {
+			keys = append(keys, event.Event+"/"+item.ID)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TestDeduplicator_IsDuplicate는 윈도우 내 재전송 이벤트 필터링을 테스트합니다.
+func TestDeduplicator_IsDuplicate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	d := NewDeduplicator(10 * time.Minute)
+	d.now = func() time.Time { return now }
+
+	event := &clickup.WebhookEvent{
+		Event:        clickup.EventTaskStatusUpdated,
+		TaskID:       "task1",
+		HistoryItems: []clickup.HistoryItem{{ID: "h1", Field: "status"}},
+	}
+
+	if d.IsDuplicate(event) {
+		t.Error("첫 수신은 중복이 아니어야 함")
+	}
+	if !d.IsDuplicate(event) {
+		t.Error("윈도우 내 재전송은 중복이어야 함")
+	}
+
+	// 같은 이력 항목이라도 이벤트 타입이 다르면 별개
+	other := *event
+	other.Event = clickup.EventTaskUpdated
+	if d.IsDuplicate(&other) {
+		t.Error("이벤트 타입이 다르면 중복이 아니어야 함")
+	}
+
+	// 새 이력 항목이 하나라도 있으면 중복 아님
+	mixed := *event
+	mixed.HistoryItems = []clickup.HistoryItem{{ID: "h1"}, {ID: "h2"}}
+	if d.IsDuplicate(&mixed) {
+		t.Error("새 이력 항목이 있으면 중복이 아니어야 함")
+	}
+
+	// 윈도우가 지나면 다시 처리
+	now = now.Add(10 * time.Minute)
+	if d.IsDuplicate(event) {
+		t.Error("윈도우가 지난 이벤트는 중복이 아니어야 함")
+	}
+}
+
+// TestDeduplicator_NoHistoryID는 이력 항목 ID가 없는 이벤트를 테스트합니다.
+func TestDeduplicator_NoHistoryID(t *testing.T) {
+	d := NewDeduplicator(time.Minute)
+	event := &clickup.WebhookEvent{Event: clickup.EventTaskCreated, TaskID: "task1"}
+
+	for i := 0; i < 2; i++ {
+		if d.IsDuplicate(event) {
+			t.Error("이력 항목 ID가 없으면 중복 판단하지 않아야 함")
+		}
+	}
+}
@@ -1,35 +1,55 @@
 package webhook
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/zime/slickwebhook/internal/clickup"
 )
 
 // Processor는 웹훅 이벤트를 처리하는 인터페이스입니다.
+// EnqueueTask/CancelTask의 ctx는 서버 수명에 묶여 있어 종료 시 취소됩니다.
 type Processor interface {
-	EnqueueTask(taskID, listID string)
+	EnqueueTask(ctx context.Context, taskID, listID string)
 	IsAIList(listID string) bool
 }
 
 // Handler는 ClickUp 웹훅을 처리합니다.
 type Handler struct {
 	processor Processor
-	secret    string
+	secrets   []string // 웹훅 시크릿 (리스트별 웹훅이면 여러 개)
 	logger    *log.Logger
+	recorder  Recorder      // 요청 기록 저장소 (nil이면 기록 안함)
+	dedupe    *Deduplicator // 재전송 이벤트 필터 (nil이면 중복 제거 안함)
+	rules     []TriggerRule // 트리거 규칙 (비어 있으면 기본 규칙)
+
+	pool       *Pool         // 비동기 처리 풀 (nil이면 요청 처리 중 바로 처리)
+	retryAfter time.Duration // 풀 포화 시 Retry-After
 }
 
 // NewHandler는 새 Handler를 생성합니다.
+// secret은 콤마로 구분하여 여러 개를 지정할 수 있으며, 하나라도 일치하면 서명이 유효합니다.
 func NewHandler(processor Processor, secret string) *Handler {
+	var secrets []string
+	for _, s := range strings.Split(secret, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+
 	return &Handler{
 		processor: processor,
-		secret:    secret,
+		secrets:   secrets,
 	}
 }
 
@@ -38,10 +58,51 @@ func (h *Handler) SetLogger(logger *log.Logger) {
 	h.logger = logger
 }
 
+// SetRecorder는 요청 기록 저장소를 설정합니다.
+// 설정되면 모든 수신 요청(헤더, 원본 페이로드, 서명 검증 결과, 라우팅 결정)을 기록합니다.
+func (h *Handler) SetRecorder(recorder Recorder) {
+	h.recorder = recorder
+}
+
+// SetDeduplicator는 재전송 이벤트 필터를 설정합니다.
+func (h *Handler) SetDeduplicator(dedupe *Deduplicator) {
+	h.dedupe = dedupe
+}
+
+// SetTriggerRules는 트리거 규칙을 설정합니다.
+// 규칙 중 하나라도 일치하면 태스크를 큐에 추가합니다. 비어 있으면 기본 규칙을 사용합니다.
+func (h *Handler) SetTriggerRules(rules []TriggerRule) {
+	h.rules = rules
+}
+
+// SetPool은 비동기 처리 풀을 설정합니다.
+// 설정되면 검증/파싱 후 즉시 200으로 응답하고 이벤트는 풀에서 처리하며,
+// 풀이 포화되면 503과 Retry-After로 응답하여 ClickUp이 재전송하도록 합니다.
+func (h *Handler) SetPool(pool *Pool, retryAfter time.Duration) {
+	h.pool = pool
+	h.retryAfter = retryAfter
+}
+
 // HandleWebhook은 ClickUp 웹훅을 처리합니다.
 func (h *Handler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	rec := &Record{
+		Time:       time.Now(),
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		RemoteAddr: r.RemoteAddr,
+		Headers:    r.Header.Clone(),
+	}
+	// 비동기 처리 시 기록은 라우팅 결정 후 풀에서 저장
+	async := false
+	defer func() {
+		if !async {
+			h.record(rec)
+		}
+	}()
+
 	// POST 메서드만 허용
 	if r.Method != http.MethodPost {
+		rec.Decision = Decision{Action: ActionRejected, Reason: "허용되지 않은 메서드"}
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
@@ -50,15 +111,19 @@ func (h *Handler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		h.logError("페이로드 읽기 실패: %v", err)
+		rec.Decision = Decision{Action: ActionRejected, Reason: "페이로드 읽기 실패: " + err.Error()}
 		http.Error(w, "Failed to read body", http.StatusBadRequest)
 		return
 	}
 	defer r.Body.Close()
+	rec.Body = string(body)
 
 	// 서명 검증
 	signature := r.Header.Get("X-Signature")
-	if !h.VerifySignature(body, signature) {
+	rec.Verified = h.VerifySignature(body, signature)
+	if !rec.Verified {
 		h.logError("서명 검증 실패")
+		rec.Decision = Decision{Action: ActionRejected, Reason: "서명 검증 실패"}
 		http.Error(w, "Invalid signature", http.StatusUnauthorized)
 		return
 	}
@@ -67,41 +132,117 @@ func (h *Handler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	var event clickup.WebhookEvent
 	if err := json.Unmarshal(body, &event); err != nil {
 		h.logError("이벤트 파싱 실패: %v", err)
+		rec.Decision = Decision{Action: ActionRejected, Reason: "이벤트 파싱 실패: " + err.Error()}
 		http.Error(w, "Failed to parse event", http.StatusBadRequest)
 		return
 	}
 
-	// 이벤트 처리
-	h.processEvent(&event)
+	// 이벤트 처리 (풀이 있으면 대기열에 넣고 즉시 응답)
+	if h.pool == nil {
+		rec.Decision = h.processEvent(r.Context(), &event)
+	} else {
+		accepted := h.pool.TrySubmit(func(ctx context.Context) {
+			rec.Decision = h.processEvent(ctx, &event)
+			h.record(rec)
+		})
+		if !accepted {
+			h.logError("처리 대기열 포화, 503 응답: %s (태스크: %s)", event.Event, event.TaskID)
+			rec.Decision = Decision{Action: ActionRejected, Reason: "처리 대기열 포화", Event: event.Event, TaskID: event.TaskID}
+			w.Header().Set("Retry-After", strconv.Itoa(int(h.retryAfter.Seconds())))
+			http.Error(w, "Server busy", http.StatusServiceUnavailable)
+			return
+		}
+		async = true
+	}
 
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("OK"))
 }
 
-// processEvent는 웹훅 이벤트를 처리합니다.
-func (h *Handler) processEvent(event *clickup.WebhookEvent) {
-	h.logInfo("웹훅 이벤트 수신: %s, 태스크: %s", event.Event, event.TaskID)
+// Replay는 기록된 요청을 다시 파싱하여 processEvent로 처리하고 라우팅 결정을 반환합니다.
+// 서명은 다시 검증하지 않으며, 원래 거부된 요청(서명 실패 등)도 페이로드가 유효하면 처리합니다.
+func (h *Handler) Replay(rec *Record) (Decision, error) {
+	var event clickup.WebhookEvent
+	if err := json.Unmarshal([]byte(rec.Body), &event); err != nil {
+		return Decision{Action: ActionRejected, Reason: "이벤트 파싱 실패: " + err.Error()}, fmt.Errorf("이벤트 파싱 실패: %w", err)
+	}
+	return h.processEvent(context.Background(), &event), nil
+}
+
+// processEvent는 웹훅 이벤트를 처리하고 라우팅 결정을 반환합니다.
+// 모든 결정은 사유와 함께 한 줄 로그로 남깁니다.
+func (h *Handler) processEvent(ctx context.Context, event *clickup.WebhookEvent) Decision {
+	decision := h.decide(ctx, event)
+
+	if decision.Action == ActionEnqueued {
+		h.processor.EnqueueTask(ctx, event.TaskID, decision.ListID)
+	}
+
+	h.logInfo("결정: %s event=%s task=%s list=%s 사유=%s %s",
+		decision.Action, decision.Event, decision.TaskID, decision.ListID, decision.Reason,
+		strings.Join(decision.Evaluations, "; "))
+
+	return decision
+}
+
+// decide는 중복, 작업 중단, 리스트, 트리거 규칙 순서로 이벤트의 라우팅을 결정합니다.
+func (h *Handler) decide(ctx context.Context, event *clickup.WebhookEvent) Decision {
+	decision := Decision{Event: event.Event, TaskID: event.TaskID}
+
+	// 재전송 이벤트 확인
+	if h.dedupe != nil && h.dedupe.IsDuplicate(event) {
+		decision.Action, decision.Reason = ActionIgnored, "중복 이벤트"
+		return decision
+	}
+
+	// 삭제/이동/취소 이벤트면 진행 중인 작업 중단
+	if cancel, ok := DetectCancellation(event); ok {
+		if canceller, ok := h.processor.(TaskCanceller); ok && canceller.CancelTask(ctx, event.TaskID, cancel.DestListID, cancel.Reason) {
+			decision.ListID = cancel.DestListID
+			decision.Action, decision.Reason = ActionCancelled, cancel.Reason
+			return decision
+		}
+	}
 
 	// 리스트 ID 추출
 	listID := event.GetListIDFromEvent()
 	if listID == "" {
-		h.logInfo("리스트 ID를 찾을 수 없음, 이벤트 무시")
-		return
+		decision.Action, decision.Reason = ActionIgnored, "리스트 ID 없음"
+		return decision
 	}
+	decision.ListID = listID
 
 	// AI 리스트인지 확인
 	if !h.processor.IsAIList(listID) {
-		h.logInfo("AI 리스트가 아님: %s, 이벤트 무시", listID)
-		return
+		decision.Action, decision.Reason = ActionIgnored, "AI 리스트가 아님"
+		return decision
 	}
 
-	// 처리할 이벤트 타입 확인
-	switch event.Event {
-	case clickup.EventTaskCreated, clickup.EventTaskUpdated, clickup.EventTaskStatusUpdated:
-		h.logInfo("태스크를 큐에 추가: %s (리스트: %s)", event.TaskID, listID)
-		h.processor.EnqueueTask(event.TaskID, listID)
-	default:
-		h.logInfo("처리하지 않는 이벤트 타입: %s", event.Event)
+	// 트리거 규칙 평가
+	rules := h.rules
+	if len(rules) == 0 {
+		rules = DefaultTriggerRules()
+	}
+	for _, rule := range rules {
+		matched, why := rule.Match(event)
+		decision.Evaluations = append(decision.Evaluations, why)
+		if matched {
+			decision.Action, decision.Reason, decision.Rule = ActionEnqueued, "트리거 규칙 일치", rule.Name
+			return decision
+		}
+	}
+
+	decision.Action, decision.Reason = ActionIgnored, "일치하는 트리거 규칙 없음"
+	return decision
+}
+
+// record는 요청 기록을 저장합니다. 저장 실패는 로그만 남깁니다.
+func (h *Handler) record(rec *Record) {
+	if h.recorder == nil {
+		return
+	}
+	if err := h.recorder.Record(rec); err != nil {
+		h.logError("요청 기록 실패: %v", err)
 	}
 }
 
@@ -111,11 +252,21 @@ func (h *Handler) VerifySignature(payload []byte, signature string) bool {
 		return false
 	}
 
-	mac := hmac.New(sha256.New, []byte(h.secret))
-	mac.Write(payload)
-	expected := hex.EncodeToString(mac.Sum(nil))
+	secrets := h.secrets
+	if len(secrets) == 0 {
+		secrets = []string{""} // 시크릿 미설정 시 기존 동작 유지 (빈 키로 검증)
+	}
 
-	return hmac.Equal([]byte(expected), []byte(signature))
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		expected := hex.EncodeToString(mac.Sum(nil))
+
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return true
+		}
+	}
+	return false
 }
 
 func (h *Handler) logInfo(format string, args ...interface{}) {
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -23,7 +24,7 @@ type EnqueuedTask struct {
 	ListID string
 }
 
-func (m *MockProcessor) EnqueueTask(taskID, listID string) {
+func (m *MockProcessor) EnqueueTask(ctx context.Context, taskID, listID string) {
 	m.EnqueuedTasks = append(m.EnqueuedTasks, EnqueuedTask{TaskID: taskID, ListID: listID})
 }
 
@@ -159,3 +160,18 @@ func computeSignature(payload []byte, secret string) string {
 	mac.Write(payload)
 	return hex.EncodeToString(mac.Sum(nil))
 }
+
+// TestVerifySignature_MultipleSecrets는 리스트별 웹훅 시크릿 여러 개로 서명 검증을 테스트합니다.
+func TestVerifySignature_MultipleSecrets(t *testing.T) {
+	handler := NewHandler(nil, "secret-a, secret-b")
+	payload := []byte(`{"event": "taskCreated"}`)
+
+	for _, secret := range []string{"secret-a", "secret-b"} {
+		if !handler.VerifySignature(payload, computeSignature(payload, secret)) {
+			t.Errorf("%s 서명은 유효해야 함", secret)
+		}
+	}
+	if handler.VerifySignature(payload, computeSignature(payload, "secret-c")) {
+		t.Error("등록되지 않은 시크릿 서명은 거부되어야 함")
+	}
+}
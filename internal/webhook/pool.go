@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Pool은 웹훅 이벤트를 처리하는 고정 크기 작업 풀입니다.
+// 대기열이 가득 차면 작업을 받지 않으므로 수신 측에서 503으로 백프레셔를 걸 수 있습니다.
+type Pool struct {
+	workers int
+	jobs    chan func(ctx context.Context)
+}
+
+// NewPool은 새 Pool을 생성합니다.
+// workers는 동시에 처리할 작업 수, queueSize는 처리 대기 작업 수 상한입니다.
+func NewPool(workers, queueSize int) *Pool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	return &Pool{
+		workers: workers,
+		jobs:    make(chan func(ctx context.Context), queueSize),
+	}
+}
+
+// TrySubmit은 작업을 대기열에 넣습니다. 대기열이 가득 차면 즉시 false를 반환합니다.
+func (p *Pool) TrySubmit(job func(ctx context.Context)) bool {
+	select {
+	case p.jobs <- job:
+		return true
+	default:
+		return false
+	}
+}
+
+// Pending은 처리 대기 중인 작업 수를 반환합니다.
+func (p *Pool) Pending() int {
+	return len(p.jobs)
+}
+
+// Run은 작업자 고루틴을 시작하고 ctx가 취소될 때까지 작업을 처리합니다.
+// 모든 작업자가 종료되면 반환하며, 처리하지 못한 대기 작업 수를 반환합니다.
+func (p *Pool) Run(ctx context.Context) int {
+	var wg sync.WaitGroup
+	var dropped atomic.Int64
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-p.jobs:
+					// 취소와 작업이 동시에 준비되면 select가 임의로 고르므로 다시 확인
+					if ctx.Err() != nil {
+						dropped.Add(1)
+						return
+					}
+					job(ctx)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return len(p.jobs) + int(dropped.Load())
+}
@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// blockingProcessor는 release가 닫힐 때까지 EnqueueTask를 막는 테스트용 프로세서입니다.
+type blockingProcessor struct {
+	mu      sync.Mutex
+	started chan string
+	release chan struct{}
+	done    []string
+}
+
+func (p *blockingProcessor) EnqueueTask(ctx context.Context, taskID, listID string) {
+	p.started <- taskID
+	select {
+	case <-p.release:
+	case <-ctx.Done():
+		return
+	}
+	p.mu.Lock()
+	p.done = append(p.done, taskID)
+	p.mu.Unlock()
+}
+
+func (p *blockingProcessor) IsAIList(listID string) bool {
+	return listID == "ai-list-1"
+}
+
+// memoryRecorder는 테스트용 메모리 기록 저장소입니다.
+type memoryRecorder struct {
+	mu      sync.Mutex
+	records []Record
+}
+
+func (r *memoryRecorder) Record(rec *Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, *rec)
+	return nil
+}
+
+// postEvent는 서명된 웹훅 요청을 보내고 응답을 반환합니다.
+func postEvent(handler *Handler, taskID string) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(clickup.WebhookEvent{
+		Event:        clickup.EventTaskCreated,
+		TaskID:       taskID,
+		HistoryItems: []clickup.HistoryItem{{Field: "parent_id", After: "ai-list-1"}},
+	})
+	req := httptest.NewRequest("POST", "/webhook/clickup", bytes.NewReader(payload))
+	req.Header.Set("X-Signature", computeSignature(payload, "test-secret"))
+	w := httptest.NewRecorder()
+	handler.HandleWebhook(w, req)
+	return w
+}
+
+// TestHandler_AsyncPoolBackpressure는 비동기 처리와 대기열 포화 시 503 응답을 테스트합니다.
+func TestHandler_AsyncPoolBackpressure(t *testing.T) {
+	processor := &blockingProcessor{started: make(chan string, 10), release: make(chan struct{})}
+	recorder := &memoryRecorder{}
+
+	handler := NewHandler(processor, "test-secret")
+	handler.SetRecorder(recorder)
+	pool := NewPool(1, 1)
+	handler.SetPool(pool, 15*time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	poolDone := make(chan int)
+	go func() { poolDone <- pool.Run(ctx) }()
+
+	// 첫 요청: 즉시 200 응답, 작업자가 처리 시작
+	if w := postEvent(handler, "task1"); w.Code != http.StatusOK {
+		t.Fatalf("첫 요청은 200이어야 함: %d", w.Code)
+	}
+	<-processor.started
+
+	// 두 번째 요청: 대기열에 들어감
+	if w := postEvent(handler, "task2"); w.Code != http.StatusOK {
+		t.Fatalf("대기열 여유가 있으면 200이어야 함: %d", w.Code)
+	}
+
+	// 세 번째 요청: 작업자/대기열 모두 가득 참
+	w := postEvent(handler, "task3")
+	if w.Code != http.StatusServiceUnavailable || w.Header().Get("Retry-After") != "15" {
+		t.Fatalf("포화 시 503 + Retry-After여야 함: %d, %q", w.Code, w.Header().Get("Retry-After"))
+	}
+
+	// 처리 완료
+	close(processor.release)
+	<-processor.started
+
+	deadline := time.After(2 * time.Second)
+	for {
+		recorder.mu.Lock()
+		n := len(recorder.records)
+		recorder.mu.Unlock()
+		if n == 3 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("기록 개수 불일치: got %d, want 3", n)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	recorder.mu.Lock()
+	actions := map[string]string{}
+	for _, rec := range recorder.records {
+		actions[rec.Decision.TaskID] = rec.Decision.Action
+	}
+	recorder.mu.Unlock()
+	if actions["task1"] != ActionEnqueued || actions["task2"] != ActionEnqueued || actions["task3"] != ActionRejected {
+		t.Errorf("결정 기록 불일치: %v", actions)
+	}
+
+	cancel()
+	if dropped := <-poolDone; dropped != 0 {
+		t.Errorf("남은 작업 없음이어야 함: %d", dropped)
+	}
+}
+
+// TestPool_StopsOnContextCancel은 컨텍스트 취소 시 풀 종료와 미처리 작업 수를 테스트합니다.
+func TestPool_StopsOnContextCancel(t *testing.T) {
+	pool := NewPool(1, 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var gotCtx context.Context
+	started := make(chan struct{})
+	pool.TrySubmit(func(jobCtx context.Context) {
+		gotCtx = jobCtx
+		close(started)
+		<-jobCtx.Done()
+	})
+	pool.TrySubmit(func(context.Context) {})
+	pool.TrySubmit(func(context.Context) {})
+
+	done := make(chan int)
+	go func() { done <- pool.Run(ctx) }()
+
+	<-started
+	cancel()
+
+	if dropped := <-done; dropped != 2 {
+		t.Errorf("미처리 작업 수 불일치: got %d, want 2", dropped)
+	}
+	if gotCtx.Err() == nil {
+		t.Error("작업 컨텍스트는 풀 컨텍스트와 함께 취소되어야 함")
+	}
+}
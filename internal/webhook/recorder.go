@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Decision은 웹훅 이벤트의 라우팅 결정입니다.
+type Decision struct {
+	Action string `json:"action"`            // enqueued, ignored, rejected, cancelled
+	Reason string `json:"reason"`            // 결정 사유
+	Event  string `json:"event,omitempty"`   // 이벤트 타입
+	TaskID string `json:"task_id,omitempty"` // 태스크 ID
+	ListID string `json:"list_id,omitempty"` // 리스트 ID
+	Rule   string `json:"rule,omitempty"`    // 일치한 트리거 규칙
+
+	Evaluations []string `json:"evaluations,omitempty"` // 트리거 규칙별 판단 근거
+}
+
+// 라우팅 결정 Action 상수
+const (
+	ActionEnqueued  = "enqueued"  // 큐에 추가됨
+	ActionIgnored   = "ignored"   // 무시됨 (중복/리스트 불일치/트리거 규칙 불일치)
+	ActionCancelled = "cancelled" // 진행 중인 작업 중단 (삭제/이동/취소)
+	ActionRejected  = "rejected"  // 거부됨 (메서드/서명/파싱 오류)
+)
+
+// Record는 수신한 웹훅 요청 하나의 기록입니다.
+type Record struct {
+	Time       time.Time           `json:"time"`
+	Method     string              `json:"method"`
+	Path       string              `json:"path"`
+	RemoteAddr string              `json:"remote_addr"`
+	Headers    map[string][]string `json:"headers"`
+	Body       string              `json:"body"`     // 원본 페이로드
+	Verified   bool                `json:"verified"` // 서명 검증 결과
+	Decision   Decision            `json:"decision"`
+}
+
+// Recorder는 웹훅 요청 기록 저장소 인터페이스입니다.
+type Recorder interface {
+	Record(rec *Record) error
+}
+
+// RecorderConfig는 JSONL 기록 파일 설정입니다.
+type RecorderConfig struct {
+	Path       string // 기록 파일 경로
+	MaxSizeMB  int    // 회전 기준 크기 (기본값: 10)
+	MaxBackups int    // 보관할 이전 파일 수 (기본값: 5)
+	MaxAgeDays int    // 보관 기간 (0이면 무제한)
+}
+
+// JSONLRecorder는 웹훅 요청을 회전 JSONL 파일에 한 줄씩 기록합니다.
+type JSONLRecorder struct {
+	mu     sync.Mutex
+	writer io.WriteCloser
+}
+
+// NewJSONLRecorder는 새 JSONLRecorder를 생성합니다.
+func NewJSONLRecorder(config RecorderConfig) *JSONLRecorder {
+	if config.MaxSizeMB == 0 {
+		config.MaxSizeMB = 10
+	}
+	if config.MaxBackups == 0 {
+		config.MaxBackups = 5
+	}
+
+	return &JSONLRecorder{
+		writer: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    config.MaxSizeMB,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAgeDays,
+			Compress:   true,
+		},
+	}
+}
+
+// Record는 요청 기록을 JSON 한 줄로 추가합니다.
+func (r *JSONLRecorder) Record(rec *Record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("기록 직렬화 실패: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.writer.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("기록 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// Close는 기록 파일을 닫습니다.
+func (r *JSONLRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.writer.Close()
+}
+
+// ReadRecords는 JSONL 기록 파일에서 요청 기록을 읽습니다.
+func ReadRecords(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("기록 파일 열기 실패: %w", err)
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("%d번째 줄 파싱 실패: %w", lineNo, err)
+		}
+		records = append(records, rec)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("기록 파일 읽기 실패: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// sendWebhook은 테스트 요청을 핸들러로 전송합니다.
+func sendWebhook(handler *Handler, event clickup.WebhookEvent, signature string) {
+	payload, _ := json.Marshal(event)
+	if signature == "" {
+		signature = computeSignature(payload, "test-secret")
+	}
+
+	req := httptest.NewRequest("POST", "/webhook/clickup", bytes.NewReader(payload))
+	req.Header.Set("X-Signature", signature)
+	handler.HandleWebhook(httptest.NewRecorder(), req)
+}
+
+// TestJSONLRecorder_RecordAndReplay는 요청 기록과 재생을 테스트합니다.
+func TestJSONLRecorder_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.jsonl")
+	recorder := NewJSONLRecorder(RecorderConfig{Path: path})
+
+	handler := NewHandler(&MockProcessor{}, "test-secret")
+	handler.SetRecorder(recorder)
+
+	aiEvent := clickup.WebhookEvent{
+		Event:        clickup.EventTaskCreated,
+		TaskID:       "task1",
+		HistoryItems: []clickup.HistoryItem{{Field: "parent_id", After: "ai-list-1"}},
+	}
+	otherEvent := clickup.WebhookEvent{
+		Event:        clickup.EventTaskCreated,
+		TaskID:       "task2",
+		HistoryItems: []clickup.HistoryItem{{Field: "parent_id", After: "other-list"}},
+	}
+
+	sendWebhook(handler, aiEvent, "")
+	sendWebhook(handler, otherEvent, "")
+	sendWebhook(handler, aiEvent, "invalid-signature")
+	recorder.Close()
+
+	records, err := ReadRecords(path)
+	if err != nil {
+		t.Fatalf("기록 읽기 실패: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("기록 개수 불일치: got %d, want 3", len(records))
+	}
+
+	wants := []struct {
+		action   string
+		verified bool
+	}{
+		{ActionEnqueued, true},
+		{ActionIgnored, true},
+		{ActionRejected, false},
+	}
+	for i, want := range wants {
+		if records[i].Decision.Action != want.action || records[i].Verified != want.verified {
+			t.Errorf("기록 %d 불일치: action=%s verified=%v", i, records[i].Decision.Action, records[i].Verified)
+		}
+	}
+	if records[0].Headers["X-Signature"] == nil || records[0].Body == "" {
+		t.Error("헤더와 원본 페이로드가 기록되어야 함")
+	}
+	if records[0].Decision.ListID != "ai-list-1" || records[0].Decision.TaskID != "task1" {
+		t.Errorf("라우팅 결정 정보 불일치: %+v", records[0].Decision)
+	}
+
+	// 다른 AI 리스트 설정으로 재생
+	replayProcessor := &MockProcessor{}
+	replayHandler := NewHandler(replayProcessor, "")
+	for i := range records {
+		if _, err := replayHandler.Replay(&records[i]); err != nil {
+			t.Errorf("재생 실패: %v", err)
+		}
+	}
+
+	// 서명 실패 요청도 재생 시에는 처리됨 (task1 두 번)
+	if len(replayProcessor.EnqueuedTasks) != 2 {
+		t.Errorf("재생 시 큐 추가 개수 불일치: got %d, want 2", len(replayProcessor.EnqueuedTasks))
+	}
+}
+
+// TestHandler_Replay_InvalidBody는 파싱할 수 없는 기록 재생을 테스트합니다.
+func TestHandler_Replay_InvalidBody(t *testing.T) {
+	handler := NewHandler(&MockProcessor{}, "")
+
+	decision, err := handler.Replay(&Record{Body: "not-json"})
+	if err == nil {
+		t.Error("잘못된 페이로드는 에러여야 함")
+	}
+	if decision.Action != ActionRejected {
+		t.Errorf("결정 불일치: %s", decision.Action)
+	}
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// TriggerRule은 웹훅 이벤트가 태스크 처리를 시작할지 판단하는 규칙입니다.
+// Events가 비어 있으면 모든 이벤트 타입, Field가 비어 있으면 이력 항목과 무관하게 일치합니다.
+type TriggerRule struct {
+	Name   string   // 규칙 원문 (결정 로그용)
+	Events []string // 대상 이벤트 타입
+	Field  string   // 이력 항목 필드 (status, tag 등)
+	From   string   // 변경 전 값 (비어 있으면 무관)
+	To     string   // 변경 후 값 (비어 있으면 무관)
+}
+
+// DefaultTriggerRules는 규칙이 설정되지 않았을 때의 기본 규칙입니다.
+// 생성/수정/상태 변경 이벤트를 모두 처리합니다.
+func DefaultTriggerRules() []TriggerRule {
+	return []TriggerRule{{
+		Name:   "default",
+		Events: []string{clickup.EventTaskCreated, clickup.EventTaskUpdated, clickup.EventTaskStatusUpdated},
+	}}
+}
+
+// ParseTriggerRules는 콤마로 구분된 규칙 문자열을 파싱합니다.
+//
+//	status=AI요청          상태가 AI요청으로 변경될 때
+//	status=보류->AI요청    상태가 보류에서 AI요청으로 변경될 때
+//	tag=ai                 ai 태그가 추가될 때
+//	event=taskCreated      태스크가 생성될 때
+//
+// 빈 문자열이면 nil을 반환합니다.
+func ParseTriggerRules(spec string) ([]TriggerRule, error) {
+	var rules []TriggerRule
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("트리거 규칙 형식 오류: %q (key=value 형식이어야 함)", part)
+		}
+
+		rule := TriggerRule{Name: part}
+		switch strings.ToLower(key) {
+		case "event":
+			rule.Events = []string{value}
+		case "status":
+			rule.Events = []string{clickup.EventTaskStatusUpdated}
+			rule.Field = "status"
+			if from, to, found := strings.Cut(value, "->"); found {
+				rule.From, rule.To = strings.TrimSpace(from), strings.TrimSpace(to)
+			} else {
+				rule.To = value
+			}
+		case "tag":
+			rule.Events = []string{clickup.EventTaskTagUpdated, clickup.EventTaskUpdated}
+			rule.Field = "tag"
+			rule.To = value
+		default:
+			return nil, fmt.Errorf("알 수 없는 트리거 규칙 키: %q", key)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Match는 이벤트가 규칙과 일치하는지 확인하고 판단 근거를 반환합니다.
+func (r TriggerRule) Match(event *clickup.WebhookEvent) (bool, string) {
+	if len(r.Events) > 0 && !containsFold(r.Events, event.Event) {
+		return false, fmt.Sprintf("%s: 이벤트 타입 불일치 (%s)", r.Name, event.Event)
+	}
+	if r.Field == "" {
+		return true, fmt.Sprintf("%s: 일치", r.Name)
+	}
+
+	for _, item := range event.HistoryItems {
+		if !strings.EqualFold(item.Field, r.Field) {
+			continue
+		}
+		before, after := historyValues(item.Before), historyValues(item.After)
+		if r.From != "" && !containsFold(before, r.From) {
+			continue
+		}
+		if r.To != "" && !containsFold(after, r.To) {
+			continue
+		}
+		return true, fmt.Sprintf("%s: 일치 (%s: %s → %s)", r.Name, item.Field, strings.Join(before, "|"), strings.Join(after, "|"))
+	}
+
+	return false, fmt.Sprintf("%s: 일치하는 %s 변경 없음", r.Name, r.Field)
+}
+
+// historyValues는 이력 항목의 before/after 값을 문자열 목록으로 변환합니다.
+// 문자열, {"status": ...}/{"name": ...} 객체, 태그 객체 배열을 지원합니다.
+func historyValues(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case map[string]interface{}:
+		for _, key := range []string{"status", "name"} {
+			if s, ok := val[key].(string); ok {
+				return []string{s}
+			}
+		}
+	case []interface{}:
+		var values []string
+		for _, elem := range val {
+			values = append(values, historyValues(elem)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// containsFold는 대소문자를 무시하고 목록에 값이 있는지 확인합니다.
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// parseEvent는 테스트용 웹훅 JSON을 파싱합니다.
+func parseEvent(t *testing.T, data string) *clickup.WebhookEvent {
+	t.Helper()
+	var event clickup.WebhookEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+	return &event
+}
+
+// TestParseTriggerRules는 규칙 문자열 파싱을 테스트합니다.
+func TestParseTriggerRules(t *testing.T) {
+	rules, err := ParseTriggerRules("status=보류->AI요청, tag=ai, event=taskCreated")
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+	if len(rules) != 3 {
+		t.Fatalf("규칙 개수 불일치: %d", len(rules))
+	}
+	if rules[0].Field != "status" || rules[0].From != "보류" || rules[0].To != "AI요청" {
+		t.Errorf("상태 규칙 불일치: %+v", rules[0])
+	}
+	if rules[1].Field != "tag" || rules[1].To != "ai" {
+		t.Errorf("태그 규칙 불일치: %+v", rules[1])
+	}
+	if rules[2].Field != "" || rules[2].Events[0] != clickup.EventTaskCreated {
+		t.Errorf("이벤트 규칙 불일치: %+v", rules[2])
+	}
+
+	if rules, err := ParseTriggerRules(""); err != nil || rules != nil {
+		t.Errorf("빈 문자열은 nil이어야 함: %v, %v", rules, err)
+	}
+
+	for _, spec := range []string{"status", "priority=high", "tag="} {
+		if _, err := ParseTriggerRules(spec); err == nil {
+			t.Errorf("%q는 에러여야 함", spec)
+		}
+	}
+}
+
+// TestTriggerRule_Match는 이력 항목 기반 규칙 평가를 테스트합니다.
+func TestTriggerRule_Match(t *testing.T) {
+	statusEvent := parseEvent(t, `{
+		"event": "taskStatusUpdated", "task_id": "task1",
+		"history_items": [{"id": "h1", "field": "status", "parent_id": "ai-list-1",
+			"before": {"status": "보류"}, "after": {"status": "ai요청"}}]
+	}`)
+	tagEvent := parseEvent(t, `{
+		"event": "taskTagUpdated", "task_id": "task1",
+		"history_items": [{"id": "h2", "field": "tag", "parent_id": "ai-list-1",
+			"after": [{"name": "urgent"}, {"name": "AI"}]}]
+	}`)
+
+	rules, _ := ParseTriggerRules("status=AI요청,status=개발중->AI요청,tag=ai,tag=backend,event=taskCreated")
+
+	tests := []struct {
+		rule  TriggerRule
+		event *clickup.WebhookEvent
+		want  bool
+	}{
+		{rules[0], statusEvent, true},
+		{rules[1], statusEvent, false},
+		{rules[2], tagEvent, true},
+		{rules[3], tagEvent, false},
+		{rules[2], statusEvent, false},
+		{rules[4], statusEvent, false},
+	}
+
+	for _, tt := range tests {
+		if got, why := tt.rule.Match(tt.event); got != tt.want {
+			t.Errorf("%s / %s: got %v, want %v (%s)", tt.rule.Name, tt.event.Event, got, tt.want, why)
+		}
+	}
+}
+
+// TestHandler_TriggerRulesAndDedupe는 트리거 규칙과 중복 제거가 적용된 결정을 테스트합니다.
+func TestHandler_TriggerRulesAndDedupe(t *testing.T) {
+	processor := &MockProcessor{}
+	handler := NewHandler(processor, "")
+	handler.SetDeduplicator(NewDeduplicator(time.Minute))
+	rules, _ := ParseTriggerRules("status=AI요청")
+	handler.SetTriggerRules(rules)
+
+	toAI := parseEvent(t, `{
+		"event": "taskStatusUpdated", "task_id": "task1",
+		"history_items": [{"id": "h1", "field": "status", "parent_id": "ai-list-1",
+			"before": {"status": "to do"}, "after": {"status": "AI요청"}}]
+	}`)
+	toDone := parseEvent(t, `{
+		"event": "taskStatusUpdated", "task_id": "task1",
+		"history_items": [{"id": "h2", "field": "status", "parent_id": "ai-list-1",
+			"before": {"status": "AI요청"}, "after": {"status": "개발완료"}}]
+	}`)
+
+	decision := handler.processEvent(context.Background(), toAI)
+	if decision.Action != ActionEnqueued || decision.Rule != "status=AI요청" {
+		t.Errorf("AI요청 전환은 큐에 추가되어야 함: %+v", decision)
+	}
+
+	decision = handler.processEvent(context.Background(), toAI)
+	if decision.Action != ActionIgnored || decision.Reason != "중복 이벤트" {
+		t.Errorf("재전송은 중복으로 무시되어야 함: %+v", decision)
+	}
+
+	decision = handler.processEvent(context.Background(), toDone)
+	if decision.Action != ActionIgnored || len(decision.Evaluations) != 1 {
+		t.Errorf("다른 상태 전환은 규칙 불일치로 무시되어야 함: %+v", decision)
+	}
+
+	if len(processor.EnqueuedTasks) != 1 || processor.EnqueuedTasks[0].ListID != "ai-list-1" {
+		t.Errorf("큐 추가 불일치: %+v", processor.EnqueuedTasks)
+	}
+}
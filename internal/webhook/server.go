@@ -10,8 +10,11 @@ import (
 
 // ServerConfig는 웹훅 서버 설정입니다.
 type ServerConfig struct {
-	Port   int    // 수신 포트
-	Secret string // 웹훅 시크릿 (서명 검증용)
+	Port       int           // 수신 포트
+	Secret     string        // 웹훅 시크릿 (서명 검증용, 콤마로 여러 개 지정 가능)
+	Workers    int           // 이벤트 처리 동시 작업 수 (기본값: 4)
+	QueueSize  int           // 처리 대기열 크기 (기본값: 100)
+	RetryAfter time.Duration // 대기열 포화 시 Retry-After (기본값: 30초)
 }
 
 // Server는 ClickUp 웹훅을 수신하는 HTTP 서버입니다.
@@ -24,6 +27,16 @@ type Server struct {
 
 // NewServer는 새 웹훅 서버를 생성합니다.
 func NewServer(config ServerConfig, processor Processor) *Server {
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 100
+	}
+	if config.RetryAfter <= 0 {
+		config.RetryAfter = 30 * time.Second
+	}
+
 	handler := NewHandler(processor, config.Secret)
 
 	return &Server{
@@ -38,8 +51,35 @@ func (s *Server) SetLogger(logger *log.Logger) {
 	s.handler.SetLogger(logger)
 }
 
+// SetRecorder는 웹훅 요청 기록 저장소를 설정합니다.
+func (s *Server) SetRecorder(recorder Recorder) {
+	s.handler.SetRecorder(recorder)
+}
+
+// SetDeduplicator는 재전송 이벤트 필터를 설정합니다.
+func (s *Server) SetDeduplicator(dedupe *Deduplicator) {
+	s.handler.SetDeduplicator(dedupe)
+}
+
+// SetTriggerRules는 트리거 규칙을 설정합니다.
+func (s *Server) SetTriggerRules(rules []TriggerRule) {
+	s.handler.SetTriggerRules(rules)
+}
+
 // Start는 서버를 시작합니다.
+// 수신한 이벤트는 ctx에 묶인 처리 풀에서 처리되며, ctx가 취소되면 서버와 풀이 함께 종료됩니다.
 func (s *Server) Start(ctx context.Context) error {
+	pool := NewPool(s.config.Workers, s.config.QueueSize)
+	s.handler.SetPool(pool, s.config.RetryAfter)
+
+	poolDone := make(chan struct{})
+	go func() {
+		defer close(poolDone)
+		if dropped := pool.Run(ctx); dropped > 0 && s.logger != nil {
+			s.logger.Printf("[Webhook Server] 종료 시 처리하지 못한 이벤트: %d개 (폴링으로 재처리)", dropped)
+		}
+	}()
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/webhook/clickup", s.handler.HandleWebhook)
 	mux.HandleFunc("/health", s.healthHandler)
@@ -53,7 +93,7 @@ func (s *Server) Start(ctx context.Context) error {
 	}
 
 	if s.logger != nil {
-		s.logger.Printf("[Webhook Server] 시작: %s", addr)
+		s.logger.Printf("[Webhook Server] 시작: %s (처리 작업 %d개, 대기열 %d)", addr, s.config.Workers, s.config.QueueSize)
 	}
 
 	// 컨텍스트 취소 시 서버 종료
@@ -66,6 +106,8 @@ func (s *Server) Start(ctx context.Context) error {
 		return fmt.Errorf("서버 시작 실패: %w", err)
 	}
 
+	// 서버 종료 후 진행 중인 이벤트 처리 대기
+	<-poolDone
 	return nil
 }
 
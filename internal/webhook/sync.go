@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// DefaultSyncEvents는 AI 리스트 웹훅에 등록하는 기본 이벤트입니다.
+var DefaultSyncEvents = []string{
+	clickup.EventTaskCreated,
+	clickup.EventTaskUpdated,
+	clickup.EventTaskStatusUpdated,
+	clickup.EventTaskTagUpdated,
+	clickup.EventTaskMoved,
+	clickup.EventTaskDeleted,
+}
+
+// WebhookAPI는 웹훅 동기화에 필요한 ClickUp API입니다.
+type WebhookAPI interface {
+	GetWebhooks(ctx context.Context) ([]clickup.Webhook, error)
+	CreateWebhook(ctx context.Context, registration *clickup.WebhookRegistration) (*clickup.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhookID string, update *clickup.WebhookUpdate) (*clickup.Webhook, error)
+}
+
+// SyncConfig는 웹훅 동기화 설정입니다.
+type SyncConfig struct {
+	Endpoint string   // 웹훅 수신 URL (예: https://example.com/webhook/clickup)
+	Events   []string // 등록할 이벤트 (비어 있으면 DefaultSyncEvents)
+	ListIDs  []string // AI 리스트 ID
+	DryRun   bool     // true면 변경 없이 계획만 보고
+}
+
+// 동기화 결과 Action 상수
+const (
+	SyncCreated   = "created"   // 새로 등록
+	SyncUpdated   = "updated"   // 엔드포인트/이벤트 수정
+	SyncReenabled = "reenabled" // 비정상 웹훅 재활성화
+	SyncUnchanged = "unchanged" // 변경 없음
+	SyncFailed    = "failed"    // API 오류
+)
+
+// SyncResult는 리스트 하나의 웹훅 동기화 결과입니다.
+type SyncResult struct {
+	ListID       string
+	WebhookID    string
+	Action       string
+	HealthStatus string // 동기화 전 상태 (active, failing, suspended)
+	FailCount    int    // 동기화 전 실패 횟수
+	Secret       string
+	Err          error
+}
+
+// SyncReport는 웹훅 동기화 전체 결과입니다.
+type SyncReport struct {
+	Results []SyncResult
+}
+
+// Secrets는 동기화된 웹훅 시크릿을 중복 없이 반환합니다. (WEBHOOK_SECRET용)
+func (r *SyncReport) Secrets() []string {
+	seen := make(map[string]bool)
+	var secrets []string
+	for _, res := range r.Results {
+		if res.Secret != "" && !seen[res.Secret] {
+			seen[res.Secret] = true
+			secrets = append(secrets, res.Secret)
+		}
+	}
+	return secrets
+}
+
+// Failed는 실패한 리스트가 있는지 확인합니다.
+func (r *SyncReport) Failed() bool {
+	for _, res := range r.Results {
+		if res.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncWebhooks는 AI 리스트마다 웹훅이 하나씩 올바른 엔드포인트/이벤트로 등록되도록 맞춥니다.
+// 리스트의 기존 웹훅이 있으면 엔드포인트/이벤트를 수정하고, 비정상(fail_count > 0, 비활성) 웹훅은 재활성화합니다.
+// 다른 엔드포인트를 가리키는 웹훅은 건드리지 않습니다.
+func SyncWebhooks(ctx context.Context, api WebhookAPI, config SyncConfig) (*SyncReport, error) {
+	if config.Endpoint == "" {
+		return nil, fmt.Errorf("웹훅 엔드포인트가 설정되지 않음")
+	}
+	events := config.Events
+	if len(events) == 0 {
+		events = DefaultSyncEvents
+	}
+
+	existing, err := api.GetWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("웹훅 목록 조회 실패: %w", err)
+	}
+
+	// 리스트별 기존 웹훅 (같은 엔드포인트 우선)
+	byList := make(map[string]clickup.Webhook)
+	for _, wh := range existing {
+		listID := string(wh.ListID)
+		if listID == "" {
+			continue
+		}
+		if current, ok := byList[listID]; ok && sameEndpoint(current.Endpoint, config.Endpoint) {
+			continue
+		}
+		byList[listID] = wh
+	}
+
+	report := &SyncReport{}
+	for _, listID := range config.ListIDs {
+		result := SyncResult{ListID: listID}
+
+		wh, ok := byList[listID]
+		if !ok {
+			result.Action = SyncCreated
+			if !config.DryRun {
+				id := listID
+				created, err := api.CreateWebhook(ctx, &clickup.WebhookRegistration{
+					Endpoint: config.Endpoint,
+					Events:   events,
+					ListID:   &id,
+				})
+				if err != nil {
+					result.Action, result.Err = SyncFailed, fmt.Errorf("웹훅 등록 실패: %w", err)
+				} else {
+					result.WebhookID, result.Secret = created.ID, created.Secret
+				}
+			}
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		result.WebhookID = wh.ID
+		result.Secret = wh.Secret
+		result.HealthStatus = wh.Health.Status
+		result.FailCount = wh.Health.FailCount
+
+		switch {
+		case !wh.IsHealthy():
+			result.Action = SyncReenabled
+		case !sameEndpoint(wh.Endpoint, config.Endpoint) || !sameEvents(wh.Events, events):
+			result.Action = SyncUpdated
+		default:
+			result.Action = SyncUnchanged
+		}
+
+		if result.Action != SyncUnchanged && !config.DryRun {
+			updated, err := api.UpdateWebhook(ctx, wh.ID, &clickup.WebhookUpdate{
+				Endpoint: config.Endpoint,
+				Events:   events,
+				Status:   clickup.WebhookStatusActive,
+			})
+			if err != nil {
+				result.Action, result.Err = SyncFailed, fmt.Errorf("웹훅 수정 실패: %w", err)
+			} else if updated.Secret != "" {
+				result.Secret = updated.Secret
+			}
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// sameEndpoint는 끝의 슬래시를 무시하고 엔드포인트를 비교합니다.
+func sameEndpoint(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
+// sameEvents는 순서를 무시하고 이벤트 목록을 비교합니다. "*"는 모든 이벤트를 의미합니다.
+func sameEvents(a, b []string) bool {
+	if len(a) == 1 && a[0] == "*" {
+		return true
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
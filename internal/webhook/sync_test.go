@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zime/slickwebhook/internal/clickup"
+)
+
+// fakeWebhookAPI는 테스트용 ClickUp 웹훅 API입니다.
+type fakeWebhookAPI struct {
+	hooks     []clickup.Webhook
+	created   []clickup.WebhookRegistration
+	updated   map[string]clickup.WebhookUpdate
+	createErr error
+}
+
+func (f *fakeWebhookAPI) GetWebhooks(ctx context.Context) ([]clickup.Webhook, error) {
+	return f.hooks, nil
+}
+
+func (f *fakeWebhookAPI) CreateWebhook(ctx context.Context, registration *clickup.WebhookRegistration) (*clickup.Webhook, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	f.created = append(f.created, *registration)
+	return &clickup.Webhook{ID: "new-" + *registration.ListID, Secret: "secret-" + *registration.ListID}, nil
+}
+
+func (f *fakeWebhookAPI) UpdateWebhook(ctx context.Context, webhookID string, update *clickup.WebhookUpdate) (*clickup.Webhook, error) {
+	if f.updated == nil {
+		f.updated = make(map[string]clickup.WebhookUpdate)
+	}
+	f.updated[webhookID] = *update
+	return &clickup.Webhook{ID: webhookID}, nil
+}
+
+// TestSyncWebhooks는 리스트별 웹훅 등록/수정/재활성화를 테스트합니다.
+func TestSyncWebhooks(t *testing.T) {
+	endpoint := "https://example.com/webhook/clickup"
+	api := &fakeWebhookAPI{hooks: []clickup.Webhook{
+		{ID: "wh-ok", ListID: "list-ok", Endpoint: endpoint + "/", Events: DefaultSyncEvents, Secret: "secret-ok",
+			Health: clickup.WebhookHealth{Status: "active"}},
+		{ID: "wh-old", ListID: "list-old", Endpoint: "https://old.example.com/webhook/clickup", Events: []string{"taskCreated"}, Secret: "secret-old",
+			Health: clickup.WebhookHealth{Status: "active"}},
+		{ID: "wh-sick", ListID: "list-sick", Endpoint: endpoint, Events: DefaultSyncEvents, Secret: "secret-sick",
+			Health: clickup.WebhookHealth{Status: "failing", FailCount: 12}},
+		{ID: "wh-other", ListID: "not-ai", Endpoint: "https://other.example.com", Events: []string{"*"}},
+	}}
+
+	report, err := SyncWebhooks(context.Background(), api, SyncConfig{
+		Endpoint: endpoint,
+		ListIDs:  []string{"list-ok", "list-old", "list-sick", "list-new"},
+	})
+	if err != nil {
+		t.Fatalf("동기화 실패: %v", err)
+	}
+
+	wantActions := []string{SyncUnchanged, SyncUpdated, SyncReenabled, SyncCreated}
+	for i, want := range wantActions {
+		if report.Results[i].Action != want {
+			t.Errorf("%s: got %s, want %s", report.Results[i].ListID, report.Results[i].Action, want)
+		}
+	}
+
+	if report.Results[2].FailCount != 12 || report.Results[2].HealthStatus != "failing" {
+		t.Errorf("비정상 웹훅 상태가 보고되어야 함: %+v", report.Results[2])
+	}
+	if api.updated["wh-sick"].Status != clickup.WebhookStatusActive || api.updated["wh-old"].Endpoint != endpoint {
+		t.Errorf("수정 요청 불일치: %+v", api.updated)
+	}
+	if _, ok := api.updated["wh-other"]; ok || len(api.updated) != 2 {
+		t.Errorf("AI 리스트가 아닌 웹훅은 수정하지 않아야 함: %+v", api.updated)
+	}
+	if len(api.created) != 1 || *api.created[0].ListID != "list-new" || len(api.created[0].Events) != len(DefaultSyncEvents) {
+		t.Errorf("등록 요청 불일치: %+v", api.created)
+	}
+
+	secrets := report.Secrets()
+	if len(secrets) != 4 || secrets[3] != "secret-list-new" {
+		t.Errorf("시크릿 목록 불일치: %v", secrets)
+	}
+}
+
+// TestSyncWebhooks_DryRunAndFailure는 dry-run과 등록 실패를 테스트합니다.
+func TestSyncWebhooks_DryRunAndFailure(t *testing.T) {
+	api := &fakeWebhookAPI{createErr: errors.New("401")}
+	config := SyncConfig{Endpoint: "https://example.com/webhook/clickup", ListIDs: []string{"list1"}}
+
+	config.DryRun = true
+	report, err := SyncWebhooks(context.Background(), api, config)
+	if err != nil || report.Results[0].Action != SyncCreated || report.Failed() {
+		t.Errorf("dry-run은 API 호출 없이 계획만 보고해야 함: %+v, %v", report, err)
+	}
+
+	config.DryRun = false
+	report, _ = SyncWebhooks(context.Background(), api, config)
+	if report.Results[0].Action != SyncFailed || !report.Failed() {
+		t.Errorf("등록 실패가 보고되어야 함: %+v", report.Results[0])
+	}
+
+	if _, err := SyncWebhooks(context.Background(), api, SyncConfig{}); err == nil {
+		t.Error("엔드포인트가 없으면 에러여야 함")
+	}
+}